@@ -210,6 +210,18 @@ var (
 
 var ISDelegegatedVPC string
 
+// For Cluster Network
+var (
+	ISClusterNetworkProfileName         string
+	ISClusterNetworkInstanceProfileName string
+)
+
+// For Confidential Compute
+var ISConfidentialComputeInstanceProfileName string
+
+// For Private Path
+var ISPrivatePathAccountID string
+
 // For Image
 
 var (
@@ -296,6 +308,12 @@ var (
 	ISClientCaCrn    string
 )
 
+// VPN Server client
+var (
+	ISVPNServerID       string
+	ISVPNServerClientID string
+)
+
 // COS Replication Bucket
 var IBM_AccountID_REPL string
 
@@ -639,6 +657,29 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SL_ZONE for testing ibm_is_zone datasource else it is set to default value 'us-south-1'")
 	}
 
+	ISClusterNetworkProfileName = os.Getenv("IS_CLUSTER_NETWORK_PROFILE")
+	if ISClusterNetworkProfileName == "" {
+		ISClusterNetworkProfileName = "h100"
+		fmt.Println("[INFO] Set the environment variable IS_CLUSTER_NETWORK_PROFILE for testing ibm_is_cluster_network resource else it is set to default value 'h100'")
+	}
+
+	ISClusterNetworkInstanceProfileName = os.Getenv("IS_CLUSTER_NETWORK_INSTANCE_PROFILE")
+	if ISClusterNetworkInstanceProfileName == "" {
+		ISClusterNetworkInstanceProfileName = "gx3d-160x1792x8h100"
+		fmt.Println("[INFO] Set the environment variable IS_CLUSTER_NETWORK_INSTANCE_PROFILE for testing ibm_is_instance cluster network attachments else it is set to default value 'gx3d-160x1792x8h100'")
+	}
+
+	ISPrivatePathAccountID = os.Getenv("IS_PRIVATE_PATH_ACCOUNT_ID")
+	if ISPrivatePathAccountID == "" {
+		fmt.Println("[INFO] Set the environment variable IS_PRIVATE_PATH_ACCOUNT_ID for testing ibm_is_private_path_service_gateway_account_policy resource else tests will fail if this is not set correctly")
+	}
+
+	ISConfidentialComputeInstanceProfileName = os.Getenv("IS_CONFIDENTIAL_COMPUTE_INSTANCE_PROFILE")
+	if ISConfidentialComputeInstanceProfileName == "" {
+		ISConfidentialComputeInstanceProfileName = "bx3dc-2x10"
+		fmt.Println("[INFO] Set the environment variable IS_CONFIDENTIAL_COMPUTE_INSTANCE_PROFILE for testing ibm_is_instance confidential compute else it is set to default value 'bx3dc-2x10'")
+	}
+
 	ISZoneName2 = os.Getenv("SL_ZONE_2")
 	if ISZoneName2 == "" {
 		ISZoneName2 = "us-south-2"
@@ -1488,6 +1529,16 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IS_CLIENT_CA_CRN for testing ibm_is_vpn_server resource")
 	}
 
+	ISVPNServerID = os.Getenv("IS_VPN_SERVER_ID")
+	if ISVPNServerID == "" {
+		fmt.Println("[INFO] Set the environment variable IS_VPN_SERVER_ID for testing ibm_is_vpn_server_client resource")
+	}
+
+	ISVPNServerClientID = os.Getenv("IS_VPN_SERVER_CLIENT_ID")
+	if ISVPNServerClientID == "" {
+		fmt.Println("[INFO] Set the environment variable IS_VPN_SERVER_CLIENT_ID with a connected client of IS_VPN_SERVER_ID for testing ibm_is_vpn_server_client resource")
+	}
+
 	IBM_AccountID_REPL = os.Getenv("IBM_AccountID_REPL")
 	if IBM_AccountID_REPL == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_AccountID_REPL for setting up authorization policy to enable replication feature resource or datasource else tests will fail if this is not set correctly")
@@ -1847,6 +1847,7 @@ type CsEntry struct {
 	Database     string
 	BundleName   string
 	BundleBase64 string
+	ReadOnly     bool
 }
 
 // IBM Cloud Databases
@@ -1865,6 +1866,7 @@ func FlattenConnectionStrings(cs []CsEntry) []map[string]interface{} {
 			"database":     csEntry.Database,
 			"bundlename":   csEntry.BundleName,
 			"bundlebase64": csEntry.BundleBase64,
+			"read_only":    csEntry.ReadOnly,
 		}
 		hosts := csEntry.Hosts
 		hostsList := make([]map[string]interface{}, len(hosts), len(hosts))
@@ -2522,6 +2524,68 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	return nil
 }
 
+// UpdateGlobalTagsUsingCRNs attaches the tags added in newList to and detaches the tags removed from oldList
+// on all the resources, in batches of 100 resources per request. Unlike UpdateGlobalTagsUsingCRN the detached
+// tags are not deleted, as they are expected to be still attached to other resources.
+func UpdateGlobalTagsUsingCRNs(oldList, newList interface{}, meta interface{}, resourceIDs []string, tagType string) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	if oldList == nil {
+		oldList = new(schema.Set)
+	}
+	if newList == nil {
+		newList = new(schema.Set)
+	}
+	olds := oldList.(*schema.Set)
+	news := newList.(*schema.Set)
+	remove := ExpandStringList(olds.Difference(news).List())
+	add := ExpandStringList(news.Difference(olds).List())
+
+	for start := 0; start < len(resourceIDs); start += 100 {
+		end := start + 100
+		if end > len(resourceIDs) {
+			end = len(resourceIDs)
+		}
+		resources := []globaltaggingv1.Resource{}
+		for _, id := range resourceIDs[start:end] {
+			resources = append(resources, globaltaggingv1.Resource{ResourceID: PtrToString(id)})
+		}
+
+		if len(remove) > 0 {
+			detachTagOptions := &globaltaggingv1.DetachTagOptions{
+				Resources: resources,
+				TagNames:  remove,
+			}
+			if len(tagType) > 0 {
+				detachTagOptions.TagType = PtrToString(tagType)
+			}
+			_, resp, err := gtClient.DetachTag(detachTagOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error detaching tags %v: %s\n%s", remove, err, resp)
+			}
+		}
+
+		if len(add) > 0 {
+			attachTagOptions := &globaltaggingv1.AttachTagOptions{
+				Resources: resources,
+				TagNames:  add,
+			}
+			if len(tagType) > 0 {
+				attachTagOptions.TagType = PtrToString(tagType)
+			}
+			_, resp, err := gtClient.AttachTag(attachTagOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error attaching tags %v: %s\n%s", add, err, resp)
+			}
+		}
+	}
+
+	return nil
+}
+
 func ResourceIBMVPCHash(v interface{}) int {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s",
@@ -2803,13 +2867,6 @@ func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 		iops = int64(iopsOk.(int))
 	}
 
-	if diff.HasChange("profile") {
-		oldProfile, newProfile := diff.GetChange("profile")
-		if oldProfile.(string) == "custom" || newProfile.(string) == "custom" {
-			diff.ForceNew("profile")
-		}
-	}
-
 	if profile != "custom" {
 		if iops != 0 && diff.NewValueKnown("iops") && diff.HasChange("iops") {
 			return fmt.Errorf("VolumeError : iops is applicable for only custom volume profiles")
@@ -260,6 +260,7 @@ func Provider() *schema.Provider {
 			"ibm_cis":                                      cis.DataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                          cis.DataSourceIBMCISDNSRecords(),
 			"ibm_cis_certificates":                         cis.DataSourceIBMCISCertificates(),
+			"ibm_cis_origin_certificates":                  cis.DataSourceIBMCISOriginCertificates(),
 			"ibm_cis_global_load_balancers":                cis.DataSourceIBMCISGlbs(),
 			"ibm_cis_origin_pools":                         cis.DataSourceIBMCISOriginPools(),
 			"ibm_cis_healthchecks":                         cis.DataSourceIBMCISHealthChecks(),
@@ -280,6 +281,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_bot_analytics":                        cis.DataSourceIBMCISBotAnalytics(),
 			"ibm_cis_webhooks":                             cis.DataSourceIBMCISWebhooks(),
 			"ibm_cis_logpush_jobs":                         cis.DataSourceIBMCISLogPushJobs(),
+			"ibm_cis_logpull":                              cis.DataSourceIBMCISLogpull(),
 			"ibm_cis_edge_functions_actions":               cis.DataSourceIBMCISEdgeFunctionsActions(),
 			"ibm_cis_edge_functions_triggers":              cis.DataSourceIBMCISEdgeFunctionsTriggers(),
 			"ibm_cis_custom_pages":                         cis.DataSourceIBMCISCustomPages(),
@@ -336,6 +338,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                            classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":                      eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":                     eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_group":             eventstreams.DataSourceIBMEventStreamsConsumerGroup(),
 			"ibm_hpcs":                                     hpcs.DataSourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                         hpcs.DataSourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                        hpcs.DataSourceIbmKeyTemplate(),
@@ -552,6 +555,9 @@ func Provider() *schema.Provider {
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
+			// Added for private path
+			"ibm_is_private_path_service_gateway_endpoint_gateway_bindings": vpc.DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindings(),
+
 			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
 			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
 			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
@@ -592,6 +598,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_images":                                 power.DataSourceIBMPIImages(),
 			"ibm_pi_instance":                               power.DataSourceIBMPIInstance(),
 			"ibm_pi_instances":                              power.DataSourceIBMPIInstances(),
+			"ibm_pi_instance_console":                       power.DataSourceIBMPIInstanceConsole(),
 			"ibm_pi_instance_ip":                            power.DataSourceIBMPIInstanceIP(),
 			"ibm_pi_instance_snapshots":                     power.DataSourceIBMPISnapshots(),
 			"ibm_pi_instance_volumes":                       power.DataSourceIBMPIInstanceVolumes(),
@@ -733,7 +740,8 @@ func Provider() *schema.Provider {
 			"ibm_cm_object":            catalogmanagement.DataSourceIBMCmObject(),
 
 			// Added for Resource Tag
-			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
+			"ibm_resource_tag":   globaltagging.DataSourceIBMResourceTag(),
+			"ibm_iam_access_tag": globaltagging.DataSourceIBMIamAccessTag(),
 
 			// Atracker
 			"ibm_atracker_targets": atracker.DataSourceIBMAtrackerTargets(),
@@ -773,46 +781,52 @@ func Provider() *schema.Provider {
 			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
 
 			// Added for Event Notifications
-			"ibm_en_source":                    eventnotification.DataSourceIBMEnSource(),
-			"ibm_en_destinations":              eventnotification.DataSourceIBMEnDestinations(),
-			"ibm_en_topic":                     eventnotification.DataSourceIBMEnTopic(),
-			"ibm_en_topics":                    eventnotification.DataSourceIBMEnTopics(),
-			"ibm_en_subscriptions":             eventnotification.DataSourceIBMEnSubscriptions(),
-			"ibm_en_destination_webhook":       eventnotification.DataSourceIBMEnWebhookDestination(),
-			"ibm_en_destination_android":       eventnotification.DataSourceIBMEnFCMDestination(),
-			"ibm_en_destination_ios":           eventnotification.DataSourceIBMEnAPNSDestination(),
-			"ibm_en_destination_chrome":        eventnotification.DataSourceIBMEnChromeDestination(),
-			"ibm_en_destination_firefox":       eventnotification.DataSourceIBMEnFirefoxDestination(),
-			"ibm_en_destination_slack":         eventnotification.DataSourceIBMEnSlackDestination(),
-			"ibm_en_subscription_sms":          eventnotification.DataSourceIBMEnSMSSubscription(),
-			"ibm_en_subscription_email":        eventnotification.DataSourceIBMEnEmailSubscription(),
-			"ibm_en_subscription_webhook":      eventnotification.DataSourceIBMEnWebhookSubscription(),
-			"ibm_en_subscription_android":      eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_ios":          eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_chrome":       eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_firefox":      eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_slack":        eventnotification.DataSourceIBMEnSlackSubscription(),
-			"ibm_en_subscription_safari":       eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_destination_safari":        eventnotification.DataSourceIBMEnSafariDestination(),
-			"ibm_en_destination_msteams":       eventnotification.DataSourceIBMEnMSTeamsDestination(),
-			"ibm_en_subscription_msteams":      eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_destination_cf":            eventnotification.DataSourceIBMEnCFDestination(),
-			"ibm_en_subscription_cf":           eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_destination_pagerduty":     eventnotification.DataSourceIBMEnPagerDutyDestination(),
-			"ibm_en_subscription_pagerduty":    eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_integration":               eventnotification.DataSourceIBMEnIntegration(),
-			"ibm_en_integrations":              eventnotification.DataSourceIBMEnIntegrations(),
-			"ibm_en_destination_sn":            eventnotification.DataSourceIBMEnServiceNowDestination(),
-			"ibm_en_subscription_sn":           eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_destination_ce":            eventnotification.DataSourceIBMEnCodeEngineDestination(),
-			"ibm_en_subscription_ce":           eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_destination_cos":           eventnotification.DataSourceIBMEnCOSDestination(),
-			"ibm_en_subscription_cos":          eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_destination_huawei":        eventnotification.DataSourceIBMEnHuaweiDestination(),
-			"ibm_en_subscription_huawei":       eventnotification.DataSourceIBMEnFCMSubscription(),
-			"ibm_en_sources":                   eventnotification.DataSourceIBMEnSources(),
-			"ibm_en_destination_custom_email":  eventnotification.DataSourceIBMEnCustomEmailDestination(),
-			"ibm_en_subscription_custom_email": eventnotification.DataSourceIBMEnCustomEmailSubscription(),
+			"ibm_en_source":                     eventnotification.DataSourceIBMEnSource(),
+			"ibm_en_destinations":               eventnotification.DataSourceIBMEnDestinations(),
+			"ibm_en_topic":                      eventnotification.DataSourceIBMEnTopic(),
+			"ibm_en_topics":                     eventnotification.DataSourceIBMEnTopics(),
+			"ibm_en_subscriptions":              eventnotification.DataSourceIBMEnSubscriptions(),
+			"ibm_en_destination_webhook":        eventnotification.DataSourceIBMEnWebhookDestination(),
+			"ibm_en_destination_android":        eventnotification.DataSourceIBMEnFCMDestination(),
+			"ibm_en_destination_ios":            eventnotification.DataSourceIBMEnAPNSDestination(),
+			"ibm_en_destination_chrome":         eventnotification.DataSourceIBMEnChromeDestination(),
+			"ibm_en_destination_firefox":        eventnotification.DataSourceIBMEnFirefoxDestination(),
+			"ibm_en_destination_slack":          eventnotification.DataSourceIBMEnSlackDestination(),
+			"ibm_en_subscription_sms":           eventnotification.DataSourceIBMEnSMSSubscription(),
+			"ibm_en_subscription_email":         eventnotification.DataSourceIBMEnEmailSubscription(),
+			"ibm_en_subscription_webhook":       eventnotification.DataSourceIBMEnWebhookSubscription(),
+			"ibm_en_subscription_android":       eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_ios":           eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_chrome":        eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_firefox":       eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_slack":         eventnotification.DataSourceIBMEnSlackSubscription(),
+			"ibm_en_subscription_safari":        eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_safari":         eventnotification.DataSourceIBMEnSafariDestination(),
+			"ibm_en_destination_msteams":        eventnotification.DataSourceIBMEnMSTeamsDestination(),
+			"ibm_en_subscription_msteams":       eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_cf":             eventnotification.DataSourceIBMEnCFDestination(),
+			"ibm_en_subscription_cf":            eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_pagerduty":      eventnotification.DataSourceIBMEnPagerDutyDestination(),
+			"ibm_en_subscription_pagerduty":     eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_integration":                eventnotification.DataSourceIBMEnIntegration(),
+			"ibm_en_integrations":               eventnotification.DataSourceIBMEnIntegrations(),
+			"ibm_en_destination_sn":             eventnotification.DataSourceIBMEnServiceNowDestination(),
+			"ibm_en_subscription_sn":            eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_ce":             eventnotification.DataSourceIBMEnCodeEngineDestination(),
+			"ibm_en_subscription_ce":            eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_cos":            eventnotification.DataSourceIBMEnCOSDestination(),
+			"ibm_en_subscription_cos":           eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_huawei":         eventnotification.DataSourceIBMEnHuaweiDestination(),
+			"ibm_en_subscription_huawei":        eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_event_streams":  eventnotification.DataSourceIBMEnEventStreamsDestination(),
+			"ibm_en_subscription_event_streams": eventnotification.DataSourceIBMEnFCMSubscription(),
+			"ibm_en_destination_custom_sms":     eventnotification.DataSourceIBMEnCustomSMSDestination(),
+			"ibm_en_subscription_custom_sms":    eventnotification.DataSourceIBMEnSMSSubscription(),
+			"ibm_en_sources":                    eventnotification.DataSourceIBMEnSources(),
+			"ibm_en_destination_custom_email":   eventnotification.DataSourceIBMEnCustomEmailDestination(),
+			"ibm_en_subscription_custom_email":  eventnotification.DataSourceIBMEnCustomEmailSubscription(),
+			"ibm_en_email_template":             eventnotification.DataSourceIBMEnEmailTemplate(),
+			"ibm_en_metrics":                    eventnotification.DataSourceIBMEnMetrics(),
 
 			// Added for Toolchain
 			"ibm_cd_toolchain":                         cdtoolchain.DataSourceIBMCdToolchain(),
@@ -913,6 +927,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_certificate_upload":                   cis.ResourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecord(),
 			"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDNSRecordsImport(),
+			"ibm_cis_dns_records_proxied":                  cis.ResourceIBMCISDNSRecordsProxied(),
 			"ibm_cis_rate_limit":                           cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                            cis.ResourceIBMCISPageRule(),
 			"ibm_cis_edge_functions_action":                cis.ResourceIBMCISEdgeFunctionsAction(),
@@ -922,6 +937,8 @@ func Provider() *schema.Provider {
 			"ibm_cis_webhook":                              cis.ResourceIBMCISWebhooks(),
 			"ibm_cis_origin_auth":                          cis.ResourceIBMCISOriginAuthPull(),
 			"ibm_cis_mtls":                                 cis.ResourceIBMCISMtls(),
+			"ibm_cis_custom_nameserver":                    cis.ResourceIBMCISCustomNameserver(),
+			"ibm_cis_zone_custom_nameservers":              cis.ResourceIBMCISZoneCustomNameservers(),
 			"ibm_cis_mtls_app":                             cis.ResourceIBMCISMtlsApp(),
 			"ibm_cis_bot_management":                       cis.ResourceIBMCISBotManagement(),
 			"ibm_cis_logpush_job":                          cis.ResourceIBMCISLogPushJob(),
@@ -932,10 +949,13 @@ func Provider() *schema.Provider {
 			"ibm_cis_custom_page":                          cis.ResourceIBMCISCustomPage(),
 			"ibm_cis_waf_rule":                             cis.ResourceIBMCISWAFRule(),
 			"ibm_cis_certificate_order":                    cis.ResourceIBMCISCertificateOrder(),
+			"ibm_cis_origin_certificate_order":             cis.ResourceIBMCISOriginCertificateOrder(),
 			"ibm_cis_filter":                               cis.ResourceIBMCISFilter(),
 			"ibm_cis_firewall_rule":                        cis.ResourceIBMCISFirewallrules(),
 			"ibm_cloudant":                                 cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                        cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_database_design_document":        cloudant.ResourceIBMCloudantDatabaseDesignDocument(),
+			"ibm_cloudant_database_index":                  cloudant.ResourceIBMCloudantDatabaseIndex(),
 			"ibm_cloud_shell_account_settings":             cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":                  classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":                 classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -971,7 +991,9 @@ func Provider() *schema.Provider {
 			"ibm_container_nlb_dns":                        kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_container_dedicated_host_pool":            kubernetes.ResourceIBMContainerDedicatedHostPool(),
 			"ibm_container_dedicated_host":                 kubernetes.ResourceIBMContainerDedicatedHost(),
+			"ibm_cr_auth":                                  registry.ResourceIBMCrAuth(),
 			"ibm_cr_namespace":                             registry.ResourceIBMCrNamespace(),
+			"ibm_cr_pull_secret":                           registry.ResourceIBMCrPullSecret(),
 			"ibm_cr_retention_policy":                      registry.ResourceIBMCrRetentionPolicy(),
 			"ibm_ob_logging":                               kubernetes.ResourceIBMObLogging(),
 			"ibm_ob_monitoring":                            kubernetes.ResourceIBMObMonitoring(),
@@ -1044,6 +1066,7 @@ func Provider() *schema.Provider {
 			"ibm_is_flow_log":                               vpc.ResourceIBMISFlowLog(),
 			"ibm_is_instance":                               vpc.ResourceIBMISInstance(),
 			"ibm_is_instance_action":                        vpc.ResourceIBMISInstanceAction(),
+			"ibm_is_instance_network_attachment":            vpc.ResourceIBMIsInstanceNetworkAttachment(),
 			"ibm_is_instance_network_interface":             vpc.ResourceIBMIsInstanceNetworkInterface(),
 			"ibm_is_instance_network_interface_floating_ip": vpc.ResourceIBMIsInstanceNetworkInterfaceFloatingIp(),
 			"ibm_is_instance_disk_management":               vpc.ResourceIBMISInstanceDiskManagement(),
@@ -1081,6 +1104,13 @@ func Provider() *schema.Provider {
 			"ibm_is_ssh_key":                                vpc.ResourceIBMISSSHKey(),
 			"ibm_is_snapshot":                               vpc.ResourceIBMSnapshot(),
 			"ibm_is_volume":                                 vpc.ResourceIBMISVolume(),
+			"ibm_is_cluster_network":                        vpc.ResourceIBMIsClusterNetwork(),
+			"ibm_is_cluster_network_interface":              vpc.ResourceIBMIsClusterNetworkInterface(),
+			"ibm_is_cluster_network_subnet":                 vpc.ResourceIBMIsClusterNetworkSubnet(),
+			"ibm_is_public_address_range":                   vpc.ResourceIBMIsPublicAddressRange(),
+			"ibm_is_reservation":                            vpc.ResourceIBMIsReservation(),
+			"ibm_is_reservation_activate":                   vpc.ResourceIBMIsReservationActivate(),
+			"ibm_is_virtual_network_interface":              vpc.ResourceIBMIsVirtualNetworkInterface(),
 			"ibm_is_vpn_gateway":                            vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                 vpc.ResourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpc":                                    vpc.ResourceIBMISVPC(),
@@ -1145,6 +1175,11 @@ func Provider() *schema.Provider {
 			"ibm_cdn":                                       classicinfrastructure.ResourceIBMCDN(),
 			"ibm_hardware_firewall_shared":                  classicinfrastructure.ResourceIBMFirewallShared(),
 
+			// Added for private path
+			"ibm_is_private_path_service_gateway":                          vpc.ResourceIBMIsPrivatePathServiceGateway(),
+			"ibm_is_private_path_service_gateway_account_policy":           vpc.ResourceIBMIsPrivatePathServiceGatewayAccountPolicy(),
+			"ibm_is_private_path_service_gateway_endpoint_gateway_binding": vpc.ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBinding(),
+
 			// Added for Power Colo
 			"ibm_pi_key":                             power.ResourceIBMPIKey(),
 			"ibm_pi_volume":                          power.ResourceIBMPIVolume(),
@@ -1264,7 +1299,8 @@ func Provider() *schema.Provider {
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.ResourceIbmSatelliteClusterWorkerPoolZoneAttachment(),
 
 			// Added for Resource Tag
-			"ibm_resource_tag": globaltagging.ResourceIBMResourceTag(),
+			"ibm_resource_tag":   globaltagging.ResourceIBMResourceTag(),
+			"ibm_iam_access_tag": globaltagging.ResourceIBMIamAccessTag(),
 
 			// Atracker
 			"ibm_atracker_target":   atracker.ResourceIBMAtrackerTarget(),
@@ -1294,42 +1330,50 @@ func Provider() *schema.Provider {
 			"ibm_cbr_rule": contextbasedrestrictions.ResourceIBMCbrRule(),
 
 			// Added for Event Notifications
-			"ibm_en_source":                    eventnotification.ResourceIBMEnSource(),
-			"ibm_en_topic":                     eventnotification.ResourceIBMEnTopic(),
-			"ibm_en_destination_webhook":       eventnotification.ResourceIBMEnWebhookDestination(),
-			"ibm_en_destination_android":       eventnotification.ResourceIBMEnFCMDestination(),
-			"ibm_en_destination_chrome":        eventnotification.ResourceIBMEnChromeDestination(),
-			"ibm_en_destination_firefox":       eventnotification.ResourceIBMEnFirefoxDestination(),
-			"ibm_en_destination_ios":           eventnotification.ResourceIBMEnAPNSDestination(),
-			"ibm_en_destination_slack":         eventnotification.ResourceIBMEnSlackDestination(),
-			"ibm_en_subscription_sms":          eventnotification.ResourceIBMEnSMSSubscription(),
-			"ibm_en_subscription_email":        eventnotification.ResourceIBMEnEmailSubscription(),
-			"ibm_en_subscription_webhook":      eventnotification.ResourceIBMEnWebhookSubscription(),
-			"ibm_en_subscription_android":      eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_ios":          eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_chrome":       eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_firefox":      eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_subscription_slack":        eventnotification.ResourceIBMEnSlackSubscription(),
-			"ibm_en_subscription_safari":       eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_destination_safari":        eventnotification.ResourceIBMEnSafariDestination(),
-			"ibm_en_destination_msteams":       eventnotification.ResourceIBMEnMSTeamsDestination(),
-			"ibm_en_subscription_msteams":      eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_destination_cf":            eventnotification.ResourceIBMEnCFDestination(),
-			"ibm_en_subscription_cf":           eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_destination_pagerduty":     eventnotification.ResourceIBMEnPagerDutyDestination(),
-			"ibm_en_subscription_pagerduty":    eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_integration":               eventnotification.ResourceIBMEnIntegration(),
-			"ibm_en_destination_sn":            eventnotification.ResourceIBMEnServiceNowDestination(),
-			"ibm_en_subscription_sn":           eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_destination_ce":            eventnotification.ResourceIBMEnCodeEngineDestination(),
-			"ibm_en_subscription_ce":           eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_destination_cos":           eventnotification.ResourceIBMEnCOSDestination(),
-			"ibm_en_subscription_cos":          eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_destination_huawei":        eventnotification.ResourceIBMEnHuaweiDestination(),
-			"ibm_en_subscription_huawei":       eventnotification.ResourceIBMEnFCMSubscription(),
-			"ibm_en_ibmsource":                 eventnotification.ResourceIBMEnIBMSource(),
-			"ibm_en_destination_custom_email":  eventnotification.ResourceIBMEnCustomEmailDestination(),
-			"ibm_en_subscription_custom_email": eventnotification.ResourceIBMEnCustomEmailSubscription(),
+			"ibm_en_source":                     eventnotification.ResourceIBMEnSource(),
+			"ibm_en_topic":                      eventnotification.ResourceIBMEnTopic(),
+			"ibm_en_destination_webhook":        eventnotification.ResourceIBMEnWebhookDestination(),
+			"ibm_en_destination_android":        eventnotification.ResourceIBMEnFCMDestination(),
+			"ibm_en_destination_chrome":         eventnotification.ResourceIBMEnChromeDestination(),
+			"ibm_en_destination_firefox":        eventnotification.ResourceIBMEnFirefoxDestination(),
+			"ibm_en_destination_ios":            eventnotification.ResourceIBMEnAPNSDestination(),
+			"ibm_en_destination_slack":          eventnotification.ResourceIBMEnSlackDestination(),
+			"ibm_en_subscription_sms":           eventnotification.ResourceIBMEnSMSSubscription(),
+			"ibm_en_subscription_email":         eventnotification.ResourceIBMEnEmailSubscription(),
+			"ibm_en_subscription_webhook":       eventnotification.ResourceIBMEnWebhookSubscription(),
+			"ibm_en_subscription_android":       eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_ios":           eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_chrome":        eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_firefox":       eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_subscription_slack":         eventnotification.ResourceIBMEnSlackSubscription(),
+			"ibm_en_subscription_safari":        eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_safari":         eventnotification.ResourceIBMEnSafariDestination(),
+			"ibm_en_destination_msteams":        eventnotification.ResourceIBMEnMSTeamsDestination(),
+			"ibm_en_subscription_msteams":       eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_cf":             eventnotification.ResourceIBMEnCFDestination(),
+			"ibm_en_subscription_cf":            eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_pagerduty":      eventnotification.ResourceIBMEnPagerDutyDestination(),
+			"ibm_en_subscription_pagerduty":     eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_integration":                eventnotification.ResourceIBMEnIntegration(),
+			"ibm_en_destination_sn":             eventnotification.ResourceIBMEnServiceNowDestination(),
+			"ibm_en_subscription_sn":            eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_ce":             eventnotification.ResourceIBMEnCodeEngineDestination(),
+			"ibm_en_subscription_ce":            eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_cos":            eventnotification.ResourceIBMEnCOSDestination(),
+			"ibm_en_subscription_cos":           eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_huawei":         eventnotification.ResourceIBMEnHuaweiDestination(),
+			"ibm_en_subscription_huawei":        eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_event_streams":  eventnotification.ResourceIBMEnEventStreamsDestination(),
+			"ibm_en_subscription_event_streams": eventnotification.ResourceIBMEnFCMSubscription(),
+			"ibm_en_destination_custom_sms":     eventnotification.ResourceIBMEnCustomSMSDestination(),
+			"ibm_en_subscription_custom_sms":    eventnotification.ResourceIBMEnSMSSubscription(),
+			"ibm_en_ibmsource":                  eventnotification.ResourceIBMEnIBMSource(),
+			"ibm_en_destination_custom_email":   eventnotification.ResourceIBMEnCustomEmailDestination(),
+			"ibm_en_subscription_custom_email":  eventnotification.ResourceIBMEnCustomEmailSubscription(),
+			"ibm_en_email_template":             eventnotification.ResourceIBMEnEmailTemplate(),
+			"ibm_en_smtp_configuration":         eventnotification.ResourceIBMEnSMTPConfiguration(),
+			"ibm_en_smtp_user":                  eventnotification.ResourceIBMEnSMTPUser(),
+			"ibm_en_destination_test_send":      eventnotification.ResourceIBMEnDestinationTestSend(),
 
 			// Added for Toolchain
 			"ibm_cd_toolchain":                         cdtoolchain.ResourceIBMCdToolchain(),
@@ -1418,18 +1462,22 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_range_app":                            cis.ResourceIBMCISRangeAppValidator(),
 				"ibm_cis_waf_rule":                             cis.ResourceIBMCISWAFRuleValidator(),
 				"ibm_cis_certificate_order":                    cis.ResourceIBMCISCertificateOrderValidator(),
+				"ibm_cis_origin_certificate_order":             cis.ResourceIBMCISOriginCertificateOrderValidator(),
 				"ibm_cis_filter":                               cis.ResourceIBMCISFilterValidator(),
 				"ibm_cis_firewall_rules":                       cis.ResourceIBMCISFirewallrulesValidator(),
 				"ibm_cis_webhook":                              cis.ResourceIBMCISWebhooksValidator(),
 				"ibm_cis_alert":                                cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecordValidator(),
 				"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDnsRecordsImportValidator(),
+				"ibm_cis_dns_records_proxied":                  cis.ResourceIBMCISDNSRecordsProxiedValidator(),
 				"ibm_cis_edge_functions_action":                cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":               cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
 				"ibm_cis_global_load_balancer":                 cis.ResourceIBMCISGlbValidator(),
 				"ibm_cis_logpush_job":                          cis.ResourceIBMCISLogPushJobValidator(),
 				"ibm_cis_mtls_app":                             cis.ResourceIBMCISMtlsAppValidator(),
 				"ibm_cis_mtls":                                 cis.ResourceIBMCISMtlsValidator(),
+				"ibm_cis_custom_nameserver":                    cis.ResourceIBMCISCustomNameserverValidator(),
+				"ibm_cis_zone_custom_nameservers":              cis.ResourceIBMCISZoneCustomNameserversValidator(),
 				"ibm_cis_bot_management":                       cis.ResourceIBMCISBotManagementValidator(),
 				"ibm_cis_origin_auth":                          cis.ResourceIBMCISOriginAuthPullValidator(),
 				"ibm_cis_origin_pool":                          cis.ResourceIBMCISPoolValidator(),
@@ -1485,6 +1533,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_instance_template":                vpc.ResourceIBMISInstanceTemplateValidator(),
 				"ibm_is_instance":                         vpc.ResourceIBMISInstanceValidator(),
 				"ibm_is_instance_action":                  vpc.ResourceIBMISInstanceActionValidator(),
+				"ibm_is_instance_network_attachment":      vpc.ResourceIBMIsInstanceNetworkAttachmentValidator(),
 				"ibm_is_instance_network_interface":       vpc.ResourceIBMIsInstanceNetworkInterfaceValidator(),
 				"ibm_is_instance_disk_management":         vpc.ResourceIBMISInstanceDiskManagementValidator(),
 				"ibm_is_instance_volume_attachment":       vpc.ResourceIBMISInstanceVolumeAttachmentValidator(),
@@ -1510,6 +1559,12 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_subnet":                           vpc.ResourceIBMISSubnetValidator(),
 				"ibm_is_subnet_reserved_ip":               vpc.ResourceIBMISSubnetReservedIPValidator(),
 				"ibm_is_volume":                           vpc.ResourceIBMISVolumeValidator(),
+				"ibm_is_cluster_network":                  vpc.ResourceIBMIsClusterNetworkValidator(),
+				"ibm_is_cluster_network_interface":        vpc.ResourceIBMIsClusterNetworkInterfaceValidator(),
+				"ibm_is_cluster_network_subnet":           vpc.ResourceIBMIsClusterNetworkSubnetValidator(),
+				"ibm_is_public_address_range":             vpc.ResourceIBMIsPublicAddressRangeValidator(),
+				"ibm_is_reservation":                      vpc.ResourceIBMIsReservationValidator(),
+				"ibm_is_virtual_network_interface":        vpc.ResourceIBMIsVirtualNetworkInterfaceValidator(),
 				"ibm_is_address_prefix":                   vpc.ResourceIBMISAddressPrefixValidator(),
 				"ibm_is_vpc":                              vpc.ResourceIBMISVPCValidator(),
 				"ibm_is_vpc_routing_table":                vpc.ResourceIBMISVPCRoutingTableValidator(),
@@ -1531,6 +1586,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_resource_key":                        resourcecontroller.ResourceIBMResourceKeyValidator(),
 				"ibm_is_virtual_endpoint_gateway":         vpc.ResourceIBMISEndpointGatewayValidator(),
 				"ibm_resource_tag":                        globaltagging.ResourceIBMResourceTagValidator(),
+				"ibm_iam_access_tag":                      globaltagging.ResourceIBMIamAccessTagValidator(),
 				"ibm_satellite_location":                  satellite.ResourceIBMSatelliteLocationValidator(),
 				"ibm_satellite_cluster":                   satellite.ResourceIBMSatelliteClusterValidator(),
 				"ibm_pi_volume":                           power.ResourceIBMPIVolumeValidator(),
@@ -1545,6 +1601,11 @@ func Validator() validate.ValidatorDict {
 				"ibm_cbr_rule":                            contextbasedrestrictions.ResourceIBMCbrRuleValidator(),
 				"ibm_satellite_host":                      satellite.ResourceIBMSatelliteHostValidator(),
 
+				// Added for private path
+				"ibm_is_private_path_service_gateway":                          vpc.ResourceIBMIsPrivatePathServiceGatewayValidator(),
+				"ibm_is_private_path_service_gateway_account_policy":           vpc.ResourceIBMIsPrivatePathServiceGatewayAccountPolicyValidator(),
+				"ibm_is_private_path_service_gateway_endpoint_gateway_binding": vpc.ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingValidator(),
+
 				// Added for SCC
 				"ibm_scc_rule":                   scc.ResourceIbmSccRuleValidator(),
 				"ibm_scc_control_library":        scc.ResourceIbmSccControlLibraryValidator(),
@@ -1664,6 +1725,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_dns_records":             cis.DataSourceIBMCISDNSRecordsValidator(),
 				"ibm_cis_domain":                  cis.DataSourceIBMCISDomainValidator(),
 				"ibm_cis_certificates":            cis.DataSourceIBMCISCertificatesValidator(),
+				"ibm_cis_origin_certificates":     cis.DataSourceIBMCISOriginCertificatesValidator(),
 				"ibm_cis_edge_functions_actions":  cis.DataSourceIBMCISEdgeFunctionsActionsValidator(),
 				"ibm_cis_edge_functions_triggers": cis.DataSourceIBMCISEdgeFunctionsTriggersValidator(),
 				"ibm_cis_filters":                 cis.DataSourceIBMCISFiltersValidator(),
@@ -1682,6 +1744,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_waf_packages":            cis.DataSourceIBMCISWAFPackagesValidator(),
 				"ibm_cis_waf_rules":               cis.DataSourceIBMCISWAFRulesValidator(),
 				"ibm_cis_logpush_jobs":            cis.DataSourceIBMCISLogPushJobsValidator(),
+				"ibm_cis_logpull":                 cis.DataSourceIBMCISLogpullValidator(),
 
 				"ibm_cos_bucket": cos.DataSourceIBMCosBucketValidator(),
 
@@ -5,6 +5,7 @@ package appid
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -24,6 +25,7 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceIBMAppIDTokenConfigValidateClaims,
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Description: "The service `tenantId`",
@@ -110,6 +112,56 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 	}
 }
 
+// restricted token claims, App ID rejects mappings that override them
+var (
+	appIDReservedIDTokenClaims     = []string{"iss", "aud", "sub", "iat", "exp", "amr", "tenant"}
+	appIDReservedAccessTokenClaims = append([]string{"scope"}, appIDReservedIDTokenClaims...)
+)
+
+func resourceIBMAppIDTokenConfigValidateClaims(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateTokenClaims(diff, "access_token_claim", appIDReservedAccessTokenClaims); err != nil {
+		return err
+	}
+	return validateTokenClaims(diff, "id_token_claim", appIDReservedIDTokenClaims)
+}
+
+// validateTokenClaims checks that the claims mapped into a token don't override a restricted
+// claim and that no two mappings of the same source write the same claim. Different identity
+// providers may map to the same claim, only the one the user signed in with applies.
+func validateTokenClaims(diff *schema.ResourceDiff, key string, reserved []string) error {
+	claims, ok := diff.GetOk(key)
+	if !ok {
+		return nil
+	}
+
+	mapped := map[string]bool{}
+	for _, item := range claims.(*schema.Set).List() {
+		cMap := item.(map[string]interface{})
+
+		// the source claim is copied as is when no destination claim is given
+		claim := cMap["destination_claim"].(string)
+		if claim == "" {
+			claim = cMap["source_claim"].(string)
+		}
+		if claim == "" {
+			continue
+		}
+
+		for _, r := range reserved {
+			if claim == r {
+				return fmt.Errorf("%s: the claim %q is reserved by App ID and can't be mapped", key, claim)
+			}
+		}
+		source := cMap["source"].(string)
+		if mapped[source+"/"+claim] {
+			return fmt.Errorf("%s: the claim %q is mapped more than once from source %s", key, claim, source)
+		}
+		mapped[source+"/"+claim] = true
+	}
+
+	return nil
+}
+
 func resourceIBMAppIDTokenConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	appidClient, err := meta.(conns.ClientSession).AppIDAPI()
 
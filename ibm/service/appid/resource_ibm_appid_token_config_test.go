@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMAppIDTokenConfig_reservedClaims(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMAppIDTokenConfigClaim(acc.AppIDTenantID, "access_token_claim", "sub"),
+				ExpectError: regexp.MustCompile(`the claim "sub" is reserved by App ID`),
+			},
+			{
+				Config:      testAccCheckIBMAppIDTokenConfigClaim(acc.AppIDTenantID, "id_token_claim", "tenant"),
+				ExpectError: regexp.MustCompile(`the claim "tenant" is reserved by App ID`),
+			},
+			{
+				Config:      testAccCheckIBMAppIDTokenConfigDuplicateClaim(acc.AppIDTenantID),
+				ExpectError: regexp.MustCompile(`the claim "employeeId" is mapped more than once from source cloud_directory`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAppIDTokenConfigClaim(tenantID string, claimType string, destinationClaim string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_token_config" "test_config" {
+			tenant_id = "%s"
+
+			%s {
+				source = "cloud_directory"
+				source_claim = "employeeId"
+				destination_claim = "%s"
+			}
+		}
+	`, tenantID, claimType, destinationClaim)
+}
+
+func testAccCheckIBMAppIDTokenConfigDuplicateClaim(tenantID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_token_config" "test_config" {
+			tenant_id = "%s"
+
+			access_token_claim {
+				source = "cloud_directory"
+				source_claim = "employeeId"
+			}
+
+			access_token_claim {
+				source = "cloud_directory"
+				source_claim = "employee_number"
+				destination_claim = "employeeId"
+			}
+		}
+	`, tenantID)
+}
@@ -27,6 +27,7 @@ func ResourceIBMCdToolchainToolSecuritycompliance() *schema.Resource {
 		UpdateContext: resourceIBMCdToolchainToolSecuritycomplianceUpdate,
 		DeleteContext: resourceIBMCdToolchainToolSecuritycomplianceDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCdToolchainToolSecuritycomplianceValidateParameters,
 
 		Schema: map[string]*schema.Schema{
 			"toolchain_id": &schema.Schema{
@@ -56,14 +57,16 @@ func ResourceIBMCdToolchainToolSecuritycompliance() *schema.Resource {
 							Description: "The name for this tool integration, shown on the toolchain page.",
 						},
 						"evidence_namespace": &schema.Schema{
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The kind of pipeline evidence to be displayed in Security and Compliance Center for this toolchain. The values are; `cd` which will use evidence generated by a Continuous Deployment pipeline, or `cc` which will use evidence generated by a Continuous Compliance pipeline.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"cd", "cc"}),
+							Description:  "The kind of pipeline evidence to be displayed in Security and Compliance Center for this toolchain. The values are; `cd` which will use evidence generated by a Continuous Deployment pipeline, or `cc` which will use evidence generated by a Continuous Compliance pipeline.",
 						},
 						"use_profile_attachment": &schema.Schema{
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Set to `enabled` to enable use profile with attachment, so that the scripts in the pipeline can interact with the Security and Compliance Center service. When enabled, other parameters become relevant; `scc_api_key`, `instance_crn`, `profile_name`, `profile_version`, `attachment_id`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"disabled", "enabled"}),
+							Description:  "Set to `enabled` to enable use profile with attachment, so that the scripts in the pipeline can interact with the Security and Compliance Center service. When enabled, other parameters become relevant; `scc_api_key`, `instance_crn`, `profile_name`, `profile_version`, `attachment_id`.",
 						},
 						"scc_api_key": &schema.Schema{
 							Type:             schema.TypeString,
@@ -185,6 +188,22 @@ func ResourceIBMCdToolchainToolSecuritycomplianceValidator() *validate.ResourceV
 	return &resourceValidator
 }
 
+// resourceIBMCdToolchainToolSecuritycomplianceValidateParameters checks at plan time that the profile
+// attachment parameters are set when use_profile_attachment is enabled, the tool is otherwise created
+// misconfigured and the compliance pipelines fail on their first run.
+func resourceIBMCdToolchainToolSecuritycomplianceValidateParameters(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("parameters.0.use_profile_attachment") || diff.Get("parameters.0.use_profile_attachment").(string) != "enabled" {
+		return nil
+	}
+	for _, parameter := range []string{"scc_api_key", "profile_name", "profile_version", "attachment_id"} {
+		key := fmt.Sprintf("parameters.0.%s", parameter)
+		if diff.NewValueKnown(key) && diff.Get(key).(string) == "" {
+			return fmt.Errorf("[ERROR] parameters.0.%s must be set when parameters.0.use_profile_attachment is enabled", parameter)
+		}
+	}
+	return nil
+}
+
 func resourceIBMCdToolchainToolSecuritycomplianceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
 	if err != nil {
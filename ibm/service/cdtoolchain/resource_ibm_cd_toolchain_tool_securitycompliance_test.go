@@ -5,6 +5,7 @@ package cdtoolchain_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -74,6 +75,22 @@ func TestAccIBMCdToolchainToolSecuritycomplianceAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCdToolchainToolSecuritycomplianceProfileAttachmentMissingParameters(t *testing.T) {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMCdToolchainToolSecuritycomplianceConfigProfileAttachment(tcName, rgName),
+				ExpectError: regexp.MustCompile("parameters.0.profile_name must be set when parameters.0.use_profile_attachment is enabled"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCdToolchainToolSecuritycomplianceConfigBasic(tcName string, rgName string) string {
 	return fmt.Sprintf(`
 		data "ibm_resource_group" "resource_group" {
@@ -184,3 +201,27 @@ func testAccCheckIBMCdToolchainToolSecuritycomplianceDestroy(s *terraform.State)
 
 	return nil
 }
+
+func testAccCheckIBMCdToolchainToolSecuritycomplianceConfigProfileAttachment(tcName string, rgName string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+
+		resource "ibm_cd_toolchain_tool_securitycompliance" "cd_toolchain_tool_securitycompliance" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "compliance"
+				evidence_namespace = "cc"
+				evidence_repo_url = "https://github.example.com/<username>/compliance-evidence-<datestamp>"
+				use_profile_attachment = "enabled"
+				scc_api_key = "{vault::sm-compliance-secrets.scc-api-key}"
+			}
+		}
+	`, rgName, tcName)
+}
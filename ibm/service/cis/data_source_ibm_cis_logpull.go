@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	cisLogpullStart  = "start"
+	cisLogpullEnd    = "end"
+	cisLogpullFields = "fields"
+	cisLogpullSample = "sample"
+	cisLogpullLimit  = "limit"
+	cisLogpullLogs   = "logs"
+
+	// the logpull API only serves windows of up to an hour
+	cisLogpullMaxWindow = time.Hour
+)
+
+func DataSourceIBMCISLogpull() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCISLogpullRead,
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_logpull",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisLogpullStart: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Datetime for start of the logs window, inclusive",
+			},
+			cisLogpullEnd: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Datetime for end of the logs window, exclusive",
+			},
+			cisLogpullFields: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Log fields to be returned, for example ClientIP, ClientRequestURI, EdgeResponseStatus and RayID",
+			},
+			cisLogpullSample: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0.001, 1),
+				Description:  "Fraction of the logs to be returned, between 0.001 and 1",
+			},
+			cisLogpullLimit: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 10000),
+				Description:  "Maximum number of log lines to be returned",
+			},
+			cisLogpullLogs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "HTTP request logs, one JSON encoded object per request",
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISLogpullValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	iBMCISLogpullValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_logpull",
+		Schema:       validateSchema}
+	return &iBMCISLogpullValidator
+}
+
+func dataSourceIBMCISLogpullRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisLogpushJobsSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	start := d.Get(cisLogpullStart).(string)
+	end := d.Get(cisLogpullEnd).(string)
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return err
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return err
+	}
+	if !endTime.After(startTime) {
+		return fmt.Errorf("[ERROR] %s must be later than %s", cisLogpullEnd, cisLogpullStart)
+	}
+	if endTime.Sub(startTime) > cisLogpullMaxWindow {
+		return fmt.Errorf("[ERROR] The window between %s and %s can't exceed %s", cisLogpullStart, cisLogpullEnd, cisLogpullMaxWindow)
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(sess.Service.GetServiceURL(), `/v1/{crn}/zones/{zone_id}/logs/received`,
+		map[string]string{"crn": crn, "zone_id": zoneID})
+	if err != nil {
+		return err
+	}
+	builder.AddQuery("start", start)
+	builder.AddQuery("end", end)
+	builder.AddQuery("timestamps", "rfc3339")
+	builder.AddQuery("count", strconv.Itoa(d.Get(cisLogpullLimit).(int)))
+	if fields, ok := d.GetOk(cisLogpullFields); ok {
+		builder.AddQuery("fields", strings.Join(flex.ExpandStringList(fields.([]interface{})), ","))
+	}
+	if sample, ok := d.GetOk(cisLogpullSample); ok {
+		builder.AddQuery("sample", strconv.FormatFloat(sample.(float64), 'f', -1, 64))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return err
+	}
+
+	var body io.ReadCloser
+	resp, err := sess.Service.Request(request, &body)
+	if err != nil {
+		log.Printf("[WARN] Get logs failed: %v\n", resp)
+		return err
+	}
+	defer body.Close()
+
+	// logs are returned as newline delimited JSON
+	logs := make([]string, 0)
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !json.Valid([]byte(line)) {
+			return fmt.Errorf("[ERROR] Unexpected log line %q", line)
+		}
+		logs = append(logs, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("[ERROR] Error reading logs: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", zoneID, start, end))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisLogpullLogs, logs)
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisLogpullDataSource_Basic(t *testing.T) {
+	name := "data.ibm_cis_logpull.test"
+	end := time.Now().UTC().Add(-5 * time.Minute).Truncate(time.Minute)
+	start := end.Add(-30 * time.Minute)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisLogpullDataSource_basic("test", start.Format(time.RFC3339), end.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisLogpullDataSource_basic(id, start, end string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	data "ibm_cis_logpull" "%[1]s" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		start     = "%[2]s"
+		end       = "%[3]s"
+		fields    = ["ClientIP", "ClientRequestURI", "EdgeResponseStatus", "RayID"]
+		limit     = 10
+	  }
+`, id, start, end)
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisOriginCertificateList = "origin_certificate_list"
+)
+
+func DataSourceIBMCISOriginCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCISOriginCertificatesRead,
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_origin_certificates",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisOriginCertificateList: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Origin certificates of the domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisOriginCertificateID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Origin certificate ID",
+						},
+						cisOriginCertificate: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PEM encoded origin CA certificate",
+						},
+						cisOriginCertificateHostnames: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Hostnames or wildcard names bound to the certificate",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						cisOriginCertificateRequestType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Signature type of the certificate",
+						},
+						cisOriginCertificateRequestedValidity: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of days for which the certificate is valid",
+						},
+						cisOriginCertificateExpiresOn: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Certificate expiration time",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISOriginCertificatesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	iBMCISOriginCertificatesValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_origin_certificates",
+		Schema:       validateSchema}
+	return &iBMCISOriginCertificatesValidator
+}
+
+func dataSourceIBMCISOriginCertificatesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisSSLClientSession %s", err))
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	result := &cisOriginCertificatesResp{}
+	resp, err := cisOriginCertificateRequest(context, cisClient, core.GET, `/v1/{crn}/zones/{zone_identifier}/origin_certificates`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID}, nil, result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing origin certificates: %v %v", err, resp))
+	}
+
+	certificates := make([]map[string]interface{}, 0)
+	for _, instance := range result.Result {
+		certificates = append(certificates, map[string]interface{}{
+			cisOriginCertificateID:                core.StringNilMapper(instance.ID),
+			cisOriginCertificate:                  core.StringNilMapper(instance.Certificate),
+			cisOriginCertificateHostnames:         instance.Hostnames,
+			cisOriginCertificateRequestType:       core.StringNilMapper(instance.RequestType),
+			cisOriginCertificateRequestedValidity: flex.IntValue(instance.RequestedValidity),
+			cisOriginCertificateExpiresOn:         core.StringNilMapper(instance.ExpiresOn),
+		})
+	}
+
+	d.SetId(dataSourceIBMCISOriginCertificatesID(d))
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	if err := d.Set(cisOriginCertificateList, certificates); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", cisOriginCertificateList, err))
+	}
+	return nil
+}
+
+func dataSourceIBMCISOriginCertificatesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
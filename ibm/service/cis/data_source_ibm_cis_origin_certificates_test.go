@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisOriginCertificatesDataSource_basic(t *testing.T) {
+	node := "data.ibm_cis_origin_certificates.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisOriginCertificatesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "origin_certificate_list.0.certificate_id"),
+					resource.TestCheckResourceAttrSet(node, "origin_certificate_list.0.certificate"),
+					resource.TestCheckResourceAttrSet(node, "origin_certificate_list.0.expires_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisOriginCertificatesDataSourceConfig() string {
+	return testAccCheckCisOriginCertificateOrderConfigBasic("origin-rsa") + `
+	data "ibm_cis_origin_certificates" "test" {
+		cis_id    = ibm_cis_origin_certificate_order.test.cis_id
+		domain_id = ibm_cis_origin_certificate_order.test.domain_id
+	  }`
+}
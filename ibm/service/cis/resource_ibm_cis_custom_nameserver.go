@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISCustomNameserver           = "ibm_cis_custom_nameserver"
+	cisCustomNameserverName          = "ns_name"
+	cisCustomNameserverSet           = "ns_set"
+	cisCustomNameserverStatus        = "status"
+	cisCustomNameserverDNSRecords    = "dns_records"
+	cisCustomNameserverDNSRecordType = "type"
+	cisCustomNameserverDNSRecordIP   = "value"
+)
+
+// cisCustomNameserver is an instance level custom nameserver as returned by the CIS API.
+type cisCustomNameserver struct {
+	NsName     *string `json:"ns_name,omitempty"`
+	NsSet      *int64  `json:"ns_set,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	DNSRecords []struct {
+		Type  *string `json:"type,omitempty"`
+		Value *string `json:"value,omitempty"`
+	} `json:"dns_records,omitempty"`
+}
+
+func ResourceIBMCISCustomNameserver() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISCustomNameserverCreate,
+		ReadContext:   resourceIBMCISCustomNameserverRead,
+		DeleteContext: resourceIBMCISCustomNameserverDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCustomNameserver,
+					"cis_id"),
+			},
+			cisCustomNameserverName: {
+				Type:        schema.TypeString,
+				Description: "The FQDN of the custom nameserver, a subdomain of a zone of the instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			cisCustomNameserverSet: {
+				Type:        schema.TypeInt,
+				Description: "The nameserver set the custom nameserver belongs to",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCustomNameserver,
+					cisCustomNameserverSet),
+			},
+			cisCustomNameserverStatus: {
+				Type:        schema.TypeString,
+				Description: "The verification status of the custom nameserver",
+				Computed:    true,
+			},
+			cisCustomNameserverDNSRecords: {
+				Type:        schema.TypeList,
+				Description: "The A and AAAA records of the custom nameserver, to publish as glue records at the registrar",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisCustomNameserverDNSRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNS record type, A or AAAA",
+						},
+						cisCustomNameserverDNSRecordIP: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the custom nameserver",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISCustomNameserverValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisCustomNameserverSet,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "5"})
+	ibmCISCustomNameserverValidator := validate.ResourceValidator{
+		ResourceName: ibmCISCustomNameserver,
+		Schema:       validateSchema}
+	return &ibmCISCustomNameserverValidator
+}
+
+func resourceIBMCISCustomNameserverCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	crn := d.Get(cisID).(string)
+	nsName := d.Get(cisCustomNameserverName).(string)
+	body := map[string]interface{}{
+		"ns_name": nsName,
+	}
+	if nsSet, ok := d.GetOk(cisCustomNameserverSet); ok {
+		body["ns_set"] = nsSet.(int)
+	}
+
+	response, err := cisRequest(context, cisClient.Service, core.POST, `/v1/{crn}/custom_ns`,
+		map[string]string{"crn": crn}, body, &cisCustomNameserver{})
+	if err != nil {
+		log.Printf("[DEBUG] CreateCustomNameserver failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating custom nameserver %s: %s\n%s", nsName, err, response))
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(nsName, crn))
+	return resourceIBMCISCustomNameserverRead(context, d, meta)
+}
+
+func resourceIBMCISCustomNameserverRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nsName, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// There is no get of a single custom nameserver, the instance has only a few.
+	nameservers := []cisCustomNameserver{}
+	response, err := cisRequest(context, cisClient.Service, core.GET, `/v1/{crn}/custom_ns`,
+		map[string]string{"crn": crn}, nil, &nameservers)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListCustomNameservers failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading custom nameserver %s: %s\n%s", nsName, err, response))
+	}
+
+	var nameserver *cisCustomNameserver
+	for i := range nameservers {
+		if nameservers[i].NsName != nil && *nameservers[i].NsName == nsName {
+			nameserver = &nameservers[i]
+			break
+		}
+	}
+	if nameserver == nil {
+		d.SetId("")
+		return nil
+	}
+
+	dnsRecords := []map[string]interface{}{}
+	for _, record := range nameserver.DNSRecords {
+		dnsRecords = append(dnsRecords, map[string]interface{}{
+			cisCustomNameserverDNSRecordType: core.StringNilMapper(record.Type),
+			cisCustomNameserverDNSRecordIP:   core.StringNilMapper(record.Value),
+		})
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisCustomNameserverName, nsName)
+	d.Set(cisCustomNameserverSet, flex.IntValue(nameserver.NsSet))
+	d.Set(cisCustomNameserverStatus, nameserver.Status)
+	if err = d.Set(cisCustomNameserverDNSRecords, dnsRecords); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", cisCustomNameserverDNSRecords, err))
+	}
+	return nil
+}
+
+func resourceIBMCISCustomNameserverDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nsName, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := cisRequest(context, cisClient.Service, core.DELETE, `/v1/{crn}/custom_ns/{custom_ns_id}`,
+		map[string]string{"crn": crn, "custom_ns_id": nsName}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteCustomNameserver failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting custom nameserver %s: %s\n%s", nsName, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCisCustomNameserver_Basic(t *testing.T) {
+	name := "ibm_cis_custom_nameserver.test"
+	zoneName := "ibm_cis_zone_custom_nameservers.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisCustomNameserverConfigBasic(acc.CisDomainStatic, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ns_name", "ns1."+acc.CisDomainStatic),
+					resource.TestCheckResourceAttr(name, "ns_set", "1"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet(name, "dns_records.0.value"),
+					resource.TestCheckResourceAttr(zoneName, "enabled", "true"),
+					resource.TestCheckResourceAttr(zoneName, "ns_set", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisCustomNameserverConfigBasic(acc.CisDomainStatic, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(zoneName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      zoneName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisCustomNameserverConfigBasic(domain string, enabled bool) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_custom_nameserver" "test" {
+		cis_id  = data.ibm_cis.cis.id
+		ns_name = "ns1.%[1]s"
+		ns_set  = 1
+	}
+
+	resource "ibm_cis_zone_custom_nameservers" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.id
+		enabled   = %[2]t
+		ns_set    = ibm_cis_custom_nameserver.test.ns_set
+	}
+`, domain, enabled)
+}
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -58,6 +59,8 @@ func ResourceIBMCISDnsRecord() *schema.Resource {
 		Exists:   ResourceIBMCISDnsRecordExist,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMCISDnsRecordValidateProxied,
+
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -113,13 +116,13 @@ func ResourceIBMCISDnsRecord() *schema.Resource {
 				Default:     false,
 				Optional:    true,
 				Type:        schema.TypeBool,
-				Description: "Boolean value true if proxied else flase",
+				Description: "Boolean value true if proxied else false, only A, AAAA and CNAME records can be proxied",
 			},
 			cisDNSRecordTTL: {
 				Optional:    true,
 				Type:        schema.TypeInt,
 				Default:     1,
-				Description: "TTL value",
+				Description: "TTL value, 1 is automatic and is the only value allowed for proxied records",
 			},
 			cisDNSRecordCreatedOn: {
 				Type:     schema.TypeString,
@@ -156,6 +159,27 @@ func ResourceIBMCISDnsRecordValidator() *validate.ResourceValidator {
 		Schema:       validateSchema}
 	return &ibmCISDNSRecordValidator
 }
+
+// resourceIBMCISDnsRecordValidateProxied rejects the combinations the API silently coerces, which would
+// otherwise show as a permanent diff: proxied records always get the automatic TTL, and the proxied
+// flag is ignored for records that can't be proxied.
+func resourceIBMCISDnsRecordValidateProxied(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(cisDNSRecordProxied) || !diff.Get(cisDNSRecordProxied).(bool) {
+		return nil
+	}
+	if diff.NewValueKnown(cisDNSRecordType) {
+		switch recordType := diff.Get(cisDNSRecordType).(string); recordType {
+		case cisDNSRecordTypeA, cisDNSRecordTypeAAAA, cisDNSRecordTypeCNAME:
+		default:
+			return fmt.Errorf("[ERROR] %s records can't be proxied, only %s, %s and %s records can", recordType, cisDNSRecordTypeA, cisDNSRecordTypeAAAA, cisDNSRecordTypeCNAME)
+		}
+	}
+	if diff.NewValueKnown(cisDNSRecordTTL) && diff.Get(cisDNSRecordTTL).(int) != cisDNSRecordTTLAutomatic {
+		return fmt.Errorf("[ERROR] %s must be %d (automatic) when %s is true, got %d", cisDNSRecordTTL, cisDNSRecordTTLAutomatic, cisDNSRecordProxied, diff.Get(cisDNSRecordTTL).(int))
+	}
+	return nil
+}
+
 func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
 
 	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
@@ -5,6 +5,7 @@ package cis_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -186,6 +187,25 @@ func TestAccIBMCisDNSRecord_CreateAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisDNSRecord_ProxiedTTL(t *testing.T) {
+	testName := "tf-acctest-proxied-ttl"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCisDNSRecordConfigProxiedTTL(testName, "A", "192.168.0.10", 300),
+				ExpectError: regexp.MustCompile("ttl must be 1 \\(automatic\\) when proxied is true"),
+			},
+			{
+				Config:      testAccCheckIBMCisDNSRecordConfigProxiedTTL(testName, "TXT", "proxied-ttl", 1),
+				ExpectError: regexp.MustCompile("TXT records can't be proxied"),
+			},
+		},
+	})
+}
+
 func testAccIBMCisManuallyDeleteDNSRecord(tfRecordID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		cisClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CisDNSRecordClientSession()
@@ -378,3 +398,18 @@ func testAccCheckIBMCisDNSRecordConfigProxied(resourceID string, CisDomainStatic
 	  }
 `, resourceID)
 }
+
+func testAccCheckIBMCisDNSRecordConfigProxiedTTL(resourceID string, recordType string, content string, ttl int) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_dns_record" "%[1]s" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+
+		name    = "%[1]s"
+		content = "%[3]s"
+		type    = "%[2]s"
+		proxied = true
+		ttl     = %[4]d
+	  }
+	  `, resourceID, recordType, content, ttl)
+}
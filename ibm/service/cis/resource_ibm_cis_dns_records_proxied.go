@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSRecordsProxiedRecordIDs       = "record_ids"
+	cisDNSRecordsProxiedRecords         = "records"
+	cisDNSRecordsProxiedOriginalProxied = "original_proxied"
+
+	// the automatic TTL, the only TTL the API keeps for proxied records
+	cisDNSRecordTTLAutomatic = 1
+)
+
+func ResourceIBMCISDNSRecordsProxied() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCISDNSRecordsProxiedUpdate,
+		Read:   resourceIBMCISDNSRecordsProxiedRead,
+		Update: resourceIBMCISDNSRecordsProxiedUpdate,
+		Delete: resourceIBMCISDNSRecordsProxiedDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCISDNSRecordsProxiedCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_records_proxied",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSRecordsProxiedRecordIDs: {
+				Type:        schema.TypeSet,
+				Description: "IDs of the DNS records to set the proxied status of, only proxiable A, AAAA and CNAME records are allowed",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			cisDNSRecordProxied: {
+				Type:        schema.TypeBool,
+				Description: "Proxied status to set on the DNS records",
+				Required:    true,
+			},
+			cisDNSRecordsProxiedOriginalProxied: {
+				Type:        schema.TypeMap,
+				Description: "Proxied status of the DNS records before they were added to the resource, restored when they are removed from it",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			cisDNSRecordsProxiedRecords: {
+				Type:        schema.TypeList,
+				Description: "DNS records of record_ids",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record ID",
+						},
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record name",
+						},
+						cisDNSRecordType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record type",
+						},
+						cisDNSRecordProxied: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Boolean value true if proxied else false",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL value",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDNSRecordsProxiedValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISDNSRecordsProxiedValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_records_proxied",
+		Schema:       validateSchema}
+	return &ibmCISDNSRecordsProxiedValidator
+}
+
+// resourceIBMCISDNSRecordsProxiedCustomizeDiff plans an update when the last refresh found records
+// whose proxied status was changed outside of the resource, so that the next apply sets it again.
+func resourceIBMCISDNSRecordsProxiedCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	proxied := diff.Get(cisDNSRecordProxied).(bool)
+	for _, r := range diff.Get(cisDNSRecordsProxiedRecords).([]interface{}) {
+		record := r.(map[string]interface{})
+		if record[cisDNSRecordProxied].(bool) != proxied {
+			return diff.SetNewComputed(cisDNSRecordsProxiedRecords)
+		}
+	}
+	return nil
+}
+
+func resourceIBMCISDNSRecordsProxiedUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+	proxied := d.Get(cisDNSRecordProxied).(bool)
+
+	oldIDs, newIDs := d.GetChange(cisDNSRecordsProxiedRecordIDs)
+	if d.Id() == "" {
+		d.SetId(flex.ConvertCisToTfThreeVar(cisDNSRecordsProxiedKey(newIDs.(*schema.Set)), zoneID, crn))
+	}
+
+	// kept in the state on errors too, so that the records already updated are restored on delete
+	original := map[string]interface{}{}
+	for id, v := range d.Get(cisDNSRecordsProxiedOriginalProxied).(map[string]interface{}) {
+		original[id] = v
+	}
+	defer d.Set(cisDNSRecordsProxiedOriginalProxied, original)
+
+	// records removed from the resource get their original proxied status back
+	for _, id := range flex.ExpandStringList(oldIDs.(*schema.Set).Difference(newIDs.(*schema.Set)).List()) {
+		if v, ok := original[id]; ok {
+			if err := cisDNSRecordsProxiedRestore(sess, id, v.(bool)); err != nil {
+				return err
+			}
+			delete(original, id)
+		}
+	}
+
+	for _, id := range flex.ExpandStringList(newIDs.(*schema.Set).List()) {
+		record, response, err := cisDNSRecordsProxiedGet(sess, id)
+		if err != nil {
+			log.Printf("Error reading dns record %s: %s", id, response)
+			return fmt.Errorf("[ERROR] Error reading dns record %s: %s", id, err)
+		}
+		if record.Proxiable == nil || !*record.Proxiable {
+			return fmt.Errorf("[ERROR] dns record %s (%s) of type %s can't be proxied", id, *record.Name, *record.Type)
+		}
+		if _, ok := original[id]; !ok {
+			original[id] = *record.Proxied
+		}
+		if *record.Proxied != proxied {
+			if err := cisDNSRecordsProxiedSet(sess, record, proxied); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceIBMCISDNSRecordsProxiedRead(d, meta)
+}
+
+func resourceIBMCISDNSRecordsProxiedRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+
+	_, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	recordList := make([]map[string]interface{}, 0)
+	for _, id := range flex.ExpandStringList(d.Get(cisDNSRecordsProxiedRecordIDs).(*schema.Set).List()) {
+		record, response, err := cisDNSRecordsProxiedGet(sess, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("[WARN] dns record %s of ibm_cis_dns_records_proxied %s no longer exists", id, d.Id())
+				continue
+			}
+			log.Printf("Error reading dns record %s: %s", id, response)
+			return fmt.Errorf("[ERROR] Error reading dns record %s: %s", id, err)
+		}
+		recordList = append(recordList, map[string]interface{}{
+			cisDNSRecordID:      *record.ID,
+			cisDNSRecordName:    *record.Name,
+			cisDNSRecordType:    *record.Type,
+			cisDNSRecordProxied: *record.Proxied,
+			cisDNSRecordTTL:     *record.TTL,
+		})
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSRecordsProxiedRecords, recordList)
+	return nil
+}
+
+func resourceIBMCISDNSRecordsProxiedDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+
+	_, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	// the records get back the proxied status they had before they were added to the resource
+	for id, v := range d.Get(cisDNSRecordsProxiedOriginalProxied).(map[string]interface{}) {
+		if err := cisDNSRecordsProxiedRestore(sess, id, v.(bool)); err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// cisDNSRecordsProxiedKey returns the first part of the ID, derived from the record IDs so that two
+// resources on the same domain don't share an ID.
+func cisDNSRecordsProxiedKey(ids *schema.Set) string {
+	list := flex.ExpandStringList(ids.List())
+	sort.Strings(list)
+	return fmt.Sprintf("%d", conns.String(strings.Join(list, ",")))
+}
+
+func cisDNSRecordsProxiedGet(sess *dnsrecordsv1.DnsRecordsV1, id string) (*dnsrecordsv1.DnsrecordDetails, *core.DetailedResponse, error) {
+	result, response, err := sess.GetDnsRecord(sess.NewGetDnsRecordOptions(id))
+	if err != nil {
+		return nil, response, err
+	}
+	return result.Result, response, nil
+}
+
+// cisDNSRecordsProxiedRestore sets the proxied status of a record back, a record deleted in the
+// meantime is skipped.
+func cisDNSRecordsProxiedRestore(sess *dnsrecordsv1.DnsRecordsV1, id string, proxied bool) error {
+	record, response, err := cisDNSRecordsProxiedGet(sess, id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("Error reading dns record %s: %s", id, response)
+		return fmt.Errorf("[ERROR] Error reading dns record %s: %s", id, err)
+	}
+	if *record.Proxied == proxied {
+		return nil
+	}
+	return cisDNSRecordsProxiedSet(sess, record, proxied)
+}
+
+func cisDNSRecordsProxiedSet(sess *dnsrecordsv1.DnsRecordsV1, record *dnsrecordsv1.DnsrecordDetails, proxied bool) error {
+	opt := sess.NewUpdateDnsRecordOptions(*record.ID)
+	opt.SetName(*record.Name)
+	opt.SetType(*record.Type)
+	opt.SetContent(*record.Content)
+	opt.SetProxied(proxied)
+	if proxied {
+		opt.SetTTL(cisDNSRecordTTLAutomatic)
+	} else {
+		opt.SetTTL(*record.TTL)
+	}
+	_, response, err := sess.UpdateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error updating dns record %s: %s", *record.Name, response)
+		return fmt.Errorf("[ERROR] Error updating proxied status of dns record %s: %s", *record.Name, err)
+	}
+	return nil
+}
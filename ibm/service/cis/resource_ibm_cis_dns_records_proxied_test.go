@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSRecordsProxied_Basic(t *testing.T) {
+	name := "ibm_cis_dns_records_proxied.test"
+	recordName := "ibm_cis_dns_record.tf-acctest-proxied"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCis(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCisDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSRecordsProxiedConfigBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "proxied", "true"),
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "records.0.record_id", recordName, "record_id"),
+					resource.TestCheckResourceAttr(name, "records.0.proxied", "true"),
+					resource.TestCheckResourceAttr(name, "records.0.ttl", "1"),
+					resource.TestCheckResourceAttr(name, "original_proxied.%", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSRecordsProxiedConfigBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "proxied", "false"),
+					resource.TestCheckResourceAttr(name, "records.0.proxied", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDNSRecordsProxiedConfigBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.0.proxied", "true"),
+				),
+			},
+			{
+				// deleting the resource restores the proxied status the record had before
+				Config: testAccCheckIBMCisDNSRecordsProxiedConfigRecord(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "proxied", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSRecordsProxiedConfigRecord() string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + `
+	resource "ibm_cis_dns_record" "tf-acctest-proxied" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+
+		name    = "tf-acctest-proxied"
+		content = "192.168.0.10"
+		type    = "A"
+
+		lifecycle {
+			ignore_changes = [proxied, ttl]
+		}
+	}
+	`
+}
+
+func testAccCheckIBMCisDNSRecordsProxiedConfigBasic(proxied bool) string {
+	return testAccCheckIBMCisDNSRecordsProxiedConfigRecord() + fmt.Sprintf(`
+	resource "ibm_cis_dns_records_proxied" "test" {
+		cis_id     = data.ibm_cis.cis.id
+		domain_id  = data.ibm_cis_domain.cis_domain.domain_id
+		record_ids = [ibm_cis_dns_record.tf-acctest-proxied.record_id]
+		proxied    = %t
+	}
+	`, proxied)
+}
@@ -4,9 +4,11 @@
 package cis
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonessettingsv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -17,6 +19,8 @@ import (
 const (
 	ibmCISDomainSettings                             = "ibm_cis_domain_settings"
 	cisDomainSettingsDNSSEC                          = "dnssec"
+	cisDomainSettingsDNSSECDS                        = "dnssec_ds"
+	cisDomainSettingsDNSSECMultiSigner               = "dnssec_multi_signer"
 	cisDomainSettingsWAF                             = "waf"
 	cisDomainSettingsSSL                             = "ssl"
 	cisDomainSettingsCertificateStatus               = "certificate_status"
@@ -96,6 +100,66 @@ func ResourceIBMCISSettings() *schema.Resource {
 					ibmCISDomainSettings,
 					cisDomainSettingsDNSSEC),
 			},
+			cisDomainSettingsDNSSECMultiSigner: {
+				Type:        schema.TypeBool,
+				Description: "Multi-signer DNSSEC, so the zone can be signed by CIS and another DNS provider at the same time",
+				Optional:    true,
+				Computed:    true,
+			},
+			cisDomainSettingsDNSSECDS: {
+				Type:        schema.TypeList,
+				Description: "The DS record of the zone, to publish at the registrar or parent zone once DNSSEC is active",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ds": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full DS record",
+						},
+						"digest": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The digest of the DNSKEY",
+						},
+						"digest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The digest type",
+						},
+						"digest_algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The digest algorithm",
+						},
+						"algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNSKEY algorithm",
+						},
+						"key_tag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The key tag",
+						},
+						"key_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key type",
+						},
+						"flags": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The DNSKEY flags",
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNSKEY public key",
+						},
+					},
+				},
+			},
 			cisDomainSettingsWAF: {
 				Type:        schema.TypeString,
 				Description: "WAF setting",
@@ -783,11 +847,18 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		switch item {
 		case cisDomainSettingsDNSSEC:
-			if d.HasChange(item) {
+			if d.HasChange(item) || d.HasChange(cisDomainSettingsDNSSECMultiSigner) {
+				// The SDK has no multi-signer field, the dnssec settings are patched with a raw request.
+				body := map[string]interface{}{}
 				if v, ok := d.GetOk(item); ok {
-					opt := cisClient.NewUpdateZoneDnssecOptions()
-					opt.SetStatus(v.(string))
-					_, resp, err = cisClient.UpdateZoneDnssec(opt)
+					body["status"] = v.(string)
+				}
+				if v, ok := d.GetOkExists(cisDomainSettingsDNSSECMultiSigner); ok {
+					body["dnssec_multi_signer"] = v.(bool)
+				}
+				if len(body) > 0 {
+					resp, err = cisRequest(context.Background(), cisClient.Service, core.PATCH, `/v1/{crn}/zones/{zone_identifier}/dnssec`,
+						map[string]string{"crn": cisID, "zone_identifier": zoneID}, body, &cisZoneDnssec{})
 				}
 			}
 		case cisDomainSettingsWAF:
@@ -1103,10 +1174,13 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		var settingResponse *core.DetailedResponse
 		switch item {
 		case cisDomainSettingsDNSSEC:
-			opt := cisClient.NewGetZoneDnssecOptions()
-			result, resp, err := cisClient.GetZoneDnssec(opt)
+			result := &cisZoneDnssec{}
+			resp, err := cisRequest(context.Background(), cisClient.Service, core.GET, `/v1/{crn}/zones/{zone_identifier}/dnssec`,
+				map[string]string{"crn": crn, "zone_identifier": zoneID}, nil, result)
 			if err == nil {
-				d.Set(cisDomainSettingsDNSSEC, result.Result.Status)
+				d.Set(cisDomainSettingsDNSSEC, result.Status)
+				d.Set(cisDomainSettingsDNSSECMultiSigner, result.DnssecMultiSigner)
+				d.Set(cisDomainSettingsDNSSECDS, flattenCISDomainSettingsDNSSECDS(&result.ZonesDnssecRespResult))
 			}
 			settingResponse = resp
 			settingErr = err
@@ -1448,3 +1522,28 @@ func resourceCISSettingsDelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// cisZoneDnssec is the zone DNSSEC settings with the multi-signer flag the SDK model is missing.
+type cisZoneDnssec struct {
+	zonessettingsv1.ZonesDnssecRespResult
+	DnssecMultiSigner *bool `json:"dnssec_multi_signer,omitempty"`
+}
+
+// flattenCISDomainSettingsDNSSECDS returns the DS record of the zone, empty until DNSSEC is active
+// and the record is generated.
+func flattenCISDomainSettingsDNSSECDS(result *zonessettingsv1.ZonesDnssecRespResult) []map[string]interface{} {
+	if result == nil || result.Ds == nil || *result.Ds == "" {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{{
+		"ds":               core.StringNilMapper(result.Ds),
+		"digest":           core.StringNilMapper(result.Digest),
+		"digest_type":      core.StringNilMapper(result.DigestType),
+		"digest_algorithm": core.StringNilMapper(result.DigestAlgorithm),
+		"algorithm":        core.StringNilMapper(result.Algorithm),
+		"key_tag":          flex.IntValue(result.KeyTag),
+		"key_type":         core.StringNilMapper(result.KeyType),
+		"flags":            flex.IntValue(result.Flags),
+		"public_key":       core.StringNilMapper(result.PublicKey),
+	}}
+}
@@ -53,6 +53,26 @@ func TestAccIBMCisSettings_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisSettings_DNSSEC(t *testing.T) {
+	name := "ibm_cis_domain_settings." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisSettingsConfigDNSSEC("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "dnssec"),
+					resource.TestCheckResourceAttr(name, "dnssec_multi_signer", "true"),
+					resource.TestCheckResourceAttrSet(name, "dnssec_ds.0.ds"),
+					resource.TestCheckResourceAttrSet(name, "dnssec_ds.0.key_tag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCisSettings_Import(t *testing.T) {
 	name := "ibm_cis_domain_settings." + "test"
 
@@ -161,3 +181,14 @@ func testAccCheckCisSettingsConfigBasic4(id string, CisDomainStatic string) stri
 	  }
 `, id)
 }
+
+func testAccCheckCisSettingsConfigDNSSEC(id string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_domain_settings" "%[1]s" {
+		cis_id              = data.ibm_cis.cis.id
+		domain_id           = data.ibm_cis_domain.cis_domain.id
+		dnssec              = "active"
+		dnssec_multi_signer = true
+	  }
+`, id)
+}
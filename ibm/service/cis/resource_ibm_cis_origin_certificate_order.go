@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	cissslv1 "github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISOriginCertificateOrder          = "ibm_cis_origin_certificate_order"
+	cisOriginCertificateID                = "certificate_id"
+	cisOriginCertificateHostnames         = "hostnames"
+	cisOriginCertificateRequestType       = "request_type"
+	cisOriginCertificateRequestedValidity = "requested_validity"
+	cisOriginCertificateCSR               = "csr"
+	cisOriginCertificate                  = "certificate"
+	cisOriginCertificatePrivateKey        = "private_key"
+	cisOriginCertificateExpiresOn         = "expires_on"
+	cisOriginCertificateTypeRSA           = "origin-rsa"
+	cisOriginCertificateTypeECC           = "origin-ecc"
+)
+
+// cisOriginCertificateResult is the origin CA certificate returned by the CIS API.
+// The networking SDK does not model origin CA certificates yet.
+type cisOriginCertificateResult struct {
+	ID                *string  `json:"id,omitempty"`
+	Certificate       *string  `json:"certificate,omitempty"`
+	Hostnames         []string `json:"hostnames,omitempty"`
+	ExpiresOn         *string  `json:"expires_on,omitempty"`
+	RequestType       *string  `json:"request_type,omitempty"`
+	RequestedValidity *int64   `json:"requested_validity,omitempty"`
+	CSR               *string  `json:"csr,omitempty"`
+}
+
+type cisOriginCertificateResp struct {
+	Success *bool                       `json:"success,omitempty"`
+	Result  *cisOriginCertificateResult `json:"result,omitempty"`
+}
+
+type cisOriginCertificatesResp struct {
+	Success *bool                        `json:"success,omitempty"`
+	Result  []cisOriginCertificateResult `json:"result,omitempty"`
+}
+
+func ResourceIBMCISOriginCertificateOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISOriginCertificateOrderCreate,
+		ReadContext:   resourceIBMCISOriginCertificateOrderRead,
+		DeleteContext: resourceIBMCISOriginCertificateOrderDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISOriginCertificateOrder,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisOriginCertificateHostnames: {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				ForceNew:    true,
+				Description: "Hostnames or wildcard names bound to the certificate",
+			},
+			cisOriginCertificateRequestType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     cisOriginCertificateTypeRSA,
+				Description: "Signature type desired on the certificate, origin-rsa or origin-ecc",
+				ValidateFunc: validate.InvokeValidator(ibmCISOriginCertificateOrder,
+					cisOriginCertificateRequestType),
+			},
+			cisOriginCertificateRequestedValidity: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     5475,
+				Description: "Number of days for which the certificate is valid",
+				ValidateFunc: validate.InvokeValidator(ibmCISOriginCertificateOrder,
+					cisOriginCertificateRequestedValidity),
+			},
+			cisOriginCertificateCSR: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "PEM encoded certificate signing request. When not set, a private key and CSR are generated and the private key is returned in private_key",
+			},
+			cisOriginCertificateID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Origin certificate ID",
+			},
+			cisOriginCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded origin CA certificate",
+			},
+			cisOriginCertificatePrivateKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key generated for the certificate. Empty when csr is provided",
+			},
+			cisOriginCertificateExpiresOn: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate expiration time",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISOriginCertificateOrderValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisOriginCertificateRequestType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "origin-rsa, origin-ecc"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisOriginCertificateRequestedValidity,
+			ValidateFunctionIdentifier: validate.ValidateAllowedIntValue,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			AllowedValues:              "7, 30, 90, 365, 730, 1095, 5475"})
+
+	ibmCISOriginCertificateOrderValidator := validate.ResourceValidator{
+		ResourceName: ibmCISOriginCertificateOrder,
+		Schema:       validateSchema}
+	return &ibmCISOriginCertificateOrderValidator
+}
+
+func resourceIBMCISOriginCertificateOrderCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisSSLClientSession %s", err))
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+
+	hostnames := flex.ExpandStringList(d.Get(cisOriginCertificateHostnames).([]interface{}))
+	requestType := d.Get(cisOriginCertificateRequestType).(string)
+
+	privateKey := ""
+	csr := d.Get(cisOriginCertificateCSR).(string)
+	if csr == "" {
+		privateKey, csr, err = generateCISOriginCertificateCSR(requestType, hostnames)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error generating certificate signing request: %s", err))
+		}
+	}
+
+	body := map[string]interface{}{
+		"hostnames":          hostnames,
+		"request_type":       requestType,
+		"requested_validity": d.Get(cisOriginCertificateRequestedValidity).(int),
+		"csr":                csr,
+	}
+
+	result := &cisOriginCertificateResp{}
+	resp, err := cisOriginCertificateRequest(context, cisClient, core.POST, `/v1/{crn}/zones/{zone_identifier}/origin_certificates`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID}, body, result)
+	if err != nil || result.Result == nil || result.Result.ID == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating origin certificate: %v %v", err, resp))
+	}
+
+	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
+	d.Set(cisOriginCertificateCSR, csr)
+	d.Set(cisOriginCertificatePrivateKey, privateKey)
+
+	return resourceIBMCISOriginCertificateOrderRead(context, d, meta)
+}
+
+func resourceIBMCISOriginCertificateOrderRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisSSLClientSession %s", err))
+	}
+	certID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &cisOriginCertificateResp{}
+	resp, err := cisOriginCertificateRequest(context, cisClient, core.GET, `/v1/{crn}/zones/{zone_identifier}/origin_certificates/{cert_identifier}`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID, "cert_identifier": certID}, nil, result)
+	if err != nil || result.Result == nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading origin certificate: %v %v", err, resp))
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisOriginCertificateID, result.Result.ID)
+	d.Set(cisOriginCertificate, result.Result.Certificate)
+	d.Set(cisOriginCertificateHostnames, result.Result.Hostnames)
+	d.Set(cisOriginCertificateExpiresOn, result.Result.ExpiresOn)
+	if result.Result.RequestType != nil {
+		d.Set(cisOriginCertificateRequestType, result.Result.RequestType)
+	}
+	if result.Result.RequestedValidity != nil {
+		d.Set(cisOriginCertificateRequestedValidity, flex.IntValue(result.Result.RequestedValidity))
+	}
+	if result.Result.CSR != nil {
+		d.Set(cisOriginCertificateCSR, result.Result.CSR)
+	}
+
+	return nil
+}
+
+func resourceIBMCISOriginCertificateOrderDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisSSLClientSession %s", err))
+	}
+	certID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &cisOriginCertificateResp{}
+	resp, err := cisOriginCertificateRequest(context, cisClient, core.DELETE, `/v1/{crn}/zones/{zone_identifier}/origin_certificates/{cert_identifier}`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID, "cert_identifier": certID}, nil, result)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error revoking origin certificate: %v %v", err, resp))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cisOriginCertificateRequest sends a request to the CIS origin certificates API using the
+// service URL and authenticator of the SSL certificate client.
+func cisOriginCertificateRequest(context context.Context, cisClient *cissslv1.SslCertificateApiV1, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = cisClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(cisClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return cisClient.Service.Request(request, result)
+}
+
+// generateCISOriginCertificateCSR creates a private key matching the requested signature
+// type and a CSR for the hostnames, both PEM encoded.
+func generateCISOriginCertificateCSR(requestType string, hostnames []string) (string, string, error) {
+	var key crypto.Signer
+	var err error
+	if requestType == cisOriginCertificateTypeECC {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	template := &x509.CertificateRequest{
+		DNSNames: hostnames,
+	}
+	if len(hostnames) > 0 {
+		template.Subject = pkix.Name{CommonName: hostnames[0]}
+	}
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+	csr := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})
+	return string(privateKey), string(csr), nil
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisOriginCertificateOrder_Basic(t *testing.T) {
+	name := "ibm_cis_origin_certificate_order.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisOriginCertificateOrderConfigBasic("origin-rsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "certificate_id"),
+					resource.TestCheckResourceAttrSet(name, "certificate"),
+					resource.TestCheckResourceAttrSet(name, "private_key"),
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckResourceAttr(name, "request_type", "origin-rsa"),
+					resource.TestCheckResourceAttr(name, "requested_validity", "30"),
+				),
+			},
+			{
+				Config: testAccCheckCisOriginCertificateOrderConfigBasic("origin-ecc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "certificate"),
+					resource.TestCheckResourceAttr(name, "request_type", "origin-ecc"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckCisOriginCertificateOrderConfigBasic(requestType string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_origin_certificate_order" "test" {
+		cis_id             = data.ibm_cis.cis.id
+		domain_id          = data.ibm_cis_domain.cis_domain.domain_id
+		hostnames          = ["%[1]s"]
+		request_type       = "%[2]s"
+		requested_validity = 30
+	  }
+	`, acc.CisDomainStatic, requestType)
+}
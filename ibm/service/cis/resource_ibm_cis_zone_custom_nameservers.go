@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISZoneCustomNameservers     = "ibm_cis_zone_custom_nameservers"
+	cisZoneCustomNameserversEnabled = "enabled"
+)
+
+// cisZoneCustomNameservers is the custom nameserver setting of a zone as returned by the CIS API.
+type cisZoneCustomNameservers struct {
+	Enabled *bool  `json:"enabled,omitempty"`
+	NsSet   *int64 `json:"ns_set,omitempty"`
+}
+
+func ResourceIBMCISZoneCustomNameservers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCISZoneCustomNameserversUpdate,
+		ReadContext:   resourceIBMCISZoneCustomNameserversRead,
+		UpdateContext: resourceIBMCISZoneCustomNameserversUpdate,
+		DeleteContext: resourceIBMCISZoneCustomNameserversDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISZoneCustomNameservers,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisZoneCustomNameserversEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the zone uses the custom nameservers of the instance",
+				Required:    true,
+			},
+			cisCustomNameserverSet: {
+				Type:        schema.TypeInt,
+				Description: "The instance nameserver set the zone uses",
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISZoneCustomNameservers,
+					cisCustomNameserverSet),
+			},
+		},
+	}
+}
+
+func ResourceIBMCISZoneCustomNameserversValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisCustomNameserverSet,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "5"})
+	ibmCISZoneCustomNameserversValidator := validate.ResourceValidator{
+		ResourceName: ibmCISZoneCustomNameservers,
+		Schema:       validateSchema}
+	return &ibmCISZoneCustomNameserversValidator
+}
+
+func resourceIBMCISZoneCustomNameserversUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	body := map[string]interface{}{
+		"enabled": d.Get(cisZoneCustomNameserversEnabled).(bool),
+	}
+	if nsSet, ok := d.GetOk(cisCustomNameserverSet); ok {
+		body["ns_set"] = nsSet.(int)
+	}
+
+	response, err := cisRequest(context, cisClient.Service, core.PUT, `/v1/{crn}/zones/{zone_identifier}/custom_ns`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID}, body, &cisZoneCustomNameservers{})
+	if err != nil {
+		log.Printf("[DEBUG] UpdateZoneCustomNameservers failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error updating custom nameservers of zone %s: %s\n%s", zoneID, err, response))
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	return resourceIBMCISZoneCustomNameserversRead(context, d, meta)
+}
+
+func resourceIBMCISZoneCustomNameserversRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &cisZoneCustomNameservers{}
+	response, err := cisRequest(context, cisClient.Service, core.GET, `/v1/{crn}/zones/{zone_identifier}/custom_ns`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID}, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetZoneCustomNameservers failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading custom nameservers of zone %s: %s\n%s", zoneID, err, response))
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisZoneCustomNameserversEnabled, result.Enabled != nil && *result.Enabled)
+	d.Set(cisCustomNameserverSet, flex.IntValue(result.NsSet))
+	return nil
+}
+
+func resourceIBMCISZoneCustomNameserversDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The zone goes back to the nameservers assigned by CIS.
+	response, err := cisRequest(context, cisClient.Service, core.PUT, `/v1/{crn}/zones/{zone_identifier}/custom_ns`,
+		map[string]string{"crn": crn, "zone_identifier": zoneID}, map[string]interface{}{"enabled": false}, &cisZoneCustomNameservers{})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] UpdateZoneCustomNameservers failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error disabling custom nameservers of zone %s: %s\n%s", zoneID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
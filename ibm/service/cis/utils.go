@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// cisResponse is the envelope every CIS API response is wrapped in.
+type cisResponse struct {
+	Success  *bool       `json:"success"`
+	Errors   [][]string  `json:"errors"`
+	Messages [][]string  `json:"messages"`
+	Result   interface{} `json:"result"`
+}
+
+// cisRequest sends a request the pinned networking-go-sdk has no operation for, with the
+// authenticator, URL and headers of an existing CIS client. The result of the response is
+// decoded into result.
+func cisRequest(context context.Context, service *core.BaseService, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return service.Request(request, &cisResponse{Result: result})
+}
@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantDatabaseDesignDocument() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDatabaseDesignDocumentCreate,
+		ReadContext:   resourceIBMCloudantDatabaseDesignDocumentRead,
+		UpdateContext: resourceIBMCloudantDatabaseDesignDocumentUpdate,
+		DeleteContext: resourceIBMCloudantDatabaseDesignDocumentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"ddoc": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+				Description:  "Name of the design document, without the _design/ prefix.",
+			},
+			"document": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "Content of the design document as JSON, for example its views, indexes, options and language, without the _id and _rev fields.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current revision of the design document.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseDesignDocumentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbName := d.Get("db").(string)
+	ddoc := d.Get("ddoc").(string)
+	designDocument, err := expandCloudantDesignDocument(d.Get("document").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	putDesignDocumentOptions := cloudantClient.NewPutDesignDocumentOptions(dbName, ddoc, designDocument)
+
+	_, response, err := cloudantClient.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceCRN, dbName, ddoc))
+
+	return resourceIBMCloudantDatabaseDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseDesignDocumentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := cloudantDesignDocumentIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(dbName, ddoc)
+
+	designDocument, response, err := cloudantClient.GetDesignDocumentWithContext(context, getDesignDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+	d.Set("ddoc", ddoc)
+
+	if err = d.Set("rev", designDocument.Rev); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rev: %s", err))
+	}
+
+	document, err := flattenCloudantDesignDocument(designDocument)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("document", document); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting document: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseDesignDocumentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := cloudantDesignDocumentIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("document") {
+		designDocument, err := expandCloudantDesignDocument(d.Get("document").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// the update is made on the revision read during the plan, a change made since then fails with a conflict
+		putDesignDocumentOptions := cloudantClient.NewPutDesignDocumentOptions(dbName, ddoc, designDocument)
+		putDesignDocumentOptions.SetIfMatch(d.Get("rev").(string))
+
+		_, response, err := cloudantClient.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 409 {
+				return diag.FromErr(fmt.Errorf("Design document %s was updated since revision %s, refresh and apply again", ddoc, d.Get("rev").(string)))
+			}
+			log.Printf("[DEBUG] PutDesignDocumentWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("PutDesignDocumentWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMCloudantDatabaseDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseDesignDocumentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := cloudantDesignDocumentIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDesignDocumentOptions := cloudantClient.NewDeleteDesignDocumentOptions(dbName, ddoc)
+	deleteDesignDocumentOptions.SetIfMatch(d.Get("rev").(string))
+
+	_, response, err := cloudantClient.DeleteDesignDocumentWithContext(context, deleteDesignDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func cloudantDesignDocumentIdParts(id string) (instanceCRN, dbName, ddoc string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return
+	}
+	if len(parts) < 3 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of instance_crn/db/ddoc", id)
+		return
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+func expandCloudantDesignDocument(document string) (*cloudantv1.DesignDocument, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(document), &m); err != nil {
+		return nil, fmt.Errorf("Error parsing document: %s", err)
+	}
+	for _, field := range []string{"_id", "_rev"} {
+		if _, ok := m[field]; ok {
+			return nil, fmt.Errorf("Error parsing document: %s is managed by the provider and can't be set", field)
+		}
+	}
+	var designDocument *cloudantv1.DesignDocument
+	if err := cloudantv1.UnmarshalDesignDocument(m, &designDocument); err != nil {
+		return nil, fmt.Errorf("Error parsing document: %s", err)
+	}
+	return designDocument, nil
+}
+
+func flattenCloudantDesignDocument(designDocument *cloudantv1.DesignDocument) (string, error) {
+	designDocument.ID = nil
+	designDocument.Rev = nil
+	document, err := json.Marshal(designDocument)
+	if err != nil {
+		return "", fmt.Errorf("Error marshalling document: %s", err)
+	}
+	return string(document), nil
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCloudantDatabaseDesignDocumentBasic(t *testing.T) {
+	var rev string
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDatabaseDesignDocumentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseDesignDocumentConfig(instanceName, db, "function (doc) { emit(doc.type, 1); }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_database_design_document.cloudant_database_design_document", "ddoc", "orders"),
+					testAccCheckIBMCloudantDatabaseDesignDocumentRev("ibm_cloudant_database_design_document.cloudant_database_design_document", &rev),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseDesignDocumentConfig(instanceName, db, "function (doc) { emit(doc.date, 1); }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantDatabaseDesignDocumentRev("ibm_cloudant_database_design_document.cloudant_database_design_document", &rev),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_database_design_document.cloudant_database_design_document",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDatabaseDesignDocumentConfig(instanceName, db, mapFunction string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_database_design_document" "cloudant_database_design_document" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			ddoc = "orders"
+			document = jsonencode({
+				language = "javascript"
+				views = {
+					by_key = {
+						map    = "%s"
+						reduce = "_count"
+					}
+				}
+			})
+		}
+	`, instanceName, db, mapFunction)
+}
+
+// testAccCheckIBMCloudantDatabaseDesignDocumentRev checks that every update creates a new revision
+func testAccCheckIBMCloudantDatabaseDesignDocumentRev(n string, rev *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		newRev := rs.Primary.Attributes["rev"]
+		if newRev == "" || newRev == *rev {
+			return fmt.Errorf("Expected a new revision of %s, got %q", rs.Primary.ID, newRev)
+		}
+		*rev = newRev
+		return nil
+	}
+}
+
+func testAccCheckIBMCloudantDatabaseDesignDocumentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_database_design_document" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(rs.Primary.Attributes["db"], rs.Primary.Attributes["ddoc"])
+
+		_, _, err = cloudantClient.GetDesignDocument(getDesignDocumentOptions)
+		if err == nil {
+			return fmt.Errorf("cloudant_database_design_document still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const cloudantDesignDocPrefix = "_design/"
+
+// cloudantIndexInformation is an index as listed by the _index API, with the partitioned flag the SDK
+// model doesn't carry.
+type cloudantIndexInformation struct {
+	Ddoc        *string         `json:"ddoc"`
+	Name        *string         `json:"name"`
+	Type        *string         `json:"type"`
+	Def         json.RawMessage `json:"def"`
+	Partitioned *bool           `json:"partitioned"`
+}
+
+func ResourceIBMCloudantDatabaseIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDatabaseIndexCreate,
+		ReadContext:   resourceIBMCloudantDatabaseIndexRead,
+		DeleteContext: resourceIBMCloudantDatabaseIndexDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"index": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressCloudantIndexDefinitionDiff,
+				Description:      "Schema for a json or text index definition, for example {\"fields\": [{\"name\": \"asc\"}]}.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "text"}, false),
+				Description:  "Schema for the type of an index.",
+			},
+			"ddoc": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+				Description:  "Name of the design document in which the index is created, without the _design/ prefix. By default, each index is created in its own design document.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Name of the index. If no name is provided, a name is generated automatically.",
+			},
+			"partitioned": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The default value is true for databases with partitioned: true and false otherwise. For databases with partitioned: false if this option is specified the value must be false.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseIndexCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbName := d.Get("db").(string)
+	// the index definition is sent as is, the SDK model doesn't carry every index option (e.g. partial_filter_selector)
+	body := map[string]interface{}{
+		"index": json.RawMessage(d.Get("index").(string)),
+		"type":  d.Get("type").(string),
+	}
+	if v, ok := d.GetOk("ddoc"); ok {
+		body["ddoc"] = v.(string)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		body["name"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("partitioned"); ok {
+		body["partitioned"] = v.(bool)
+	}
+
+	var indexResult cloudantv1.IndexResult
+	response, err := cloudantIndexRequest(context, cloudantClient, core.POST, dbName, body, &indexResult)
+	if err != nil {
+		log.Printf("[DEBUG] PostIndexWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PostIndexWithContext failed %s\n%s", err, response))
+	}
+
+	ddoc := strings.TrimPrefix(*indexResult.ID, cloudantDesignDocPrefix)
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", instanceCRN, dbName, ddoc, *indexResult.Name))
+
+	return resourceIBMCloudantDatabaseIndexRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseIndexRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 4 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of instance_crn/db/ddoc/name", d.Id()))
+	}
+
+	instanceCRN, dbName, ddoc, name := strings.Join(parts[:len(parts)-3], "/"), parts[len(parts)-3], parts[len(parts)-2], parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var indexesInformation struct {
+		Indexes []cloudantIndexInformation `json:"indexes"`
+	}
+	response, err := cloudantIndexRequest(context, cloudantClient, core.GET, dbName, nil, &indexesInformation)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetIndexesInformationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetIndexesInformationWithContext failed %s\n%s", err, response))
+	}
+
+	var index *cloudantIndexInformation
+	for i, indexInformation := range indexesInformation.Indexes {
+		if indexInformation.Ddoc != nil && *indexInformation.Ddoc == cloudantDesignDocPrefix+ddoc && indexInformation.Name != nil && *indexInformation.Name == name {
+			index = &indexesInformation.Indexes[i]
+			break
+		}
+	}
+	if index == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+
+	if err = d.Set("ddoc", ddoc); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ddoc: %s", err))
+	}
+	if err = d.Set("name", *index.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("type", *index.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if index.Partitioned != nil {
+		if err = d.Set("partitioned", *index.Partitioned); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting partitioned: %s", err))
+		}
+	}
+	// the server returns a normalized definition, the configured one is kept unless the index is imported
+	if _, ok := d.GetOk("index"); !ok && len(index.Def) > 0 {
+		if err = d.Set("index", string(index.Def)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting index: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseIndexDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 4 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of instance_crn/db/ddoc/name", d.Id()))
+	}
+
+	instanceCRN, dbName, ddoc, name := strings.Join(parts[:len(parts)-3], "/"), parts[len(parts)-3], parts[len(parts)-2], parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteIndexOptions := cloudantClient.NewDeleteIndexOptions(dbName, ddoc, d.Get("type").(string), name)
+
+	_, response, err := cloudantClient.DeleteIndexWithContext(context, deleteIndexOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteIndexWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteIndexWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// cloudantIndexRequest sends a request to the _index API of a database, the SDK models of index
+// definitions and of listed indexes don't carry every index option.
+func cloudantIndexRequest(context context.Context, cloudantClient *cloudantv1.CloudantV1, method, dbName string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	_, err := builder.ResolveRequestURL(cloudantClient.Service.Options.URL, `/{db}/_index`, map[string]string{"db": dbName})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudantClient.Service.Request(request, result)
+}
+
+// suppressCloudantIndexDefinitionDiff compares index definitions in the form the server returns them,
+// so that an imported definition matches the configured one.
+func suppressCloudantIndexDefinitionDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldDef, err := normalizeCloudantIndexDefinition(old)
+	if err != nil {
+		return false
+	}
+	newDef, err := normalizeCloudantIndexDefinition(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldDef, newDef)
+}
+
+// normalizeCloudantIndexDefinition rewrites shorthand fields, e.g. "date" to {"date": "asc"} and
+// {"name": "date", "type": "string"} to {"date": "string"}, and drops options left at their defaults.
+func normalizeCloudantIndexDefinition(def string) (map[string]interface{}, error) {
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(def), &definition); err != nil {
+		return nil, err
+	}
+
+	if fields, ok := definition["fields"].([]interface{}); ok {
+		for i, f := range fields {
+			switch field := f.(type) {
+			case string:
+				fields[i] = map[string]interface{}{field: "asc"}
+			case map[string]interface{}:
+				fieldName, nameOk := field["name"].(string)
+				fieldType, typeOk := field["type"].(string)
+				if nameOk && typeOk && len(field) == 2 {
+					fields[i] = map[string]interface{}{fieldName: fieldType}
+				}
+			}
+		}
+	}
+
+	for k, v := range definition {
+		if option, ok := v.(map[string]interface{}); ok && len(option) == 0 {
+			delete(definition, k)
+		}
+	}
+	if v, ok := definition["index_array_lengths"].(bool); ok && v {
+		delete(definition, "index_array_lengths")
+	}
+	if v, ok := definition["default_analyzer"].(string); ok && v == "keyword" {
+		delete(definition, "default_analyzer")
+	}
+	if v, ok := definition["default_field"].(map[string]interface{}); ok && reflect.DeepEqual(v, map[string]interface{}{"enabled": true, "analyzer": "standard"}) {
+		delete(definition, "default_field")
+	}
+
+	return definition, nil
+}
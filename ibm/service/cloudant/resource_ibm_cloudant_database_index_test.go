@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCloudantDatabaseIndexBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDatabaseIndexDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseIndexConfig(instanceName, db, "type-index", `{"fields": [{"type": "asc"}]}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_database_index", "name", "type-index"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_database_index", "ddoc", "tf-indexes"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_database_index", "type", "json"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_database_index", "partitioned", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseIndexConfig(instanceName, db, "type-date-index", `{"fields": [{"type": "asc"}, {"date": "asc"}], "partial_filter_selector": {"type": "order"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_database_index", "name", "type-date-index"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cloudant_database_index.cloudant_database_index",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"index"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDatabaseIndexConfig(instanceName, db, name, index string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_database_index" "cloudant_database_index" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			ddoc = "tf-indexes"
+			name = "%s"
+			index = jsonencode(%s)
+		}
+	`, instanceName, db, name, index)
+}
+
+func testAccCheckIBMCloudantDatabaseIndexDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_database_index" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getIndexesInformationOptions := cloudantClient.NewGetIndexesInformationOptions(rs.Primary.Attributes["db"])
+
+		indexesInformation, _, err := cloudantClient.GetIndexesInformation(getIndexesInformationOptions)
+		if err != nil {
+			// the database is gone along with its indexes
+			continue
+		}
+		for _, index := range indexesInformation.Indexes {
+			if *index.Name == rs.Primary.Attributes["name"] {
+				return fmt.Errorf("cloudant_database_index still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
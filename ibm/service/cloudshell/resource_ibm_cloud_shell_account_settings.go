@@ -69,7 +69,7 @@ func ResourceIBMCloudShellAccountSettings() *schema.Resource {
 						"key": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of the feature.",
+							Description: "Name of the feature, for example server.file_manager or server.web_preview.",
 						},
 					},
 				},
@@ -89,7 +89,7 @@ func ResourceIBMCloudShellAccountSettings() *schema.Resource {
 						"key": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of the region.",
+							Description: "Name of the region, for example eu-de, jp-tok or us-south.",
 						},
 					},
 				},
@@ -135,13 +135,13 @@ func resourceIBMCloudShellAccountSettingsCreate(context context.Context, d *sche
 	if _, ok := d.GetOk("rev"); ok {
 		updateAccountSettingsOptions.SetRev(d.Get("rev").(string))
 	}
-	if _, ok := d.GetOk("default_enable_new_features"); ok {
+	if _, ok := d.GetOkExists("default_enable_new_features"); ok {
 		updateAccountSettingsOptions.SetDefaultEnableNewFeatures(d.Get("default_enable_new_features").(bool))
 	}
-	if _, ok := d.GetOk("default_enable_new_regions"); ok {
+	if _, ok := d.GetOkExists("default_enable_new_regions"); ok {
 		updateAccountSettingsOptions.SetDefaultEnableNewRegions(d.Get("default_enable_new_regions").(bool))
 	}
-	if _, ok := d.GetOk("enabled"); ok {
+	if _, ok := d.GetOkExists("enabled"); ok {
 		updateAccountSettingsOptions.SetEnabled(d.Get("enabled").(bool))
 	}
 	if _, ok := d.GetOk("features"); ok {
@@ -241,6 +241,7 @@ func resourceIBMCloudShellAccountSettingsRead(context context.Context, d *schema
 			featuresItemMap := resourceIBMCloudShellAccountSettingsFeatureToMap(featuresItem)
 			features = append(features, featuresItemMap)
 		}
+		features = resourceIBMCloudShellAccountSettingsOrderByConfig(d.Get("features").([]interface{}), features)
 		if err = d.Set("features", features); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting features: %s", err))
 		}
@@ -251,6 +252,7 @@ func resourceIBMCloudShellAccountSettingsRead(context context.Context, d *schema
 			regionsItemMap := resourceIBMCloudShellAccountSettingsRegionSettingToMap(regionsItem)
 			regions = append(regions, regionsItemMap)
 		}
+		regions = resourceIBMCloudShellAccountSettingsOrderByConfig(d.Get("regions").([]interface{}), regions)
 		if err = d.Set("regions", regions); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting regions: %s", err))
 		}
@@ -300,6 +302,34 @@ func resourceIBMCloudShellAccountSettingsRegionSettingToMap(regionSetting ibmclo
 	return regionSettingMap
 }
 
+// resourceIBMCloudShellAccountSettingsOrderByConfig keeps only the features or regions that
+// are configured, in the configured order, so that enabling or disabling one of them in the
+// console shows up as drift without a diff on the ones that are not managed. When nothing is
+// configured all the settings returned by the API are kept.
+func resourceIBMCloudShellAccountSettingsOrderByConfig(configured []interface{}, settings []map[string]interface{}) []map[string]interface{} {
+	if len(configured) == 0 {
+		return settings
+	}
+	byKey := make(map[string]map[string]interface{}, len(settings))
+	for _, setting := range settings {
+		if key, ok := setting["key"].(*string); ok && key != nil {
+			byKey[*key] = setting
+		}
+	}
+	ordered := make([]map[string]interface{}, 0, len(configured))
+	for _, c := range configured {
+		item, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// a configured setting that the API does not return is dropped, which shows up as drift
+		if setting, ok := byKey[item["key"].(string)]; ok {
+			ordered = append(ordered, setting)
+		}
+	}
+	return ordered
+}
+
 func resourceIBMCloudShellAccountSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ibmCloudShellClient, err := meta.(conns.ClientSession).IBMCloudShellV1()
 	if err != nil {
@@ -81,6 +81,54 @@ func TestAccIBMCloudShellAccountSettingsAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCloudShellAccountSettingsFileManager(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudShell(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudShellAccountSettingsConfigFileManager(acc.CloudShellAccountID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "features.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "features.0.key", "server.file_manager"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "features.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "regions.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "regions.0.key", "us-south"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCloudShellAccountSettingsConfigFileManager(acc.CloudShellAccountID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "features.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "features.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudShellAccountSettingsConfigFileManager(accountID string, fileManager bool) string {
+	return fmt.Sprintf(`
+	data "ibm_cloud_shell_account_settings" "account_settings" {
+		account_id = "%s"
+	}
+
+	resource "ibm_cloud_shell_account_settings" "cloud_shell_account_settings" {
+		account_id = "%s"
+		rev = data.ibm_cloud_shell_account_settings.account_settings.rev
+		enabled = true
+		features {
+			enabled = %t
+			key = "server.file_manager"
+		}
+		regions {
+			enabled = true
+			key = "us-south"
+		}
+	}
+	`, accountID, accountID, fileManager)
+}
+
 func testAccCheckIBMCloudShellAccountSettingsConfigBasic(accountID string) string {
 	return fmt.Sprintf(`
 	data "ibm_cloud_shell_account_settings" "account_settings" {
@@ -5,10 +5,16 @@ package codeengine
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -26,6 +32,11 @@ func ResourceIbmCodeEngineSecret() *schema.Resource {
 		DeleteContext: resourceIbmCodeEngineSecretDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			resourceIbmCodeEngineSecretValidateFormat,
+			resourceIbmCodeEngineSecretDataChange,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -55,6 +66,13 @@ func ResourceIbmCodeEngineSecret() *schema.Resource {
 				Description: "Data container that allows to specify config parameters and their values as a key-value map. Each key field must consist of alphanumeric characters, `-`, `_` or `.` and must not be exceed a max length of 253 characters. Each value field can consists of any character and must not be exceed a max length of 1048576 characters.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary key-value pairs that rotate the secret when changed, the secret is recreated, which regenerates the binding of a service access secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"service_access": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -201,6 +219,108 @@ func ResourceIbmCodeEngineSecretValidator() *validate.ResourceValidator {
 	return &resourceValidator
 }
 
+// Keys of the data of the secret formats with a well known shape, the other keys are optional
+var codeEngineSecretRequiredDataKeys = map[string][]string{
+	"basic_auth": {"username", "password"},
+	"registry":   {"server", "username", "password"},
+	"ssh_auth":   {"ssh_key"},
+	"tls":        {"tls_cert", "tls_key"},
+}
+
+// resourceIbmCodeEngineSecretValidateFormat checks the configured data against the format of the secret, values
+// unknown during the plan are only checked for their presence.
+func resourceIbmCodeEngineSecretValidateFormat(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	format := config.GetAttr("format")
+	data := config.GetAttr("data")
+	serviceAccess := config.GetAttr("service_access")
+	if !format.IsKnown() || format.IsNull() || !data.IsKnown() || !serviceAccess.IsKnown() {
+		return nil
+	}
+
+	hasServiceAccess := !serviceAccess.IsNull() && serviceAccess.LengthInt() > 0
+	if format.AsString() == "service_access" {
+		if !hasServiceAccess {
+			return fmt.Errorf("service_access must be set for a secret of format service_access")
+		}
+		if !data.IsNull() && data.LengthInt() > 0 {
+			return fmt.Errorf("data can't be set for a secret of format service_access, it is generated from the service credential")
+		}
+		return nil
+	}
+	if hasServiceAccess {
+		return fmt.Errorf("service_access can only be set for a secret of format service_access")
+	}
+
+	keys := map[string]bool{}
+	values := map[string]string{}
+	if !data.IsNull() {
+		for k, v := range data.AsValueMap() {
+			keys[k] = true
+			if v.IsKnown() && !v.IsNull() {
+				values[k] = v.AsString()
+			}
+		}
+	}
+
+	return validateCodeEngineSecretData(format.AsString(), keys, values)
+}
+
+// validateCodeEngineSecretData checks that keys holds the keys required by the format, and that the known values of
+// the keys holding a key or a certificate are PEM encoded.
+func validateCodeEngineSecretData(format string, keys map[string]bool, values map[string]string) error {
+	var missing []string
+	for _, key := range codeEngineSecretRequiredDataKeys[format] {
+		if !keys[key] {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("data of a secret of format %s is missing the keys: %s", format, strings.Join(missing, ", "))
+	}
+
+	switch format {
+	case "ssh_auth":
+		if sshKey, ok := values["ssh_key"]; ok {
+			if block, _ := pem.Decode([]byte(sshKey)); block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+				return fmt.Errorf("ssh_key of a secret of format ssh_auth must be a PEM encoded private key")
+			}
+		}
+	case "tls":
+		tlsCert, certOk := values["tls_cert"]
+		tlsKey, keyOk := values["tls_key"]
+		if certOk {
+			block, _ := pem.Decode([]byte(tlsCert))
+			if block == nil || block.Type != "CERTIFICATE" {
+				return fmt.Errorf("tls_cert of a secret of format tls must be a PEM encoded certificate")
+			}
+			if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+				return fmt.Errorf("tls_cert of a secret of format tls can't be parsed: %s", err)
+			}
+		}
+		if certOk && keyOk {
+			if _, err := tls.X509KeyPair([]byte(tlsCert), []byte(tlsKey)); err != nil {
+				return fmt.Errorf("tls_key of a secret of format tls doesn't match tls_cert: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// resourceIbmCodeEngineSecretDataChange marks the version of the secret as changing with its data, so that an app
+// referencing entity_tag, for example in a literal environment variable, rolls a new revision that reads the new data.
+func resourceIbmCodeEngineSecretDataChange(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("format").(string) == "service_access" || !diff.HasChange("data") {
+		return nil
+	}
+	// etag is left untouched, the update sends it as If-Match
+	return diff.SetNewComputed("entity_tag")
+}
+
 func resourceIbmCodeEngineSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	codeEngineClient, err := meta.(conns.ClientSession).CodeEngineV2()
 	if err != nil {
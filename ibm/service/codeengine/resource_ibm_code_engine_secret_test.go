@@ -4,7 +4,12 @@
 package codeengine_test
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -184,15 +189,19 @@ func TestAccIbmCodeEngineSecretSSHAuth(t *testing.T) {
 	var conf codeenginev2.Secret
 	format := "ssh_auth"
 	name := fmt.Sprintf("tf-secret-ssh-auth-%d", acctest.RandIntRange(10, 1000))
-	data := `{
-		"ssh_key"     = "ssh-key",
-    "known_hosts" = "knownhosts",
-	}`
+	sshKey := testAccCodeEngineSSHKey(t)
+	data := fmt.Sprintf(`{
+		"ssh_key"     = <<EOT
+%sEOT
+		"known_hosts" = "knownhosts"
+	}`, sshKey)
 	nameUpdate := fmt.Sprintf("tf-secret-ssh-auth-update-%d", acctest.RandIntRange(10, 1000))
-	dataUpdate := `{
-		"ssh_key"     = "ssh-key-update",
-    "known_hosts" = "knownhosts-update",
-	}`
+	sshKeyUpdate := testAccCodeEngineSSHKey(t)
+	dataUpdate := fmt.Sprintf(`{
+		"ssh_key"     = <<EOT
+%sEOT
+		"known_hosts" = "knownhosts-update"
+	}`, sshKeyUpdate)
 
 	projectID := acc.CeProjectId
 
@@ -208,7 +217,7 @@ func TestAccIbmCodeEngineSecretSSHAuth(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "project_id", projectID),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "format", format),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "name", name),
-					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.ssh_key", "ssh-key"),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.ssh_key", sshKey),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.known_hosts", "knownhosts"),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "resource_type", "secret_auth_ssh_v2"),
 				),
@@ -219,7 +228,7 @@ func TestAccIbmCodeEngineSecretSSHAuth(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "project_id", projectID),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "format", format),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "name", nameUpdate),
-					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.ssh_key", "ssh-key-update"),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.ssh_key", sshKeyUpdate),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.known_hosts", "knownhosts-update"),
 					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "resource_type", "secret_auth_ssh_v2"),
 				),
@@ -318,6 +327,90 @@ func TestAccIbmCodeEngineSecretServiceAccess(t *testing.T) {
 	})
 }
 
+func TestAccIbmCodeEngineSecretFormatValidation(t *testing.T) {
+	name := fmt.Sprintf("tf-secret-invalid-%d", acctest.RandIntRange(10, 1000))
+	projectID := acc.CeProjectId
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIbmCodeEngineSecretConfig(projectID, "registry", name, `{ "username" = "foo", "password" = "bar" }`),
+				ExpectError: regexp.MustCompile("missing the keys: server"),
+			},
+			resource.TestStep{
+				Config:      testAccCheckIbmCodeEngineSecretConfig(projectID, "ssh_auth", name, `{ "ssh_key" = "ssh-key" }`),
+				ExpectError: regexp.MustCompile("must be a PEM encoded private key"),
+			},
+			resource.TestStep{
+				Config:      testAccCheckIbmCodeEngineSecretConfig(projectID, "tls", name, `{ "tls_cert" = "cert", "tls_key" = "key" }`),
+				ExpectError: regexp.MustCompile("must be a PEM encoded certificate"),
+			},
+		},
+	})
+}
+
+func TestAccIbmCodeEngineSecretDataUpdate(t *testing.T) {
+	var conf codeenginev2.Secret
+	var entityTag string
+	name := fmt.Sprintf("tf-secret-data-update-%d", acctest.RandIntRange(10, 1000))
+	projectID := acc.CeProjectId
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmCodeEngineSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmCodeEngineSecretConfig(projectID, "generic", name, `{ "key" = "value" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmCodeEngineSecretExists("ibm_code_engine_secret.code_engine_secret_instance", conf),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.key", "value"),
+					testAccCheckIbmCodeEngineSecretEntityTag("ibm_code_engine_secret.code_engine_secret_instance", &entityTag, false),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmCodeEngineSecretConfig(projectID, "generic", name, `{ "key" = "value-update" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "name", name),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.key", "value-update"),
+					testAccCheckIbmCodeEngineSecretEntityTag("ibm_code_engine_secret.code_engine_secret_instance", &entityTag, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmCodeEngineSecretKeepers(t *testing.T) {
+	var conf codeenginev2.Secret
+	name := fmt.Sprintf("tf-secret-keepers-%d", acctest.RandIntRange(10, 1000))
+	projectID := acc.CeProjectId
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmCodeEngineSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmCodeEngineSecretKeepersConfig(projectID, name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmCodeEngineSecretExists("ibm_code_engine_secret.code_engine_secret_instance", conf),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "keepers.rotation", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmCodeEngineSecretKeepersConfig(projectID, name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmCodeEngineSecretExists("ibm_code_engine_secret.code_engine_secret_instance", conf),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "keepers.rotation", "2"),
+					resource.TestCheckResourceAttr("ibm_code_engine_secret.code_engine_secret_instance", "data.key", "value"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmCodeEngineSecretConfig(projectID string, format string, name string, data string) string {
 	return fmt.Sprintf(`
 		data "ibm_code_engine_project" "code_engine_project_instance" {
@@ -333,6 +426,51 @@ func testAccCheckIbmCodeEngineSecretConfig(projectID string, format string, name
 	`, projectID, format, name, data)
 }
 
+func testAccCheckIbmCodeEngineSecretKeepersConfig(projectID string, name string, rotation string) string {
+	return fmt.Sprintf(`
+		data "ibm_code_engine_project" "code_engine_project_instance" {
+			project_id = "%s"
+		}
+
+		resource "ibm_code_engine_secret" "code_engine_secret_instance" {
+			project_id = data.ibm_code_engine_project.code_engine_project_instance.project_id
+			format = "generic"
+			name = "%s"
+			data = {
+				key = "value"
+			}
+			keepers = {
+				rotation = "%s"
+			}
+		}
+	`, projectID, name, rotation)
+}
+
+// testAccCheckIbmCodeEngineSecretEntityTag records the entity_tag of the secret, and checks that it changed from the
+// recorded one when changed is set.
+func testAccCheckIbmCodeEngineSecretEntityTag(n string, entityTag *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		current := rs.Primary.Attributes["entity_tag"]
+		if changed && current == *entityTag {
+			return fmt.Errorf("entity_tag of %s didn't change from %s", n, *entityTag)
+		}
+		*entityTag = current
+		return nil
+	}
+}
+
+func testAccCodeEngineSSHKey(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating ssh key: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
 func testAccCheckIbmCodeEngineSecretTLSConfig(projectID string, tlsKey string, tlsCert string, secretFormat string, secretName string) string {
 	return fmt.Sprintf(`
 		data "ibm_code_engine_project" "code_engine_project_instance" {
@@ -60,6 +60,10 @@ const (
 	redisRBACRoleRegexPattern = `([+-][a-z]+\s?)+`
 )
 
+const (
+	mysqlGroupReplicationPrefix = "group_replication_"
+)
+
 type DatabaseUser struct {
 	Username string
 	Password string
@@ -102,7 +106,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
 			validateGroupsDiff,
-			validateUsersDiff),
+			validateUsersDiff,
+			validateServiceCredentialsDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -226,6 +231,31 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
 			},
+			"remotes": {
+				Description: "The replication topology of the database",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"leader": {
+							Description: "The CRN of the leader, set when the database is a read-only replica",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"replicas": {
+							Description: "The CRNs of the read-only replicas of the database",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"resync_trigger": {
+				Description: "Arbitrary value, changing it resyncs the read-only replica with its leader",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"key_protect_instance": {
 				Description: "The CRN of Key protect instance",
 				Type:        schema.TypeString,
@@ -305,6 +335,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"service_credentials": databaseServiceCredentialsSchema(),
 			"connectionstrings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -315,6 +346,11 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							Type:        schema.TypeString,
 							Computed:    true,
 						},
+						"read_only": {
+							Description: "Whether the connection string points to the read-only endpoint",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
 						"composed": {
 							Description: "Connection string",
 							Type:        schema.TypeString,
@@ -1062,6 +1098,11 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		invalidFields := []string{}
 		for k, _ := range rawConfig {
 			if _, ok := result[k]; !ok {
+				// Group replication parameters are validated by the service against the
+				// deployment configuration schema, the SDK model does not include them.
+				if service == "databases-for-mysql" && strings.HasPrefix(k, mysqlGroupReplicationPrefix) {
+					continue
+				}
 				invalidFields = append(invalidFields, k)
 			}
 		}
@@ -1457,12 +1498,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] database configuration is invalid"))
 		}
 
-		updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
-			ID:            &instanceID,
-			Configuration: configuration,
-		}
-
-		updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+		updateDatabaseConfigurationResponse, response, err := updateDatabaseConfiguration(cloudDatabasesClient, instanceID, d.Get("service").(string), rawConfig, configuration)
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
@@ -1515,6 +1551,12 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if v, ok := d.GetOk("service_credentials"); ok {
+		if err := databaseServiceCredentialsUpdate(d, meta, nil, v.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
@@ -1664,6 +1706,15 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 
 	d.Set("allowlist", flex.FlattenAllowlist(allowlist.IPAddresses))
 
+	remotes, response, err := cloudDatabasesClient.ListRemotes(&clouddatabasesv5.ListRemotesOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error getting database (%s) remotes: %s %s", icdId, err, response)
+	} else {
+		d.Set("remotes", flattenDatabaseRemotes(remotes.Remotes))
+	}
+
 	var connectionStrings []flex.CsEntry
 	//ICD does not implement a GetUsers API. Users populated from tf configuration.
 	tfusers := d.Get("users").(*schema.Set)
@@ -1674,15 +1725,19 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	users = append(users, user)
 	for _, user := range users {
 		userName := user.UserName
-		csEntry, err := getConnectionString(d, userName, connectionEndpoint, meta)
+		csEntries, err := getConnectionStrings(d, userName, connectionEndpoint, meta)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error getting user connection string for user (%s): %s", userName, err))
 		}
-		connectionStrings = append(connectionStrings, csEntry)
+		connectionStrings = append(connectionStrings, csEntries...)
 	}
 	d.Set("connectionstrings", flex.FlattenConnectionStrings(connectionStrings))
 
-	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" {
+	if err = databaseServiceCredentialsRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" || serviceOff == "databases-for-mysql" {
 		configSchema, err := icdClient.Configurations().GetConfiguration(icdId)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", icdId, err))
@@ -1771,12 +1826,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				return diag.FromErr(err)
 			}
 
-			updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
-				ID:            &instanceID,
-				Configuration: configuration,
-			}
-
-			updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+			updateDatabaseConfigurationResponse, response, err := updateDatabaseConfiguration(cloudDatabasesClient, instanceID, d.Get("service").(string), rawConfig, configuration)
 
 			if err != nil {
 				return diag.FromErr(fmt.Errorf(
@@ -2099,47 +2149,70 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("resync_trigger") {
+		// keep the old trigger on failure so that the next apply retries the resync
+		oldResyncTrigger, _ := d.GetChange("resync_trigger")
+		remotes, response, err := cloudDatabasesClient.ListRemotes(&clouddatabasesv5.ListRemotesOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			d.Set("resync_trigger", oldResyncTrigger)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) remotes: %s %s", icdId, err, response))
+		}
+		if remotes.Remotes == nil || remotes.Remotes.Leader == nil || *remotes.Remotes.Leader == "" {
+			d.Set("resync_trigger", oldResyncTrigger)
+			return diag.FromErr(fmt.Errorf("[ERROR] Database (%s) is not a read-only replica, only replicas can be resynced", icdId))
+		}
+
+		resyncReplicaResponse, response, err := cloudDatabasesClient.ResyncReplica(&clouddatabasesv5.ResyncReplicaOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			d.Set("resync_trigger", oldResyncTrigger)
+			return diag.FromErr(fmt.Errorf("[ERROR] ResyncReplica (%s) failed %s\n%s", icdId, err, response))
+		}
+
+		if resyncReplicaResponse == nil || resyncReplicaResponse.Task == nil || resyncReplicaResponse.Task.ID == nil {
+			log.Printf("[WARN] ResyncReplica (%s) returned no task to wait for", icdId)
+		} else {
+			_, err = waitForDatabaseTaskComplete(*resyncReplicaResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				d.Set("resync_trigger", oldResyncTrigger)
+				return diag.FromErr(fmt.Errorf(
+					"[ERROR] Error waiting for database (%s) resync task to complete: %s", icdId, err))
+			}
+		}
+	}
+
+	if d.HasChange("service_credentials") {
+		oldCredentials, newCredentials := d.GetChange("service_credentials")
+		if err := databaseServiceCredentialsUpdate(d, meta, oldCredentials.([]interface{}), newCredentials.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
-func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint string, meta interface{}) (flex.CsEntry, error) {
+// getConnectionStrings returns the connection strings of the user for the given endpoint. For
+// databases-for-mysql the read-only endpoint, where provided, is returned as an additional entry.
+func getConnectionStrings(d *schema.ResourceData, userName, connectionEndpoint string, meta interface{}) ([]flex.CsEntry, error) {
 	csEntry := flex.CsEntry{}
 	icdClient, err := meta.(conns.ClientSession).ICDAPI()
 	if err != nil {
-		return csEntry, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+		return nil, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
 	}
 
 	icdId := d.Id()
 	connection, err := icdClient.Connections().GetConnection(icdId, userName, connectionEndpoint)
 	if err != nil {
-		return csEntry, fmt.Errorf("[ERROR] Error getting database user connection string via ICD API: %s", err)
+		return nil, fmt.Errorf("[ERROR] Error getting database user connection string via ICD API: %s", err)
 	}
 
-	service := d.Get("service")
-	dbConnection := icdv4.Uri{}
-	var cassandraConnection icdv4.CassandraUri
-
-	switch service {
-	case "databases-for-postgresql":
-		dbConnection = connection.Postgres
-	case "databases-for-redis":
-		dbConnection = connection.Rediss
-	case "databases-for-mongodb":
-		dbConnection = connection.Mongo
-	case "databases-for-mysql":
-		dbConnection = connection.Mysql
-	case "databases-for-elasticsearch":
-		dbConnection = connection.Https
-	case "databases-for-cassandra":
-		cassandraConnection = connection.Secure
-	case "databases-for-etcd":
-		dbConnection = connection.Grpc
-	case "messages-for-rabbitmq":
-		dbConnection = connection.Amqps
-	case "databases-for-enterprisedb":
-		dbConnection = connection.Postgres
-	default:
-		return csEntry, fmt.Errorf("[ERROR] Unrecognised database type during connection string lookup: %s", service)
+	service := d.Get("service").(string)
+	dbConnection, cassandraConnection, err := databaseConnectionUri(service, connection)
+	if err != nil {
+		return nil, err
 	}
 
 	if !reflect.DeepEqual(cassandraConnection, icdv4.CassandraUri{}) {
@@ -2151,23 +2224,38 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 		}
 	} else {
 		csEntry = flex.CsEntry{
-			Name:     userName,
-			Password: "",
-			// Populate only first 'composed' connection string as an example
-			Composed:     dbConnection.Composed[0],
-			CertName:     dbConnection.Certificate.Name,
-			CertBase64:   dbConnection.Certificate.CertificateBase64,
-			Hosts:        dbConnection.Hosts,
-			Scheme:       dbConnection.Scheme,
-			Path:         dbConnection.Path,
-			QueryOptions: dbConnection.QueryOptions.(map[string]interface{}),
+			Name:       userName,
+			Password:   "",
+			CertName:   dbConnection.Certificate.Name,
+			CertBase64: dbConnection.Certificate.CertificateBase64,
+			Hosts:      dbConnection.Hosts,
+			Scheme:     dbConnection.Scheme,
+			Path:       dbConnection.Path,
+		}
+		// Populate only first 'composed' connection string as an example
+		if len(dbConnection.Composed) > 0 {
+			csEntry.Composed = dbConnection.Composed[0]
+		}
+		// the read-only endpoint of databases-for-mysql isn't an example of the requested endpoint
+		if service == "databases-for-mysql" {
+			for _, composed := range dbConnection.Composed {
+				hostName := databaseComposedHostName(composed)
+				if hostName != "" && databaseConnectionHasHost(dbConnection, hostName) {
+					csEntry.Composed = composed
+					break
+				}
+			}
+		}
+		// not every engine returns query options
+		if queryOptions, ok := dbConnection.QueryOptions.(map[string]interface{}); ok {
+			csEntry.QueryOptions = queryOptions
 		}
 
 		// Postgres DB name is of type string, Redis is json.Number, others are nil
 		if dbConnection.Database != nil {
 			switch v := dbConnection.Database.(type) {
 			default:
-				return csEntry, fmt.Errorf("Unexpected data type: %T", v)
+				return nil, fmt.Errorf("Unexpected data type: %T", v)
 			case json.Number:
 				csEntry.Database = dbConnection.Database.(json.Number).String()
 			case string:
@@ -2178,7 +2266,125 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 		}
 	}
 
-	return csEntry, nil
+	csEntries := []flex.CsEntry{csEntry}
+	if service == "databases-for-mysql" {
+		// the hosts are the ones of the requested endpoint, a composed connection string to any
+		// other host is the read-only endpoint
+		for _, composed := range dbConnection.Composed {
+			hostName := databaseComposedHostName(composed)
+			if hostName == "" || databaseConnectionHasHost(dbConnection, hostName) {
+				continue
+			}
+			readOnlyEntry := csEntry
+			readOnlyEntry.Composed = composed
+			readOnlyEntry.ReadOnly = true
+			csEntries = append(csEntries, readOnlyEntry)
+		}
+	}
+
+	return csEntries, nil
+}
+
+// databaseComposedHostName returns the host name of a composed connection string, or "" if it
+// can't be parsed.
+func databaseComposedHostName(composed string) string {
+	u, err := url.Parse(composed)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// databaseConnectionHasHost returns whether the host name is one of the hosts of the connection.
+func databaseConnectionHasHost(connection icdv4.Uri, hostName string) bool {
+	for _, host := range connection.Hosts {
+		if host.HostName == hostName {
+			return true
+		}
+	}
+	return false
+}
+
+// databaseConnectionUri returns the connection of the user for the service. DataStax secure
+// connect bundles of databases-for-cassandra are returned as the CassandraUri, any other engine
+// as the Uri of its protocol. Engines the provider doesn't know the protocol of use the first
+// connection found in the response.
+func databaseConnectionUri(service string, connection icdv4.Connection) (icdv4.Uri, icdv4.CassandraUri, error) {
+	switch service {
+	case "databases-for-postgresql", "databases-for-enterprisedb":
+		return connection.Postgres, icdv4.CassandraUri{}, nil
+	case "databases-for-redis":
+		return connection.Rediss, icdv4.CassandraUri{}, nil
+	case "databases-for-mongodb":
+		return connection.Mongo, icdv4.CassandraUri{}, nil
+	case "databases-for-mysql":
+		return connection.Mysql, icdv4.CassandraUri{}, nil
+	case "databases-for-elasticsearch":
+		return connection.Https, icdv4.CassandraUri{}, nil
+	case "databases-for-cassandra":
+		return icdv4.Uri{}, connection.Secure, nil
+	case "databases-for-etcd":
+		return connection.Grpc, icdv4.CassandraUri{}, nil
+	case "messages-for-rabbitmq":
+		return connection.Amqps, icdv4.CassandraUri{}, nil
+	}
+
+	for _, uri := range []icdv4.Uri{connection.Postgres, connection.Rediss, connection.Mongo, connection.Mysql,
+		connection.Https, connection.Grpc, connection.Amqps} {
+		if len(uri.Composed) > 0 || len(uri.Hosts) > 0 {
+			return uri, icdv4.CassandraUri{}, nil
+		}
+	}
+	if !reflect.DeepEqual(connection.Secure, icdv4.CassandraUri{}) {
+		return icdv4.Uri{}, connection.Secure, nil
+	}
+	return icdv4.Uri{}, icdv4.CassandraUri{}, fmt.Errorf("[ERROR] No connection found for database type %s", service)
+}
+
+// updateDatabaseConfiguration applies the configuration to the deployment. The SDK model for
+// MySQL does not include the group replication parameters, so for databases-for-mysql the
+// configuration is sent as provided.
+func updateDatabaseConfiguration(cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, instanceID, service string, rawConfig map[string]json.RawMessage, configuration clouddatabasesv5.ConfigurationIntf) (*clouddatabasesv5.UpdateDatabaseConfigurationResponse, *core.DetailedResponse, error) {
+	if service != "databases-for-mysql" {
+		updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
+			ID:            &instanceID,
+			Configuration: configuration,
+		}
+		return cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+	}
+
+	builder := core.NewRequestBuilder(core.PATCH)
+	builder.EnableGzipCompression = cloudDatabasesClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(cloudDatabasesClient.Service.Options.URL, `/deployments/{id}/configuration`, map[string]string{"id": instanceID})
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+
+	_, err = builder.SetBodyContentJSON(map[string]interface{}{"configuration": rawConfig})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := cloudDatabasesClient.Service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+
+	var result *clouddatabasesv5.UpdateDatabaseConfigurationResponse
+	err = core.UnmarshalModel(rawResponse, "", &result, clouddatabasesv5.UnmarshalUpdateDatabaseConfigurationResponse)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
 }
 
 func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -2591,6 +2797,19 @@ func flattenAutoScalingGroup(autoScalingGroup clouddatabasesv5.AutoscalingGroup)
 	return result
 }
 
+func flattenDatabaseRemotes(remotes *clouddatabasesv5.Remotes) []map[string]interface{} {
+	if remotes == nil {
+		return []map[string]interface{}{}
+	}
+	remote := map[string]interface{}{
+		"replicas": remotes.Replicas,
+	}
+	if remotes.Leader != nil {
+		remote["leader"] = *remotes.Leader
+	}
+	return []map[string]interface{}{remote}
+}
+
 func normalizeGroups(_groups []clouddatabasesv5.Group) (groups []Group) {
 	groups = make([]Group, len(_groups))
 	for _, g := range _groups {
@@ -46,6 +46,7 @@ func TestAccIBMDatabaseInstance_Etcd_Basic(t *testing.T) {
 					resource.TestMatchResourceAttr(name, "connectionstrings.1.certbase64", regexp.MustCompile("^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$")),
 					resource.TestCheckResourceAttr(name, "connectionstrings.0.hosts.#", "1"),
 					resource.TestCheckResourceAttr(name, "connectionstrings.0.database", ""),
+					resource.TestMatchResourceAttr(name, "connectionstrings.1.composed", regexp.MustCompile("^https://")),
 				),
 			},
 			{
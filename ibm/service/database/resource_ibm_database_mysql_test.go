@@ -67,6 +67,8 @@ func TestAccIBMMysqlDatabaseInstanceBasic(t *testing.T) {
 					resource.TestMatchResourceAttr(name, "connectionstrings.0.certname", regexp.MustCompile("[-a-z0-9]*")),
 					resource.TestMatchResourceAttr(name, "connectionstrings.0.certbase64", regexp.MustCompile("^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$")),
 					resource.TestMatchResourceAttr(name, "connectionstrings.0.database", regexp.MustCompile("[-a-z0-9]+")),
+					resource.TestCheckResourceAttr(name, "connectionstrings.0.read_only", "false"),
+					resource.TestCheckResourceAttrSet(name, "configuration_schema"),
 					resource.TestCheckResourceAttr(name, "tags.#", "1"),
 				),
 			},
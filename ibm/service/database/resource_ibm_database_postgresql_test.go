@@ -241,6 +241,42 @@ func TestAccIBMDatabaseInstancePostgresImport(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresServiceCredentials(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresServiceCredentials(databaseResourceGroup, serviceName, "Viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "service_credentials.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_credentials.0.name", "app"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_credentials.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_credentials.0.credentials_json"),
+					resource.TestCheckResourceAttr(resourceName, "service_credentials.1.endpoint", "private"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresServiceCredentials(databaseResourceGroup, serviceName, "Editor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "service_credentials.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_credentials.0.role", "Editor"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_credentials.0.credentials_json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMDatabaseInstancePostgresPITR(t *testing.T) {
 	t.Parallel()
 	databaseResourceGroup := "default"
@@ -281,6 +317,41 @@ func TestAccIBMDatabaseInstancePostgresPITR(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresReadReplicaResync(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	var databaseInstanceTwo string
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	replicaServiceName := serviceName + "-replica"
+	resourceName := "ibm_database." + serviceName
+	replicaResource := "ibm_database." + replicaServiceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresReadReplica(databaseResourceGroup, serviceName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					testAccCheckIBMDatabaseInstanceExists(replicaResource, &databaseInstanceTwo),
+					resource.TestCheckResourceAttr(resourceName, "remotes.0.replicas.#", "1"),
+					resource.TestCheckResourceAttrPair(replicaResource, "remotes.0.leader", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresReadReplica(databaseResourceGroup, serviceName, "resync"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(replicaResource, "resync_trigger", "resync"),
+					resource.TestCheckResourceAttrPair(replicaResource, "remotes.0.leader", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMDatabaseInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -694,6 +765,34 @@ func testAccCheckIBMDatabaseInstancePostgresImport(databaseResourceGroup string,
 				`, databaseResourceGroup, name, acc.Region())
 }
 
+func testAccCheckIBMDatabaseInstancePostgresServiceCredentials(databaseResourceGroup string, name string, role string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		service_endpoints = "public-and-private"
+
+		service_credentials {
+			name = "app"
+			role = "%[4]s"
+		}
+		service_credentials {
+			name     = "app-private"
+			role     = "Viewer"
+			endpoint = "private"
+		}
+	}
+				`, databaseResourceGroup, name, acc.Region(), role)
+}
+
 func testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -711,6 +810,33 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup string
 				`, databaseResourceGroup, name, acc.Region())
 }
 
+func testAccCheckIBMDatabaseInstancePostgresReadReplica(databaseResourceGroup string, name string, resyncTrigger string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+	}
+
+	resource "ibm_database" "%[2]s-replica" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s-replica"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		remote_leader_id  = ibm_database.%[2]s.id
+		resync_trigger    = "%[4]s"
+	}
+				`, databaseResourceGroup, name, acc.Region(), resyncTrigger)
+}
+
 func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
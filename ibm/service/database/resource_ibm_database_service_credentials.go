@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const databaseServiceCredentialRemovedState = "removed"
+
+func databaseServiceCredentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Service credentials (resource keys) of the deployment, a credential is recreated when its role or endpoint changes",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "The name of the service credential",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"role": {
+					Description: "The IAM role of the service credential, for example Administrator, Editor, Operator, Viewer, Manager, Writer or Reader",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"endpoint": {
+					Description:  "The endpoint of the connection details, public or private",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+				},
+				"id": {
+					Description: "The ID of the resource key",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"crn": {
+					Description: "The CRN of the resource key",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"credentials_json": {
+					Description: "The connection payload of the service credential as JSON",
+					Type:        schema.TypeString,
+					Computed:    true,
+					Sensitive:   true,
+				},
+			},
+		},
+	}
+}
+
+// validateServiceCredentialsDiff rejects service credentials sharing a name, credentials are matched
+// to their resource keys by name.
+func validateServiceCredentialsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	names := map[string]bool{}
+	for i, c := range diff.Get("service_credentials").([]interface{}) {
+		if !diff.NewValueKnown(fmt.Sprintf("service_credentials.%d.name", i)) {
+			continue
+		}
+		credential, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := credential["name"].(string)
+		if names[name] {
+			return fmt.Errorf("found 2 or more service_credentials with name %s", name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+// databaseServiceCredentialsUpdate creates the configured service credentials missing from the old list,
+// and deletes the ones no longer configured. A credential whose role or endpoint changed is recreated, as
+// resource keys can't be updated.
+func databaseServiceCredentialsUpdate(d *schema.ResourceData, meta interface{}, oldCredentials, newCredentials []interface{}) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	current := map[string]map[string]interface{}{}
+	for _, c := range oldCredentials {
+		credential := c.(map[string]interface{})
+		if credential["id"].(string) != "" {
+			current[credential["name"].(string)] = credential
+		}
+	}
+
+	credentials := make([]map[string]interface{}, 0, len(newCredentials))
+	for _, c := range newCredentials {
+		credential := c.(map[string]interface{})
+		name := credential["name"].(string)
+		if existing, ok := current[name]; ok {
+			delete(current, name)
+			if existing["role"].(string) == credential["role"].(string) && existing["endpoint"].(string) == credential["endpoint"].(string) {
+				credentials = append(credentials, existing)
+				continue
+			}
+			if err := databaseServiceCredentialDelete(rsConClient, existing["id"].(string)); err != nil {
+				return err
+			}
+		}
+		resourceKey, err := databaseServiceCredentialCreate(d, meta, rsConClient, credential)
+		if err != nil {
+			return err
+		}
+		credentials = append(credentials, map[string]interface{}{
+			"name":     name,
+			"role":     credential["role"].(string),
+			"endpoint": credential["endpoint"].(string),
+			"id":       *resourceKey.ID,
+			"crn":      *resourceKey.CRN,
+		})
+	}
+
+	for _, credential := range current {
+		if err := databaseServiceCredentialDelete(rsConClient, credential["id"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return d.Set("service_credentials", credentials)
+}
+
+func databaseServiceCredentialCreate(d *schema.ResourceData, meta interface{}, rsConClient *rc.ResourceControllerV2, credential map[string]interface{}) (*rc.ResourceKey, error) {
+	name := credential["name"].(string)
+	keyParameters := rc.ResourceKeyPostParameters{}
+	if endpoint := credential["endpoint"].(string); endpoint != "" {
+		keyParameters.SetProperty("service-endpoints", endpoint)
+	}
+
+	source := d.Id()
+	createResourceKeyOptions := &rc.CreateResourceKeyOptions{
+		Name:       &name,
+		Source:     &source,
+		Parameters: &keyParameters,
+	}
+	if role := credential["role"].(string); role != "" {
+		roleCRN, err := databaseServiceCredentialRoleCRN(role, d.Get("service").(string), meta)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error creating service credential (%s) when getting role: %s", name, err)
+		}
+		keyParameters.SetProperty("role_crn", roleCRN)
+		createResourceKeyOptions.Role = roleCRN
+	}
+
+	resourceKey, response, err := rsConClient.CreateResourceKey(createResourceKeyOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating service credential (%s): %s %s", name, err, response)
+	}
+	return resourceKey, nil
+}
+
+func databaseServiceCredentialDelete(rsConClient *rc.ResourceControllerV2, id string) error {
+	response, err := rsConClient.DeleteResourceKey(&rc.DeleteResourceKeyOptions{
+		ID: &id,
+	})
+	if err != nil {
+		if response != nil && (response.StatusCode == 404 || response.StatusCode == 410) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting service credential (%s): %s %s", id, err, response)
+	}
+	return nil
+}
+
+// databaseServiceCredentialsRead refreshes the connection payload of the service credentials in state, a
+// credential deleted outside of terraform is dropped so that the next apply creates it again.
+func databaseServiceCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	credentials := make([]map[string]interface{}, 0)
+	for _, c := range d.Get("service_credentials").([]interface{}) {
+		credential := c.(map[string]interface{})
+		id := credential["id"].(string)
+		if id == "" {
+			continue
+		}
+		resourceKey, response, err := rsConClient.GetResourceKey(&rc.GetResourceKeyOptions{
+			ID: &id,
+		})
+		if err != nil {
+			if response != nil && (response.StatusCode == 404 || response.StatusCode == 410) {
+				log.Printf("[WARN] Service credential (%s) of database (%s) not found", id, d.Id())
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error getting service credential (%s): %s %s", id, err, response)
+		}
+		if resourceKey.State != nil && *resourceKey.State == databaseServiceCredentialRemovedState {
+			continue
+		}
+		creds, err := json.Marshal(resourceKey.Credentials)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error marshalling service credential (%s) credentials: %s", id, err)
+		}
+		credentials = append(credentials, map[string]interface{}{
+			"name":             *resourceKey.Name,
+			"role":             credential["role"].(string),
+			"endpoint":         credential["endpoint"].(string),
+			"id":               id,
+			"crn":              *resourceKey.CRN,
+			"credentials_json": string(creds),
+		})
+	}
+	return d.Set("service_credentials", credentials)
+}
+
+func databaseServiceCredentialRoleCRN(roleName, serviceName string, meta interface{}) (*string, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	roleList, response, err := iamPolicyManagementClient.ListRoles(&iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &userDetails.UserAccount,
+		ServiceName: &serviceName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", err, response)
+	}
+
+	role, err := flex.FindRoleByName(flex.MapRoleListToPolicyRoles(*roleList), roleName)
+	if err != nil {
+		return nil, err
+	}
+	return role.RoleID, nil
+}
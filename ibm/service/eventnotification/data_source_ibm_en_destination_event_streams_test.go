@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnEventStreamsDestinationDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnEventStreamsDestinationDataSourceConfigBasic(instanceName, name, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "instance_guid"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "name"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "description"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "type"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "updated_at"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "destination_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_destination_event_streams.en_destination_data_6", "subscription_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnEventStreamsDestinationDataSourceConfigBasic(instanceName, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_destination_datasource2" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_destination_event_streams" "en_destination_datasource_4" {
+		instance_guid = ibm_resource_instance.en_destination_datasource2.guid
+		name        = "%s"
+		type        = "event_streams"
+		description = "%s"
+		config {
+			params {
+				crn      = "crn:v1:bluemix:public:messagehub:us-south:a/4a74ea4fbbf3a2cbb5da4a4b2ff2e8b5:1f4d2c4e-3b7e-4e1c-a8c5-8c2c7a1a9f6c::"
+				endpoint = "https://broker-0-0a0a0a0a0a0a0a0a.kafka.svc01.us-south.eventstreams.cloud.ibm.com:9093"
+				topic    = "en-notifications"
+			}
+		}
+	}
+
+	data "ibm_en_destination_event_streams" "en_destination_data_6" {
+		instance_guid  = ibm_resource_instance.en_destination_datasource2.guid
+		destination_id = ibm_en_destination_event_streams.en_destination_datasource_4.destination_id
+	}
+	`, instanceName, name, description)
+}
@@ -43,7 +43,7 @@ func DataSourceIBMEnHuaweiDestination() *schema.Resource {
 			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Destination type push_android.",
+				Description: "Destination type push_huawei.",
 			},
 			"config": {
 				Type:        schema.TypeList,
@@ -69,6 +69,7 @@ func DataSourceIBMEnHuaweiDestination() *schema.Resource {
 									"client_secret": {
 										Type:        schema.TypeString,
 										Computed:    true,
+										Sensitive:   true,
 										Description: "ClientSecret for the Huawei account oauth",
 									},
 								},
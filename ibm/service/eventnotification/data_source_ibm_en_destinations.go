@@ -9,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,20 @@ func DataSourceIBMEnDestinations() *schema.Resource {
 				Optional:    true,
 				Description: "Filter the destinations by name or type.",
 			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the destination with this exact name.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the destinations of this type, for example webhook or slack.",
+			},
 			"total_count": {
 				Type:        schema.TypeInt,
 				Computed:    true,
-				Description: "Total number of destinations.",
+				Description: "Total number of destinations, or the number of destinations matching name and type when set.",
 			},
 			"destinations": {
 				Type:        schema.TypeList,
@@ -121,14 +132,21 @@ func dataSourceIBMEnDestinationsRead(context context.Context, d *schema.Resource
 
 		offset = offset + limit
 
-		finalList = append(finalList, result.Destinations...)
+		for _, destination := range result.Destinations {
+			if enListItemMatches(d, "name", destination.Name) && enListItemMatches(d, "type", destination.Type) {
+				finalList = append(finalList, destination)
+			}
+		}
 
-		if offset > *result.TotalCount {
+		if result.TotalCount == nil || offset >= *result.TotalCount {
 			break
 		}
 	}
 
 	destinationList.Destinations = finalList
+	if enListFiltered(d, "name", "type") {
+		destinationList.TotalCount = core.Int64Ptr(int64(len(finalList)))
+	}
 
 	d.SetId(fmt.Sprintf("destinations/%s", *options.InstanceID))
 
@@ -33,6 +33,9 @@ func TestAccIBMEnDestinationsDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_en_destinations.data_destination_2", "destinations.0.type"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_destinations.data_destination_2", "destinations.0.id"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_destinations.data_destination_2", "destinations.0.description"),
+					resource.TestCheckResourceAttr("data.ibm_en_destinations.data_destination_filtered", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_en_destinations.data_destination_filtered", "destinations.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_en_destinations.data_destination_filtered", "destinations.0.type", "webhook"),
 				),
 			},
 		},
@@ -64,5 +67,11 @@ func testAccCheckIBMEnDestinationDatasourceConfig(instanceName, name, descriptio
 	data "ibm_en_destinations" "data_destination_2" {
 		instance_guid = ibm_resource_instance.en_destination_datasource.guid
 	}
+
+	data "ibm_en_destinations" "data_destination_filtered" {
+		instance_guid = ibm_en_destination.en_destination_datasource_1.instance_guid
+		name          = ibm_en_destination.en_destination_datasource_1.name
+		type          = "webhook"
+	}
 	`, instanceName, name, description)
 }
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func DataSourceIBMEnEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEnEmailTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for Template.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Template name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Template description.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Template type, smtp_custom.invitation or smtp_custom.notification.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+			"subscription_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of subscriptions using the template.",
+			},
+			"subscription_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of subscriptions using the template.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEnEmailTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.GetTemplateOptions{}
+
+	options.SetInstanceID(d.Get("instance_guid").(string))
+	options.SetID(d.Get("template_id").(string))
+
+	result, response, err := enClient.GetTemplateWithContext(context, options)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("GetTemplateWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
+
+	if err = d.Set("name", result.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+
+	if err = d.Set("type", result.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	if err = d.Set("description", result.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+
+	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	if err = d.Set("subscription_count", flex.IntValue(result.SubscriptionCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_count: %s", err))
+	}
+
+	if err = d.Set("subscription_names", result.SubscriptionNames); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_names: %s", err))
+	}
+
+	return nil
+}
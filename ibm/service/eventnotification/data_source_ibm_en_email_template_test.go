@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnEmailTemplateDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnEmailTemplateDataSourceConfigBasic(instanceName, name, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_en_email_template.en_template_data_1", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_email_template.en_template_data_1", "instance_guid"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_email_template.en_template_data_1", "template_id"),
+					resource.TestCheckResourceAttr("data.ibm_en_email_template.en_template_data_1", "name", name),
+					resource.TestCheckResourceAttr("data.ibm_en_email_template.en_template_data_1", "description", description),
+					resource.TestCheckResourceAttr("data.ibm_en_email_template.en_template_data_1", "type", "smtp_custom.notification"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_email_template.en_template_data_1", "updated_at"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_email_template.en_template_data_1", "subscription_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnEmailTemplateDataSourceConfigBasic(instanceName, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_template_datasource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_email_template" "en_template_datasource_1" {
+		instance_guid = ibm_resource_instance.en_template_datasource.guid
+		name          = "%s"
+		type          = "smtp_custom.notification"
+		description   = "%s"
+		params {
+			body    = base64encode("<html><body>{{ data.message }}</body></html>")
+			subject = "Notification"
+		}
+	}
+
+	data "ibm_en_email_template" "en_template_data_1" {
+		instance_guid = ibm_resource_instance.en_template_datasource.guid
+		template_id   = ibm_en_email_template.en_template_datasource_1.template_id
+	}
+	`, instanceName, name, description)
+}
@@ -100,7 +100,7 @@ func dataSourceIBMEnIntegrationsRead(context context.Context, d *schema.Resource
 
 		finalList = append(finalList, result.Integrations...)
 
-		if offset > *result.TotalCount {
+		if result.TotalCount == nil || offset >= *result.TotalCount {
 			break
 		}
 	}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type enMetrics struct {
+	Metrics []enMetric `json:"metrics"`
+}
+
+type enMetric struct {
+	Key       *string            `json:"key,omitempty"`
+	DocCount  *int64             `json:"doc_count,omitempty"`
+	Histogram *enMetricHistogram `json:"histogram,omitempty"`
+}
+
+type enMetricHistogram struct {
+	Buckets []enMetricBucket `json:"buckets"`
+}
+
+type enMetricBucket struct {
+	DocCount    *int64  `json:"doc_count,omitempty"`
+	KeyAsString *string `json:"key_as_string,omitempty"`
+}
+
+func DataSourceIBMEnMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEnMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"smtp_custom"}),
+				Description:  "Destination type, smtp_custom.",
+			},
+			"gte": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Start of the time range, in the format 2023-10-01T00:00:00Z.",
+			},
+			"lte": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "End of the time range, in the format 2023-10-31T23:59:59Z.",
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique identifier for the destination.",
+			},
+			"source_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique identifier for the source.",
+			},
+			"email_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Receiver email address.",
+			},
+			"notification_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique identifier for the notification.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email subject.",
+			},
+			"metrics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of metrics.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Metric name, for example success, bounced, deferred or opened.",
+						},
+						"doc_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of notifications for the metric in the time range.",
+						},
+						"histogram": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Distribution of the metric over the time range.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"buckets": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "List of buckets.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"doc_count": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "Number of notifications of the bucket.",
+												},
+												"key_as_string": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Timestamp of the bucket.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEnMetricsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_guid").(string)
+
+	query := map[string]string{
+		"destination_type": d.Get("destination_type").(string),
+		"gte":              d.Get("gte").(string),
+		"lte":              d.Get("lte").(string),
+	}
+	for _, key := range []string{"destination_id", "source_id", "email_to", "notification_id", "subject"} {
+		if v, ok := d.GetOk(key); ok {
+			query[key] = v.(string)
+		}
+	}
+
+	result := &enMetrics{}
+	response, err := enRequestWithQuery(context, enClient, core.GET, `/v1/instances/{instance_id}/metrics`,
+		map[string]string{"instance_id": instanceID}, query, nil, nil, result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("GetMetricsWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("metrics/%s", instanceID))
+
+	if err = d.Set("metrics", enFlattenMetrics(result.Metrics)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting metrics %s", err))
+	}
+
+	return nil
+}
+
+func enFlattenMetrics(result []enMetric) (metrics []map[string]interface{}) {
+	metrics = []map[string]interface{}{}
+	for _, metricItem := range result {
+		metric := map[string]interface{}{}
+		if metricItem.Key != nil {
+			metric["key"] = metricItem.Key
+		}
+		if metricItem.DocCount != nil {
+			metric["doc_count"] = metricItem.DocCount
+		}
+		if metricItem.Histogram != nil {
+			buckets := []map[string]interface{}{}
+			for _, bucketItem := range metricItem.Histogram.Buckets {
+				bucket := map[string]interface{}{}
+				if bucketItem.DocCount != nil {
+					bucket["doc_count"] = bucketItem.DocCount
+				}
+				if bucketItem.KeyAsString != nil {
+					bucket["key_as_string"] = bucketItem.KeyAsString
+				}
+				buckets = append(buckets, bucket)
+			}
+			metric["histogram"] = []map[string]interface{}{{"buckets": buckets}}
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics
+}
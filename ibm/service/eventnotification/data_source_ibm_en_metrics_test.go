@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnMetricsDataSourceBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnMetricsDataSourceConfigBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_en_metrics.en_metrics", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_metrics.en_metrics", "instance_guid"),
+					resource.TestCheckResourceAttr("data.ibm_en_metrics.en_metrics", "destination_type", "smtp_custom"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_metrics.en_metrics", "metrics.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnMetricsDataSourceConfigBasic(instanceName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_metrics_instance" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	data "ibm_en_metrics" "en_metrics" {
+		instance_guid    = ibm_resource_instance.en_metrics_instance.guid
+		destination_type = "smtp_custom"
+		gte              = "2023-10-01T00:00:00Z"
+		lte              = "2023-10-31T23:59:59Z"
+	}
+	`, instanceName)
+}
@@ -26,14 +26,34 @@ func DataSourceIBMEnSource() *schema.Resource {
 				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
 			},
 			"source_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Unique identifier for Source.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"source_id", "name"},
+				Description:  "Unique identifier for Source.",
 			},
 			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"source_id", "name"},
+				Description:  "Source name. Looks up the source by its exact name, including IBM Cloud managed sources.",
+			},
+			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Source name.",
+				Description: "Source type.",
+			},
+			"topic_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of topics the source is connected to.",
+			},
+			"topic_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the topics the source is connected to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -60,10 +80,20 @@ func dataSourceIBMEnSourceRead(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	instanceID := d.Get("instance_guid").(string)
+
+	sourceID := d.Get("source_id").(string)
+	if sourceID == "" {
+		sourceID, err = enSourceIDByName(context, enClient, instanceID, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	options := &en.GetSourceOptions{}
 
-	options.SetInstanceID(d.Get("instance_guid").(string))
-	options.SetID(d.Get("source_id").(string))
+	options.SetInstanceID(instanceID)
+	options.SetID(sourceID)
 
 	result, response, err := enClient.GetSourceWithContext(context, options)
 	if err != nil {
@@ -72,6 +102,10 @@ func dataSourceIBMEnSourceRead(context context.Context, d *schema.ResourceData,
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *options.ID))
 
+	if err = d.Set("source_id", options.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting source_id: %s", err))
+	}
+
 	if err = d.Set("name", result.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
 	}
@@ -86,9 +120,65 @@ func dataSourceIBMEnSourceRead(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enabled flag: %s", err))
 	}
 
+	if err = d.Set("type", result.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	if err = d.Set("topic_count", flex.IntValue(result.TopicCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting topic_count: %s", err))
+	}
+
+	if err = d.Set("topic_names", result.TopicNames); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting topic_names: %s", err))
+	}
+
 	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
 	}
 
 	return nil
 }
+
+// enSourceIDByName returns the ID of the source with the given name. The search filter
+// of the list API matches substrings, so the result is narrowed down to an exact match.
+func enSourceIDByName(context context.Context, enClient *en.EventNotificationsV1, instanceID, name string) (string, error) {
+	options := &en.ListSourcesOptions{}
+
+	options.SetInstanceID(instanceID)
+	options.SetSearch(name)
+
+	var offset int64 = 0
+	var limit int64 = 100
+
+	options.SetLimit(limit)
+
+	ids := []string{}
+	for {
+		options.SetOffset(offset)
+
+		result, response, err := enClient.ListSourcesWithContext(context, options)
+		if err != nil {
+			return "", fmt.Errorf("ListSourcesWithContext failed %s\n%s", err, response)
+		}
+
+		for _, source := range result.Sources {
+			if source.Name != nil && *source.Name == name && source.ID != nil {
+				ids = append(ids, *source.ID)
+			}
+		}
+
+		offset = offset + limit
+
+		if result.TotalCount == nil || offset >= *result.TotalCount {
+			break
+		}
+	}
+
+	if len(ids) == 0 {
+		return "", fmt.Errorf("[ERROR] No source found with name %s", name)
+	}
+	if len(ids) > 1 {
+		return "", fmt.Errorf("[ERROR] More than one source found with name %s, use source_id instead", name)
+	}
+	return ids[0], nil
+}
@@ -45,7 +45,7 @@ func testAccCheckIBMEnSourceDataSourceConfigBasic(instanceName, name, descriptio
 	}
 	
 	resource "ibm_en_source" "en_source_resource_1" {
-		instance_guid = ibm_resource_instance.en_source_resource.guid
+		instance_guid = ibm_resource_instance.en_source_datasource2.guid
 		name        = "%s"
 		enabled = true
 		description = "%s"
@@ -53,7 +53,50 @@ func testAccCheckIBMEnSourceDataSourceConfigBasic(instanceName, name, descriptio
 
 		data "ibm_en_source" "en_source_data_1" {
 			instance_guid = ibm_resource_instance.en_source_datasource2.guid
-			destination_id = ibm_en_source.en_source_datasource_4.destination_id
+			source_id = ibm_en_source.en_source_resource_1.source_id
 		}
 	`, instanceName, name, description)
 }
+
+func TestAccIBMEnSourceDataSourceByName(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnSourceDataSourceConfigByName(instanceName, name, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_en_source.en_source_data_1", "source_id", "ibm_en_source.en_source_resource_1", "source_id"),
+					resource.TestCheckResourceAttr("data.ibm_en_source.en_source_data_1", "name", name),
+					resource.TestCheckResourceAttr("data.ibm_en_source.en_source_data_1", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_source.en_source_data_1", "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnSourceDataSourceConfigByName(instanceName, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_source_datasource2" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_source" "en_source_resource_1" {
+		instance_guid = ibm_resource_instance.en_source_datasource2.guid
+		name        = "%s"
+		description = "%s"
+	}
+
+	data "ibm_en_source" "en_source_data_1" {
+		instance_guid = ibm_en_source.en_source_resource_1.instance_guid
+		name          = ibm_en_source.en_source_resource_1.name
+	}
+	`, instanceName, name, description)
+}
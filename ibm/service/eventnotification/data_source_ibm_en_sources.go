@@ -120,7 +120,7 @@ func dataSourceIBMEnSourcesRead(context context.Context, d *schema.ResourceData,
 
 		finalList = append(finalList, result.Sources...)
 
-		if offset > *result.TotalCount {
+		if offset >= *result.TotalCount {
 			break
 		}
 	}
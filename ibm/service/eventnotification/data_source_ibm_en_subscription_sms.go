@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -187,7 +188,10 @@ func dataSourceIBMEnSMSSubscriptionRead(context context.Context, d *schema.Resou
 func enSMSSubscriptionFlattenAttributes(result en.SubscriptionAttributesIntf) (finalList []map[string]interface{}) {
 	finalList = []map[string]interface{}{}
 
-	attributes := result.(*en.SubscriptionAttributes)
+	attributes, ok := result.(*en.SubscriptionAttributes)
+	if !ok {
+		return finalList
+	}
 
 	finalMap := enSMSSubscriptionToMap(attributes)
 	finalList = append(finalList, finalMap)
@@ -198,18 +202,42 @@ func enSMSSubscriptionFlattenAttributes(result en.SubscriptionAttributesIntf) (f
 func enSMSSubscriptionToMap(attributeItem *en.SubscriptionAttributes) (attributeMap map[string]interface{}) {
 	attributeMap = map[string]interface{}{}
 
-	invitedmap := make(map[string]interface{})
-	if attributeItem.Invited != nil {
-		invitedmap["invited"] = attributeItem.Invited
-	}
-	subscribedmap := make(map[string]interface{})
-	if attributeItem.Subscribed != nil {
-		subscribedmap["subscribed"] = attributeItem.Subscribed
-	}
-	unsubscribedmap := make(map[string]interface{})
-	if attributeItem.Unsubscribed != nil {
-		unsubscribedmap["unsubscribed"] = attributeItem.Unsubscribed
-	}
+	attributeMap["invited"] = enSMSInvitedNumbersToList(attributeItem.Invited)
+	attributeMap["subscribed"] = enSMSNumbersToList(attributeItem.Subscribed)
+	attributeMap["unsubscribed"] = enSMSNumbersToList(attributeItem.Unsubscribed)
 
 	return attributeMap
 }
+
+func enSMSInvitedNumbersToList(items []en.SmsInviteAttributesItems) []map[string]interface{} {
+	numbers := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		number := map[string]interface{}{}
+		if item.PhoneNumber != nil {
+			number["phone_number"] = *item.PhoneNumber
+		}
+		if item.UpdatedAt != nil {
+			number["updated_at"] = flex.DateTimeToString(item.UpdatedAt)
+		}
+		if item.ExpiresAt != nil {
+			number["expires_at"] = flex.DateTimeToString(item.ExpiresAt)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers
+}
+
+func enSMSNumbersToList(items []en.SmsAttributesItems) []map[string]interface{} {
+	numbers := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		number := map[string]interface{}{}
+		if item.PhoneNumber != nil {
+			number["phone_number"] = *item.PhoneNumber
+		}
+		if item.UpdatedAt != nil {
+			number["updated_at"] = flex.DateTimeToString(item.UpdatedAt)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,25 @@ func DataSourceIBMEnSubscriptions() *schema.Resource {
 				Optional:    true,
 				Description: "Filter the subscriptions by name",
 			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the subscription with this exact name.",
+			},
+			"destination_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the subscriptions to destinations of this type.",
+			},
+			"topic_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the subscriptions of this topic.",
+			},
 			"total_count": {
 				Type:        schema.TypeInt,
 				Computed:    true,
-				Description: "Number of subscriptions.",
+				Description: "Number of subscriptions, or the number of subscriptions matching name, destination_type and topic_id when set.",
 			},
 			"subscriptions": {
 				Type:        schema.TypeList,
@@ -128,14 +144,23 @@ func dataSourceIBMEnSubscriptionsRead(context context.Context, d *schema.Resourc
 
 		offset = offset + limit
 
-		finalList = append(finalList, result.Subscriptions...)
+		for _, subscription := range result.Subscriptions {
+			if enListItemMatches(d, "name", subscription.Name) &&
+				enListItemMatches(d, "destination_type", subscription.DestinationType) &&
+				enListItemMatches(d, "topic_id", subscription.TopicID) {
+				finalList = append(finalList, subscription)
+			}
+		}
 
-		if offset > *result.TotalCount {
+		if result.TotalCount == nil || offset >= *result.TotalCount {
 			break
 		}
 	}
 
 	subscriptionList.Subscriptions = finalList
+	if enListFiltered(d, "name", "destination_type", "topic_id") {
+		subscriptionList.TotalCount = core.Int64Ptr(int64(len(finalList)))
+	}
 
 	d.SetId(fmt.Sprintf("subscriptions_%s", d.Get("instance_guid").(string)))
 
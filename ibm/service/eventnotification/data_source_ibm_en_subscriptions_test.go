@@ -36,6 +36,9 @@ func TestAccIBMEnSubscriptionsDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_en_subscriptions.data_subscription_4", "subscriptions.0.topic_id"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_subscriptions.data_subscription_4", "subscriptions.0.topic_name"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_subscriptions.data_subscription_4", "subscriptions.0.updated_at"),
+					resource.TestCheckResourceAttr("data.ibm_en_subscriptions.data_subscription_filtered", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_en_subscriptions.data_subscription_filtered", "subscriptions.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_en_subscriptions.data_subscription_filtered", "subscriptions.0.destination_type", "webhook"),
 				),
 			},
 		},
@@ -86,5 +89,12 @@ func testAccCheckIBMEnSubscriptionsDataSourceConfigBasic(instanceName, name, des
 		instance_guid     = ibm_en_subscription.en_subscription_resource_6.instance_guid
 	}
 
+	data "ibm_en_subscriptions" "data_subscription_filtered" {
+		instance_guid    = ibm_en_subscription.en_subscription_resource_6.instance_guid
+		name             = ibm_en_subscription.en_subscription_resource_6.name
+		destination_type = "webhook"
+		topic_id         = ibm_en_topic.en_topic_resource_6.topic_id
+	}
+
 	`, instanceName, name, description)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -28,13 +29,18 @@ func DataSourceIBMEnTopics() *schema.Resource {
 			"total_count": {
 				Type:        schema.TypeInt,
 				Computed:    true,
-				Description: "Number of topics.",
+				Description: "Number of topics, or the number of topics matching name when set.",
 			},
 			"search_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Filter the topic by name",
 			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the topic with this exact name.",
+			},
 			"topics": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -115,14 +121,21 @@ func dataSourceIBMEnTopicsRead(context context.Context, d *schema.ResourceData,
 		}
 		offset = offset + limit
 
-		finalList = append(finalList, result.Topics...)
+		for _, topic := range result.Topics {
+			if enListItemMatches(d, "name", topic.Name) {
+				finalList = append(finalList, topic)
+			}
+		}
 
-		if offset > *result.TotalCount {
+		if result.TotalCount == nil || offset >= *result.TotalCount {
 			break
 		}
 	}
 
 	topicList.Topics = finalList
+	if enListFiltered(d, "name") {
+		topicList.TotalCount = core.Int64Ptr(int64(len(finalList)))
+	}
 
 	d.SetId(fmt.Sprintf("topics_%s", d.Get("instance_guid").(string)))
 
@@ -33,6 +33,8 @@ func TestAccIBMEnTopicsDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_en_topics.en_topic_datasource_6", "topics.0.description"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_topics.en_topic_datasource_6", "topics.0.source_count"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_topics.en_topic_datasource_6", "topics.0.subscription_count"),
+					resource.TestCheckResourceAttr("data.ibm_en_topics.en_topic_datasource_filtered", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_en_topics.en_topic_datasource_filtered", "topics.0.name", name),
 				),
 			},
 		},
@@ -57,5 +59,10 @@ func testAccCheckIBMEnTopicsDataSourceConfigBasic(instanceName, name, descriptio
 	data "ibm_en_topics" "en_topic_datasource_6" {
 		instance_guid = ibm_resource_instance.en_topic_datasource_1.guid
 	}
+
+	data "ibm_en_topics" "en_topic_datasource_filtered" {
+		instance_guid = ibm_en_topic.en_topic_datasource_4.instance_guid
+		name          = ibm_en_topic.en_topic_datasource_4.name
+	}
 	`, instanceName, name, description)
 }
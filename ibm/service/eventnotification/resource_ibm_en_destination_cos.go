@@ -6,10 +6,13 @@ package eventnotification
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -37,9 +40,11 @@ func ResourceIBMEnCOSDestination() *schema.Resource {
 				Description: "The Destintion name.",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The type of Destination Webhook.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"ibmcos"}),
+				Description:  "The type of Destination, ibmcos.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -67,7 +72,7 @@ func ResourceIBMEnCOSDestination() *schema.Resource {
 									"instance_id": {
 										Type:        schema.TypeString,
 										Required:    true,
-										Description: "The instance id for COS instance",
+										Description: "The instance id or CRN for COS instance",
 									},
 									"endpoint": {
 										Type:        schema.TypeString,
@@ -80,6 +85,12 @@ func ResourceIBMEnCOSDestination() *schema.Resource {
 					},
 				},
 			},
+			"skip_integration_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the check that an IAM authorization grants the Event Notifications instance write access to the COS instance.",
+			},
 			"destination_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -122,6 +133,11 @@ func resourceIBMEnCOSDestinationCreate(context context.Context, d *schema.Resour
 		options.SetDescription(d.Get("description").(string))
 	}
 	if _, ok := d.GetOk("config"); ok {
+		if !d.Get("skip_integration_validation").(bool) {
+			if err := checkCOSDestinationAuthorization(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		config := COSdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}), destinationtype)
 		options.SetConfig(&config)
 	}
@@ -228,6 +244,11 @@ func resourceIBMEnCOSDestinationUpdate(context context.Context, d *schema.Resour
 		}
 		destinationtype := d.Get("type").(string)
 		if _, ok := d.GetOk("config"); ok {
+			if d.HasChange("config") && !d.Get("skip_integration_validation").(bool) {
+				if err := checkCOSDestinationAuthorization(d, meta); err != nil {
+					return diag.FromErr(err)
+				}
+			}
 			config := COSdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}), destinationtype)
 			options.SetConfig(&config)
 		}
@@ -290,3 +311,58 @@ func COSdestinationConfigMapToDestinationConfig(configParams map[string]interfac
 	destinationConfig.Params = params
 	return *destinationConfig
 }
+
+// checkCOSDestinationAuthorization verifies that an IAM authorization policy lets the Event
+// Notifications instance write objects to the COS instance of the destination, so a missing
+// integration is reported up front instead of as failed deliveries.
+func checkCOSDestinationAuthorization(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+
+	enInstanceID := d.Get("instance_guid").(string)
+	cosInstanceID := d.Get("config.0.params.0.instance_id").(string)
+	// accept the CRN of the COS instance as well as its GUID
+	if crn := strings.Split(cosInstanceID, ":"); len(crn) > 7 {
+		cosInstanceID = crn[7]
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(userDetails.UserAccount),
+		Type:      core.StringPtr("authorization"),
+	}
+	policyList, response, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing authorization policies to validate the COS integration: %s\n%s", err, response)
+	}
+
+	for _, policy := range policyList.Policies {
+		if len(policy.Subjects) == 0 || len(policy.Resources) == 0 {
+			continue
+		}
+		source := policy.Subjects[0]
+		target := policy.Resources[0]
+		if *flex.GetSubjectAttribute("serviceName", source) != "event-notifications" ||
+			*flex.GetResourceAttribute("serviceName", target) != "cloud-object-storage" {
+			continue
+		}
+		if sourceInstance := *flex.GetSubjectAttribute("serviceInstance", source); sourceInstance != "" && sourceInstance != enInstanceID {
+			continue
+		}
+		if targetInstance := *flex.GetResourceAttribute("serviceInstance", target); targetInstance != "" && targetInstance != cosInstanceID {
+			continue
+		}
+		for _, role := range policy.Roles {
+			if role.DisplayName != nil && (*role.DisplayName == "Object Writer" || *role.DisplayName == "Writer" || *role.DisplayName == "Manager") {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("[ERROR] Event Notifications instance %s is not authorized to write to COS instance %s, create an ibm_iam_authorization_policy from event-notifications to cloud-object-storage with the Object Writer role, or set skip_integration_validation to skip this check", enInstanceID, cosInstanceID)
+}
@@ -5,6 +5,7 @@ package eventnotification_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -57,6 +58,32 @@ func TestAccIBMEnCOSDestinationAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMEnCOSDestinationInvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "ibm_en_destination_cos" "en_destination_resource_1" {
+					instance_guid = "8f2b5a6e-0548-41a0-a178-e95be2846477"
+					name          = "tf_cos_destination"
+					type          = "webhook"
+					config {
+						params {
+							bucket_name = "cos-destination-en-bucket"
+							instance_id = "42e13636e-0548-41a0-a178-e95be28464773"
+							endpoint    = "https://s3.us-west.cloud-object-storage.test.appdomain.cloud"
+						}
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`must contain a value from`),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMEnCOSDestinationConfig(instanceName, name, description string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_destination_resource" {
@@ -65,8 +92,17 @@ func testAccCheckIBMEnCOSDestinationConfig(instanceName, name, description strin
 		plan     = "standard"
 		service  = "event-notifications"
 	}
+
+	resource "ibm_iam_authorization_policy" "en_cos_policy" {
+		source_service_name         = "event-notifications"
+		source_resource_instance_id = ibm_resource_instance.en_destination_resource.guid
+		target_service_name         = "cloud-object-storage"
+		target_resource_instance_id = "42e13636e-0548-41a0-a178-e95be28464773"
+		roles                       = ["Object Writer"]
+	}
 	
 	resource "ibm_en_destination_cos" "en_destination_resource_1" {
+		depends_on    = [ibm_iam_authorization_policy.en_cos_policy]
 		instance_guid = ibm_resource_instance.en_destination_resource.guid
 		name        = "%s"
 		type        = "ibmcos"
@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func ResourceIBMEnCustomSMSDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnCustomSMSDestinationCreate,
+		ReadContext:   resourceIBMEnCustomSMSDestinationRead,
+		UpdateContext: resourceIBMEnCustomSMSDestinationUpdate,
+		DeleteContext: resourceIBMEnCustomSMSDestinationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Destintion name.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of Destination type sms_custom.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Destination description.",
+			},
+			"config": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Payload describing a destination configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"params": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Required:    true,
+							Description: "The sender configuration of the custom SMS destination.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sender_phone_numbers": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "The phone numbers, in E.164 format, the SMS are sent from.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Destination ID",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+			"subscription_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of subscriptions.",
+			},
+			"subscription_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of subscriptions.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMEnCustomSMSDestinationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.CreateDestinationOptions{}
+
+	options.SetInstanceID(d.Get("instance_guid").(string))
+	options.SetName(d.Get("name").(string))
+
+	options.SetType(d.Get("type").(string))
+	if _, ok := d.GetOk("description"); ok {
+		options.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("config"); ok {
+		config := CustomSMSdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}))
+		options.SetConfig(&config)
+	}
+	result, response, err := enClient.CreateDestinationWithContext(context, options)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
+
+	return resourceIBMEnCustomSMSDestinationRead(context, d, meta)
+}
+
+func resourceIBMEnCustomSMSDestinationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.GetDestinationOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	result, senderPhoneNumbers, response, err := enCustomSMSDestinationGet(context, enClient, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_guid", options.InstanceID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_guid: %s", err))
+	}
+
+	if err = d.Set("destination_id", options.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting destination_id: %s", err))
+	}
+
+	if err = d.Set("name", result.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+
+	if err = d.Set("type", result.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	if err = d.Set("description", result.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+
+	if err = d.Set("config", enCustomSMSDestinationFlattenConfig(senderPhoneNumbers)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting config: %s", err))
+	}
+
+	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	if err = d.Set("subscription_count", flex.IntValue(result.SubscriptionCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_count: %s", err))
+	}
+
+	if err = d.Set("subscription_names", result.SubscriptionNames); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_names: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMEnCustomSMSDestinationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.UpdateDestinationOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	if ok := d.HasChanges("name", "description", "config"); ok {
+		options.SetName(d.Get("name").(string))
+
+		if _, ok := d.GetOk("description"); ok {
+			options.SetDescription(d.Get("description").(string))
+		}
+		if _, ok := d.GetOk("config"); ok {
+			config := CustomSMSdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}))
+			options.SetConfig(&config)
+		}
+		_, response, err := enClient.UpdateDestinationWithContext(context, options)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+		}
+
+		return resourceIBMEnCustomSMSDestinationRead(context, d, meta)
+	}
+
+	return nil
+}
+
+func resourceIBMEnCustomSMSDestinationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.DeleteDestinationOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	response, err := enClient.DeleteDestinationWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// enCustomSMSDestinationConfigParams are the params of a custom SMS destination config. The SDK has
+// no model for the sender phone numbers, the embedded params only make it a DestinationConfigOneOfIntf.
+type enCustomSMSDestinationConfigParams struct {
+	*en.DestinationConfigOneOf
+	SenderPhoneNumbers []string `json:"sender_phone_numbers,omitempty"`
+}
+
+func CustomSMSdestinationConfigMapToDestinationConfig(configParams map[string]interface{}) en.DestinationConfig {
+	params := new(enCustomSMSDestinationConfigParams)
+	if configParams["sender_phone_numbers"] != nil {
+		params.SenderPhoneNumbers = flex.ExpandStringList(configParams["sender_phone_numbers"].([]interface{}))
+	}
+
+	destinationConfig := new(en.DestinationConfig)
+	destinationConfig.Params = params
+	return *destinationConfig
+}
+
+func enCustomSMSDestinationFlattenConfig(senderPhoneNumbers []string) []map[string]interface{} {
+	if len(senderPhoneNumbers) == 0 {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			"params": []map[string]interface{}{
+				{
+					"sender_phone_numbers": senderPhoneNumbers,
+				},
+			},
+		},
+	}
+}
+
+// enCustomSMSDestinationGet gets a custom SMS destination together with its sender phone numbers,
+// which GetDestination drops while decoding the config.
+func enCustomSMSDestinationGet(context context.Context, enClient *en.EventNotificationsV1, options *en.GetDestinationOptions) (result *en.Destination, senderPhoneNumbers []string, response *core.DetailedResponse, err error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = enClient.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(enClient.Service.Options.URL, `/v1/instances/{instance_id}/destinations/{id}`,
+		map[string]string{"instance_id": *options.InstanceID, "id": *options.ID})
+	if err != nil {
+		return
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = enClient.Service.Request(request, &rawResponse)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(rawResponse, "", &result, en.UnmarshalDestination)
+	if err != nil {
+		return
+	}
+
+	config := struct {
+		Params struct {
+			SenderPhoneNumbers []string `json:"sender_phone_numbers"`
+		} `json:"params"`
+	}{}
+	if rawConfig, ok := rawResponse["config"]; ok {
+		if err = json.Unmarshal(rawConfig, &config); err != nil {
+			return
+		}
+	}
+	senderPhoneNumbers = config.Params.SenderPhoneNumbers
+	return
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func TestAccIBMEnCustomSMSDestinationAllArgs(t *testing.T) {
+	var config en.Destination
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	newName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	newDescription := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnCustomSMSDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnCustomSMSDestinationConfig(instanceName, name, description),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEnCustomSMSDestinationExists("ibm_en_destination_custom_sms.en_destination_resource_1", config),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "name", name),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "type", "sms_custom"),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "description", description),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "config.0.params.0.sender_phone_numbers.0", "+15678923404"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnCustomSMSDestinationConfig(instanceName, newName, newDescription),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "name", newName),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "type", "sms_custom"),
+					resource.TestCheckResourceAttr("ibm_en_destination_custom_sms.en_destination_resource_1", "description", newDescription),
+				),
+			},
+			{
+				ResourceName:      "ibm_en_destination_custom_sms.en_destination_resource_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnCustomSMSDestinationConfig(instanceName, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_destination_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+	
+	resource "ibm_en_destination_custom_sms" "en_destination_resource_1" {
+		instance_guid = ibm_resource_instance.en_destination_resource.guid
+		name        = "%s"
+		type        = "sms_custom"
+		description = "%s"
+		config {
+			params {
+				sender_phone_numbers = ["+15678923404"]
+			}
+		}
+	}
+	`, instanceName, name, description)
+}
+
+func testAccCheckIBMEnCustomSMSDestinationExists(n string, obj en.Destination) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		enClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EventNotificationsApiV1()
+		if err != nil {
+			return err
+		}
+
+		options := &en.GetDestinationOptions{}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		options.SetInstanceID(parts[0])
+		options.SetID(parts[1])
+
+		result, _, err := enClient.GetDestination(options)
+		if err != nil {
+			return err
+		}
+
+		obj = *result
+		return nil
+	}
+}
+
+func testAccCheckIBMEnCustomSMSDestinationDestroy(s *terraform.State) error {
+	enClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "en_destination_resource_1" {
+			continue
+		}
+
+		options := &en.GetDestinationOptions{}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		options.SetInstanceID(parts[0])
+		options.SetID(parts[1])
+
+		// Try to find the key
+		_, response, err := enClient.GetDestination(options)
+
+		if err == nil {
+			return fmt.Errorf("en_destination still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for en_destination (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func ResourceIBMEnEventStreamsDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnEventStreamsDestinationCreate,
+		ReadContext:   resourceIBMEnEventStreamsDestinationRead,
+		UpdateContext: resourceIBMEnEventStreamsDestinationUpdate,
+		DeleteContext: resourceIBMEnEventStreamsDestinationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Destintion name.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of Destination type event_streams.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Destination description.",
+			},
+			"config": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Payload describing a destination configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"params": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"crn": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "CRN of the Event Streams instance.",
+									},
+									"endpoint": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Kafka broker endpoint of the Event Streams instance.",
+									},
+									"topic": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Event Streams topic to which the notifications are sent.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Destination ID",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+			"subscription_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of subscriptions.",
+			},
+			"subscription_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of subscriptions.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMEnEventStreamsDestinationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_guid").(string)
+
+	form := map[string]interface{}{
+		"name": d.Get("name").(string),
+		"type": d.Get("type").(string),
+	}
+	if _, ok := d.GetOk("description"); ok {
+		form["description"] = d.Get("description").(string)
+	}
+	if _, ok := d.GetOk("config"); ok {
+		form["config"] = EventStreamsdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}))
+	}
+
+	result, response, err := enCreateRawDestination(context, enClient, instanceID, form)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("CreateDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *result.ID))
+
+	return resourceIBMEnEventStreamsDestinationRead(context, d, meta)
+}
+
+func resourceIBMEnEventStreamsDestinationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, response, err := enGetRawDestination(context, enClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_guid", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_guid: %s", err))
+	}
+
+	if err = d.Set("destination_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting destination_id: %s", err))
+	}
+
+	if err = d.Set("name", result.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+
+	if err = d.Set("type", result.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	if err = d.Set("description", result.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+
+	if result.Config != nil {
+		err = d.Set("config", enEventStreamsDestinationFlattenConfig(*result.Config))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting config %s", err))
+		}
+	}
+
+	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	if err = d.Set("subscription_count", flex.IntValue(result.SubscriptionCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_count: %s", err))
+	}
+
+	if err = d.Set("subscription_names", result.SubscriptionNames); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_names: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMEnEventStreamsDestinationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if ok := d.HasChanges("name", "description", "config"); ok {
+		form := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+
+		if _, ok := d.GetOk("description"); ok {
+			form["description"] = d.Get("description").(string)
+		}
+
+		if _, ok := d.GetOk("config"); ok {
+			form["config"] = EventStreamsdestinationConfigMapToDestinationConfig(d.Get("config.0.params.0").(map[string]interface{}))
+		}
+
+		response, err := enUpdateRawDestination(context, enClient, parts[0], parts[1], form)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateDestinationWithContext failed %s\n%s", err, response))
+		}
+
+		return resourceIBMEnEventStreamsDestinationRead(context, d, meta)
+	}
+
+	return nil
+}
+
+func resourceIBMEnEventStreamsDestinationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.DeleteDestinationOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	response, err := enClient.DeleteDestinationWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("DeleteDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// The SDK has no model for Event Streams destinations, so the configuration is sent as a
+// plain map that serializes to the documented payload.
+func EventStreamsdestinationConfigMapToDestinationConfig(configParams map[string]interface{}) enRawDestinationConfig {
+	params := map[string]interface{}{}
+	for _, key := range []string{"crn", "endpoint", "topic"} {
+		if configParams[key] != nil {
+			params[key] = configParams[key].(string)
+		}
+	}
+
+	return enRawDestinationConfig{Params: params}
+}
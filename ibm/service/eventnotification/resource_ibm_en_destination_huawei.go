@@ -72,6 +72,7 @@ func ResourceIBMEnHuaweiDestination() *schema.Resource {
 									"client_secret": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "ClientSecret for the Huawei account oauth.",
 									},
 								},
@@ -63,12 +63,13 @@ func ResourceIBMEnPagerDutyDestination() *schema.Resource {
 										Type:        schema.TypeString,
 										Sensitive:   true,
 										Optional:    true,
-										Description: "The api key for chrome app authorization",
+										Description: "The PagerDuty API key",
 									},
 									"routing_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
-										Description: "The website url",
+										Description: "The PagerDuty routing key of the integration",
 									},
 								},
 							},
@@ -76,6 +77,12 @@ func ResourceIBMEnPagerDutyDestination() *schema.Resource {
 					},
 				},
 			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, sends the routing key and API key to the destination again. The destination and its subscriptions are kept.",
+			},
 			"destination_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -129,7 +136,7 @@ func resourceIBMEnPagerDutyDestinationCreate(context context.Context, d *schema.
 
 	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
 
-	return resourceIBMEnChromeDestinationRead(context, d, meta)
+	return resourceIBMEnPagerDutyDestinationRead(context, d, meta)
 }
 
 func resourceIBMEnPagerDutyDestinationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -215,7 +222,8 @@ func resourceIBMEnPagerDutyDestinationUpdate(context context.Context, d *schema.
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
 
-	if ok := d.HasChanges("name", "description", "config"); ok {
+	// a keepers change rotates the routing key in place, the update always carries the whole config
+	if ok := d.HasChanges("name", "description", "config", "keepers"); ok {
 		options.SetName(d.Get("name").(string))
 
 		if _, ok := d.GetOk("description"); ok {
@@ -57,6 +57,45 @@ func TestAccIBMEnPagerDutyDestinationAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMEnPagerDutyDestinationRotateRoutingKey(t *testing.T) {
+	var config en.Destination
+	var destinationID string
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnPagerDutyDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnPagerDutyDestinationKeepersConfig(instanceName, name, "33220320pgdpgpewwp", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEnPagerDutyDestinationExists("ibm_en_destination_pagerduty.en_destination_resource_1", config),
+					resource.TestCheckResourceAttr("ibm_en_destination_pagerduty.en_destination_resource_1", "keepers.rotation", "1"),
+					func(s *terraform.State) error {
+						destinationID = s.RootModule().Resources["ibm_en_destination_pagerduty.en_destination_resource_1"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckIBMEnPagerDutyDestinationKeepersConfig(instanceName, name, "44330430pgdpgpewwp", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["ibm_en_destination_pagerduty.en_destination_resource_1"].Primary.ID; id != destinationID {
+							return fmt.Errorf("destination was recreated: %s != %s", id, destinationID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("ibm_en_destination_pagerduty.en_destination_resource_1", "config.0.params.0.routing_key", "44330430pgdpgpewwp"),
+					resource.TestCheckResourceAttr("ibm_en_destination_pagerduty.en_destination_resource_1", "keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMEnPagerDutyDestinationConfig(instanceName, name, description string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_destination_resource" {
@@ -81,6 +120,32 @@ func testAccCheckIBMEnPagerDutyDestinationConfig(instanceName, name, description
 	`, instanceName, name, description)
 }
 
+func testAccCheckIBMEnPagerDutyDestinationKeepersConfig(instanceName, name, routingKey, rotation string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_destination_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+	
+	resource "ibm_en_destination_pagerduty" "en_destination_resource_1" {
+		instance_guid = ibm_resource_instance.en_destination_resource.guid
+		name        = "%s"
+		type        = "pagerduty"
+		config {
+			params {
+				routing_key = "%s"
+				api_key     = "dwvdouqufqwojji"
+			}
+		}
+		keepers = {
+			rotation = "%s"
+		}
+	}
+	`, instanceName, name, routingKey, rotation)
+}
+
 func testAccCheckIBMEnPagerDutyDestinationExists(n string, obj en.Destination) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
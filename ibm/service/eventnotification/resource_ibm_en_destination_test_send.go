@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type enDestinationTestSendResponse struct {
+	Status         *string `json:"status,omitempty"`
+	NotificationID *string `json:"notification_id,omitempty"`
+}
+
+func ResourceIBMEnDestinationTestSend() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnDestinationTestSendCreate,
+		ReadContext:   resourceIBMEnDestinationTestSendRead,
+		DeleteContext: resourceIBMEnDestinationTestSendDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for the destination to send the test notification to.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values, changing any of them sends a new test notification.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the test notification.",
+			},
+			"notification_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the test notification, set for destinations tested asynchronously.",
+			},
+		},
+	}
+}
+
+func resourceIBMEnDestinationTestSendCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_guid").(string)
+	destinationID := d.Get("destination_id").(string)
+
+	result := &enDestinationTestSendResponse{}
+	response, err := enRequest(context, enClient, core.POST, `/v1/instances/{instance_id}/destinations/{id}/test`,
+		map[string]string{"instance_id": instanceID, "id": destinationID}, nil, map[string]interface{}{}, result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("TestDestinationWithContext failed, the destination %s is not reachable: %s\n%s", destinationID, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, destinationID))
+
+	if err = d.Set("status", result.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+
+	if err = d.Set("notification_id", result.NotificationID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting notification_id: %s", err))
+	}
+
+	return resourceIBMEnDestinationTestSendRead(context, d, meta)
+}
+
+func resourceIBMEnDestinationTestSendRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The test result is only known at create, drop it once the destination is gone
+	_, response, err := enGetRawDestination(context, enClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetDestinationWithContext failed %s\n%s", err, response))
+	}
+
+	return nil
+}
+
+func resourceIBMEnDestinationTestSendDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnDestinationTestSendBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnDestinationTestSendConfig(instanceName, name, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_en_destination_test_send.en_destination_test_send", "id"),
+					resource.TestCheckResourceAttrSet("ibm_en_destination_test_send.en_destination_test_send", "status"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnDestinationTestSendConfig(instanceName, name, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_destination_test_send.en_destination_test_send", "triggers.url_version", "v2"),
+					resource.TestCheckResourceAttrSet("ibm_en_destination_test_send.en_destination_test_send", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnDestinationTestSendConfig(instanceName, name, version string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_destination_test_send_instance" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_destination_webhook" "en_destination_test_send_webhook" {
+		instance_guid = ibm_resource_instance.en_destination_test_send_instance.guid
+		name          = "%s"
+		type          = "webhook"
+		description   = "Destination verified by a test notification"
+		config {
+			params {
+				verb = "POST"
+				url  = "https://demo.webhook.com"
+			}
+		}
+	}
+
+	resource "ibm_en_destination_test_send" "en_destination_test_send" {
+		instance_guid  = ibm_resource_instance.en_destination_test_send_instance.guid
+		destination_id = ibm_en_destination_webhook.en_destination_test_send_webhook.destination_id
+		triggers = {
+			url_version = "%s"
+		}
+	}
+	`, instanceName, name, version)
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func ResourceIBMEnEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnEmailTemplateCreate,
+		ReadContext:   resourceIBMEnEmailTemplateRead,
+		UpdateContext: resourceIBMEnEmailTemplateUpdate,
+		DeleteContext: resourceIBMEnEmailTemplateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Template name.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{
+					en.CreateTemplateOptionsTypeSMTPCustomInvitationConst,
+					en.CreateTemplateOptionsTypeSMTPCustomNotificationConst,
+				}),
+				Description: "The type of template, smtp_custom.invitation or smtp_custom.notification.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Template description.",
+			},
+			"params": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Required:    true,
+				Description: "Payload describing a template configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateEnTemplateBody,
+							Description:  "The base64 encoded HTML body of the template.",
+						},
+						"subject": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The email subject of the template.",
+						},
+					},
+				},
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Template ID.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time, changes every time a new version of the template is saved.",
+			},
+			"subscription_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of subscriptions using the template.",
+			},
+			"subscription_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of subscriptions using the template.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func validateEnTemplateBody(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a base64 encoded HTML document: %s", k, err))
+	}
+	return
+}
+
+func resourceIBMEnEmailTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.CreateTemplateOptions{}
+
+	options.SetInstanceID(d.Get("instance_guid").(string))
+	options.SetName(d.Get("name").(string))
+	options.SetType(d.Get("type").(string))
+	if _, ok := d.GetOk("description"); ok {
+		options.SetDescription(d.Get("description").(string))
+	}
+	options.SetParams(enEmailTemplateMapToTemplateConfig(d.Get("params.0").(map[string]interface{})))
+
+	result, response, err := enClient.CreateTemplateWithContext(context, options)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("CreateTemplateWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
+
+	return resourceIBMEnEmailTemplateRead(context, d, meta)
+}
+
+func resourceIBMEnEmailTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.GetTemplateOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	result, response, err := enClient.GetTemplateWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetTemplateWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_guid", options.InstanceID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_guid: %s", err))
+	}
+
+	if err = d.Set("template_id", options.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting template_id: %s", err))
+	}
+
+	if err = d.Set("name", result.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+
+	if err = d.Set("type", result.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	if err = d.Set("description", result.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+
+	// The template body and subject are not returned by the API, params is kept as configured.
+
+	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	if err = d.Set("subscription_count", flex.IntValue(result.SubscriptionCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_count: %s", err))
+	}
+
+	if err = d.Set("subscription_names", result.SubscriptionNames); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_names: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMEnEmailTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.UpdateTemplateOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	if ok := d.HasChanges("name", "type", "description", "params"); ok {
+		options.SetName(d.Get("name").(string))
+		options.SetType(d.Get("type").(string))
+		options.SetDescription(d.Get("description").(string))
+		options.SetParams(enEmailTemplateMapToTemplateConfig(d.Get("params.0").(map[string]interface{})))
+
+		_, response, err := enClient.UpdateTemplateWithContext(context, options)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateTemplateWithContext failed %s\n%s", err, response))
+		}
+
+		return resourceIBMEnEmailTemplateRead(context, d, meta)
+	}
+
+	return nil
+}
+
+func resourceIBMEnEmailTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &en.DeleteTemplateOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	response, err := enClient.DeleteTemplateWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("DeleteTemplateWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func enEmailTemplateMapToTemplateConfig(params map[string]interface{}) *en.TemplateConfig {
+	templateConfig := new(en.TemplateConfig)
+	if params["body"] != nil {
+		templateConfig.Body = core.StringPtr(params["body"].(string))
+	}
+	if params["subject"] != nil {
+		templateConfig.Subject = core.StringPtr(params["subject"].(string))
+	}
+	return templateConfig
+}
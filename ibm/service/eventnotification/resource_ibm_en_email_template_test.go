@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+func TestAccIBMEnEmailTemplateAllArgs(t *testing.T) {
+	var config en.Template
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	newName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	newDescription := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnEmailTemplateConfig(instanceName, name, description, "Invitation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEnEmailTemplateExists("ibm_en_email_template.en_template_resource_1", config),
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "name", name),
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "type", "smtp_custom.invitation"),
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "description", description),
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "params.0.subject", "Invitation"),
+					resource.TestCheckResourceAttrSet("ibm_en_email_template.en_template_resource_1", "template_id"),
+					resource.TestCheckResourceAttrSet("ibm_en_email_template.en_template_resource_1", "updated_at"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnEmailTemplateConfig(instanceName, newName, newDescription, "Updated Invitation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "name", newName),
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "description", newDescription),
+					resource.TestCheckResourceAttr("ibm_en_email_template.en_template_resource_1", "params.0.subject", "Updated Invitation"),
+				),
+			},
+			{
+				ResourceName:            "ibm_en_email_template.en_template_resource_1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"params"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnEmailTemplateConfig(instanceName, name, description, subject string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_template_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_email_template" "en_template_resource_1" {
+		instance_guid = ibm_resource_instance.en_template_resource.guid
+		name          = "%s"
+		type          = "smtp_custom.invitation"
+		description   = "%s"
+		params {
+			body    = base64encode("<html><body>You are invited</body></html>")
+			subject = "%s"
+		}
+	}
+	`, instanceName, name, description, subject)
+}
+
+func testAccCheckIBMEnEmailTemplateExists(n string, obj en.Template) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		enClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EventNotificationsApiV1()
+		if err != nil {
+			return err
+		}
+
+		options := &en.GetTemplateOptions{}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		options.SetInstanceID(parts[0])
+		options.SetID(parts[1])
+
+		result, _, err := enClient.GetTemplate(options)
+		if err != nil {
+			return err
+		}
+
+		obj = *result
+		return nil
+	}
+}
+
+func testAccCheckIBMEnEmailTemplateDestroy(s *terraform.State) error {
+	enClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_en_email_template" {
+			continue
+		}
+
+		options := &en.GetTemplateOptions{}
+
+		parts, err := flex.SepIdParts(rs.Primary.ID, "/")
+		if err != nil {
+			return err
+		}
+
+		options.SetInstanceID(parts[0])
+		options.SetID(parts[1])
+
+		_, response, err := enClient.GetTemplate(options)
+
+		if err == nil {
+			return fmt.Errorf("en_email_template still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for en_email_template (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
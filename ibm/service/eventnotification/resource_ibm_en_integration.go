@@ -9,12 +9,29 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	enIntegrationTypeKMS  = "kms"
+	enIntegrationTypeHPCS = "hs-crypto"
+	enIntegrationTypeCOS  = "collect_failed_events"
+)
+
+// enRawIntegration is the integration payload of the API, the SDK only models the key
+// management metadata and not the bucket of the COS integration.
+type enRawIntegration struct {
+	ID        *string                `json:"id,omitempty"`
+	Type      *string                `json:"type,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	UpdatedAt *string                `json:"updated_at,omitempty"`
+}
+
 func ResourceIBMEnIntegration() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMEnIntegrationCreate,
@@ -22,6 +39,10 @@ func ResourceIBMEnIntegration() *schema.Resource {
 		UpdateContext: resourceIBMEnIntegrationUpdate,
 		DeleteContext: resourceIBMEnIntegrationDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.ForceNewIfChange("type", func(ctx context.Context, old, new, meta interface{}) bool {
+			// kms and hs-crypto replace each other, a COS integration is a different integration
+			return old.(string) == enIntegrationTypeCOS || new.(string) == enIntegrationTypeCOS
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"instance_guid": {
@@ -32,13 +53,18 @@ func ResourceIBMEnIntegration() *schema.Resource {
 			},
 			"integration_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for the integration. The key management integration of the instance is looked up when not set, a COS integration is created.",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The type of key integration kms/hs-crypto.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{
+					enIntegrationTypeKMS, enIntegrationTypeHPCS, enIntegrationTypeCOS,
+				}),
+				Description: "The type of integration kms/hs-crypto for BYOK, collect_failed_events for a COS bucket.",
 			},
 			"metadata": {
 				Type:     schema.TypeList,
@@ -58,8 +84,13 @@ func ResourceIBMEnIntegration() *schema.Resource {
 						},
 						"root_key_id": {
 							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The value of root key id",
+							Optional:    true,
+							Description: "The value of root key id, required for kms/hs-crypto",
+						},
+						"bucket_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the COS bucket, required for collect_failed_events",
 						},
 					},
 				},
@@ -79,17 +110,58 @@ func resourceIBMEnIntegrationCreate(context context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	options := &en.ReplaceIntegrationOptions{}
+	instanceID := d.Get("instance_guid").(string)
+	integrationType := d.Get("type").(string)
+	metadataParams := d.Get("metadata.0").(map[string]interface{})
+	if err := enValidateIntegrationMetadata(integrationType, metadataParams); err != nil {
+		return diag.FromErr(err)
+	}
 
-	options.SetInstanceID(d.Get("instance_guid").(string))
-	options.SetID(d.Get("integration_id").(string))
-	options.SetType(d.Get("type").(string))
+	if integrationType == enIntegrationTypeCOS {
+		id := d.Get("integration_id").(string)
+		if id == "" {
+			result := &enRawIntegration{}
+			body := map[string]interface{}{
+				"type":     integrationType,
+				"metadata": enIntegrationMapRawMetadata(integrationType, metadataParams),
+			}
+			response, err := enRequest(context, enClient, core.POST, `/v1/instances/{instance_id}/integrations`,
+				map[string]string{"instance_id": instanceID}, nil, body, result)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("CreateIntegrationWithContext failed %s\n%s", err, response))
+			}
+			if result.ID == nil {
+				return diag.FromErr(fmt.Errorf("CreateIntegrationWithContext failed, integration ID missing from response\n%s", response))
+			}
+			id = *result.ID
+		} else {
+			response, err := enReplaceRawIntegration(context, enClient, instanceID, id, integrationType, metadataParams)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("ReplaceIntegrationWithContext failed %s\n%s", err, response))
+			}
+		}
+		d.SetId(fmt.Sprintf("%s/%s", instanceID, id))
+		return resourceIBMEnIntegrationRead(context, d, meta)
+	}
 
-	if _, ok := d.GetOk("metadata"); ok {
-		metadata := ReplaceIntegrationMapMetadata(d.Get("metadata.0").(map[string]interface{}))
-		options.SetMetadata(&metadata)
+	id := d.Get("integration_id").(string)
+	if id == "" {
+		// every instance has a single key management integration, bring your own key replaces it
+		id, err = enKeyManagementIntegrationID(context, enClient, instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
+	options := &en.ReplaceIntegrationOptions{}
+
+	options.SetInstanceID(instanceID)
+	options.SetID(id)
+	options.SetType(integrationType)
+
+	metadata := ReplaceIntegrationMapMetadata(metadataParams)
+	options.SetMetadata(&metadata)
+
 	_, response, err := enClient.ReplaceIntegrationWithContext(context, options)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("ReplaceIntegrationWithContext failed %s\n%s", err, response))
@@ -106,37 +178,46 @@ func resourceIBMEnIntegrationRead(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	options := &en.GetIntegrationOptions{}
-
 	parts, err := flex.SepIdParts(d.Id(), "/")
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	options.SetInstanceID(parts[0])
-	options.SetID(parts[1])
-
-	result, response, err := enClient.GetIntegrationWithContext(context, options)
+	result := &enRawIntegration{}
+	response, err := enRequest(context, enClient, core.GET, `/v1/instances/{instance_id}/integrations/{id}`,
+		map[string]string{"instance_id": parts[0], "id": parts[1]}, nil, nil, result)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
-			d.SetId(d.Get("integration_id").(string))
+			d.SetId("")
 			return nil
 		}
 		return diag.FromErr(fmt.Errorf("GetIntegrationWithContext failed %s\n%s", err, response))
 	}
 
-	if err = d.Set("instance_guid", options.InstanceID); err != nil {
+	if err = d.Set("instance_guid", parts[0]); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_guid: %s", err))
 	}
 
-	if err = d.Set("integration_id", options.ID); err != nil {
+	if err = d.Set("integration_id", parts[1]); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error integration_id: %s", err))
 	}
 	if err = d.Set("type", result.Type); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
 	}
 
-	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
+	if result.Metadata != nil {
+		metadata := map[string]interface{}{}
+		for _, k := range []string{"endpoint", "crn", "root_key_id", "bucket_name"} {
+			if v, ok := result.Metadata[k].(string); ok {
+				metadata[k] = v
+			}
+		}
+		if err = d.Set("metadata", []map[string]interface{}{metadata}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting metadata: %s", err))
+		}
+	}
+
+	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
 	}
 
@@ -160,13 +241,24 @@ func resourceIBMEnIntegrationUpdate(context context.Context, d *schema.ResourceD
 	options.SetID(parts[1])
 	options.SetType(d.Get("type").(string))
 
-	if ok := d.HasChanges("metadata"); ok {
+	if ok := d.HasChanges("type", "metadata"); ok {
+		integrationType := d.Get("type").(string)
+		metadataParams := d.Get("metadata.0").(map[string]interface{})
+		if err := enValidateIntegrationMetadata(integrationType, metadataParams); err != nil {
+			return diag.FromErr(err)
+		}
 
-		if _, ok := d.GetOk("metadata"); ok {
-			metadata := ReplaceIntegrationMapMetadata(d.Get("metadata.0").(map[string]interface{}))
-			options.SetMetadata(&metadata)
+		if integrationType == enIntegrationTypeCOS {
+			response, err := enReplaceRawIntegration(context, enClient, parts[0], parts[1], integrationType, metadataParams)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("ReplaceIntegrationWithContext failed %s\n%s", err, response))
+			}
+			return resourceIBMEnIntegrationRead(context, d, meta)
 		}
 
+		metadata := ReplaceIntegrationMapMetadata(metadataParams)
+		options.SetMetadata(&metadata)
+
 		_, response, err := enClient.ReplaceIntegrationWithContext(context, options)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("ReplaceIntegrationWithContext failed %s\n%s", err, response))
@@ -196,6 +288,98 @@ func ReplaceIntegrationMapMetadata(metadataParams map[string]interface{}) en.Int
 }
 
 func resourceIBMEnIntegrationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the key management integration can't be deleted, it is only removed from the state
+	if d.Get("type").(string) != enIntegrationTypeCOS {
+		d.SetId("")
+		return nil
+	}
+
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := enRequest(context, enClient, core.DELETE, `/v1/instances/{instance_id}/integrations/{id}`,
+		map[string]string{"instance_id": parts[0], "id": parts[1]}, nil, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("DeleteIntegrationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
 
 	return nil
 }
+
+func enValidateIntegrationMetadata(integrationType string, metadataParams map[string]interface{}) error {
+	if integrationType == enIntegrationTypeCOS {
+		if v, ok := metadataParams["bucket_name"].(string); !ok || v == "" {
+			return fmt.Errorf("[ERROR] metadata.0.bucket_name is required for integration type %s", integrationType)
+		}
+		return nil
+	}
+	if v, ok := metadataParams["root_key_id"].(string); !ok || v == "" {
+		return fmt.Errorf("[ERROR] metadata.0.root_key_id is required for integration type %s", integrationType)
+	}
+	return nil
+}
+
+func enIntegrationMapRawMetadata(integrationType string, metadataParams map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"endpoint": metadataParams["endpoint"],
+		"crn":      metadataParams["crn"],
+	}
+	if integrationType == enIntegrationTypeCOS {
+		metadata["bucket_name"] = metadataParams["bucket_name"]
+	} else {
+		metadata["root_key_id"] = metadataParams["root_key_id"]
+	}
+	return metadata
+}
+
+func enReplaceRawIntegration(context context.Context, enClient *en.EventNotificationsV1, instanceID, id, integrationType string, metadataParams map[string]interface{}) (*core.DetailedResponse, error) {
+	body := map[string]interface{}{
+		"type":     integrationType,
+		"metadata": enIntegrationMapRawMetadata(integrationType, metadataParams),
+	}
+	result := &enRawIntegration{}
+	return enRequest(context, enClient, core.PUT, `/v1/instances/{instance_id}/integrations/{id}`,
+		map[string]string{"instance_id": instanceID, "id": id}, nil, body, result)
+}
+
+// enKeyManagementIntegrationID returns the ID of the kms/hs-crypto integration of the instance.
+func enKeyManagementIntegrationID(context context.Context, enClient *en.EventNotificationsV1, instanceID string) (string, error) {
+	options := &en.ListIntegrationsOptions{}
+	options.SetInstanceID(instanceID)
+
+	var offset int64 = 0
+	var limit int64 = 100
+	options.SetLimit(limit)
+
+	for {
+		options.SetOffset(offset)
+		result, response, err := enClient.ListIntegrationsWithContext(context, options)
+		if err != nil {
+			return "", fmt.Errorf("ListIntegrationsWithContext failed %s\n%s", err, response)
+		}
+		for _, integration := range result.Integrations {
+			if integration.Type != nil && integration.ID != nil &&
+				(*integration.Type == enIntegrationTypeKMS || *integration.Type == enIntegrationTypeHPCS) {
+				return integration.ID.String(), nil
+			}
+		}
+		offset = offset + limit
+		if result.TotalCount == nil || offset >= *result.TotalCount {
+			break
+		}
+	}
+	return "", fmt.Errorf("[ERROR] No kms/hs-crypto integration found for instance %s", instanceID)
+}
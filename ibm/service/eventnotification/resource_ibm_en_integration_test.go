@@ -16,23 +16,23 @@ import (
 
 func TestAccIBMEnIntegrationAllArgs(t *testing.T) {
 	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
-	integrationid := fmt.Sprintf("tf_integrationid_%d", acctest.RandIntRange(10, 100))
-	newintegrationid := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acc.TestAccPreCheck(t) },
 		Providers:    acc.TestAccProviders,
-		CheckDestroy: testAccCheckIBMEnSourceDestroy,
+		CheckDestroy: testAccCheckIBMEnIntegrationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckIBMEnIntegrationConfig(instanceName, integrationid),
+				Config: testAccCheckIBMEnIntegrationConfig(instanceName, "gyyebvhy-34673783-nshuwubw"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_1", "integration_id", integrationid),
+					resource.TestCheckResourceAttrSet("ibm_en_integration.en_integration_resource_1", "integration_id"),
+					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_1", "type", "kms"),
+					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_1", "metadata.0.root_key_id", "gyyebvhy-34673783-nshuwubw"),
 				),
 			},
 			{
-				Config: testAccCheckIBMEnIntegrationConfig(instanceName, newintegrationid),
+				Config: testAccCheckIBMEnIntegrationConfig(instanceName, "hsjjsbvh-98763783-nshuwubw"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_1", "integration_id", integrationid),
+					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_1", "metadata.0.root_key_id", "hsjjsbvh-98763783-nshuwubw"),
 				),
 			},
 			{
@@ -44,7 +44,32 @@ func TestAccIBMEnIntegrationAllArgs(t *testing.T) {
 	})
 }
 
-func testAccCheckIBMEnIntegrationConfig(instanceName, integrationid string) string {
+func TestAccIBMEnIntegrationCOS(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-bucket-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnIntegrationCOSConfig(instanceName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_en_integration.en_integration_resource_cos", "integration_id"),
+					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_cos", "type", "collect_failed_events"),
+					resource.TestCheckResourceAttr("ibm_en_integration.en_integration_resource_cos", "metadata.0.bucket_name", bucketName),
+				),
+			},
+			{
+				ResourceName:      "ibm_en_integration.en_integration_resource_cos",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnIntegrationConfig(instanceName, rootKeyID string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_integration_resource" {
 		name     = "%s"
@@ -52,19 +77,38 @@ func testAccCheckIBMEnIntegrationConfig(instanceName, integrationid string) stri
 		plan     = "standard"
 		service  = "event-notifications"
 	}
-	
+
 	resource "ibm_en_integration" "en_integration_resource_1" {
-		instance_guid = ibm_resource_instance.en_destination_resource.guid
-		integration_id       = "%s"
-		type = "kms"
+		instance_guid = ibm_resource_instance.en_integration_resource.guid
+		type          = "kms"
 		metadata {
-			endpoint = "https://us-south.kms.cloud.ibm.com"
-			crn = "crn:v1:bluemix:public:kms:us-south:a/tyyeeuuii2637390003hehhhhi:fgsyysbnjiios::"
-			root_key_id = "gyyebvhy-34673783-nshuwubw"
+			endpoint    = "https://us-south.kms.cloud.ibm.com"
+			crn         = "crn:v1:bluemix:public:kms:us-south:a/tyyeeuuii2637390003hehhhhi:fgsyysbnjiios::"
+			root_key_id = "%s"
 		}
+	}
+	`, instanceName, rootKeyID)
+}
 
+func testAccCheckIBMEnIntegrationCOSConfig(instanceName, bucketName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_integration_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_integration" "en_integration_resource_cos" {
+		instance_guid = ibm_resource_instance.en_integration_resource.guid
+		type          = "collect_failed_events"
+		metadata {
+			endpoint    = "https://s3.us-west.cloud-object-storage.test.appdomain.cloud"
+			crn         = "crn:v1:bluemix:public:cloud-object-storage:global:a/tyyeeuuii2637390003hehhhhi:fgsyysbnjiios::"
+			bucket_name = "%s"
+		}
 	}
-	`, instanceName, integrationid)
+	`, instanceName, bucketName)
 }
 
 func testAccCheckIBMEnIntegrationDestroy(s *terraform.State) error {
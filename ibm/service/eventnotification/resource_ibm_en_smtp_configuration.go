@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+// The SMTP relay API is not modelled by the SDK yet, the requests are sent with enRequest.
+
+type enSMTPConfiguration struct {
+	ID          *string                    `json:"id,omitempty"`
+	Name        *string                    `json:"name,omitempty"`
+	Description *string                    `json:"description,omitempty"`
+	Domain      *string                    `json:"domain,omitempty"`
+	Config      *enSMTPConfigurationConfig `json:"config,omitempty"`
+	UpdatedAt   *string                    `json:"updated_at,omitempty"`
+}
+
+type enSMTPConfigurationConfig struct {
+	Dkim            *enSMTPDNSRecord `json:"dkim,omitempty"`
+	EnAuthorization *enSMTPDNSRecord `json:"en_authorization,omitempty"`
+	Spf             *enSMTPDNSRecord `json:"spf,omitempty"`
+}
+
+type enSMTPDNSRecord struct {
+	TxtName      *string `json:"txt_name,omitempty"`
+	TxtValue     *string `json:"txt_value,omitempty"`
+	Verification *string `json:"verification,omitempty"`
+}
+
+type enSMTPAllowedIPs struct {
+	Subnets   []string `json:"subnets"`
+	UpdatedAt *string  `json:"updated_at,omitempty"`
+}
+
+func ResourceIBMEnSMTPConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnSMTPConfigurationCreate,
+		ReadContext:   resourceIBMEnSMTPConfigurationRead,
+		UpdateContext: resourceIBMEnSMTPConfigurationUpdate,
+		DeleteContext: resourceIBMEnSMTPConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SMTP configuration name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The SMTP configuration description.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain the emails are sent from.",
+			},
+			"allowed_ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "The IP addresses or CIDR blocks allowed to connect to the SMTP relay.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNS records to create to verify the domain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dkim": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The DKIM TXT record.",
+							Elem:        enSMTPDNSRecordSchema(),
+						},
+						"en_authorization": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The Event Notifications authorization TXT record.",
+							Elem:        enSMTPDNSRecordSchema(),
+						},
+						"spf": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The SPF TXT record.",
+							Elem:        enSMTPDNSRecordSchema(),
+						},
+					},
+				},
+			},
+			"smtp_config_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SMTP configuration ID.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+		},
+	}
+}
+
+func enSMTPDNSRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"txt_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the TXT record.",
+			},
+			"txt_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value of the TXT record.",
+			},
+			"verification": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The verification status of the record.",
+			},
+		},
+	}
+}
+
+func resourceIBMEnSMTPConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_guid").(string)
+	body := map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"domain": d.Get("domain").(string),
+	}
+	if _, ok := d.GetOk("description"); ok {
+		body["description"] = d.Get("description").(string)
+	}
+
+	result := &enSMTPConfiguration{}
+	response, err := enRequest(context, enClient, core.POST, `/v1/instances/{instance_id}/smtp/config`,
+		map[string]string{"instance_id": instanceID}, nil, body, result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("CreateSMTPConfigurationWithContext failed %s\n%s", err, response))
+	}
+	if result.ID == nil {
+		return diag.FromErr(fmt.Errorf("CreateSMTPConfigurationWithContext failed, SMTP configuration ID missing from response\n%s", response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *result.ID))
+
+	if v, ok := d.GetOk("allowed_ips"); ok {
+		response, err := enUpdateSMTPAllowedIPs(context, enClient, instanceID, *result.ID, flex.ExpandStringList(v.(*schema.Set).List()))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateSMTPAllowedIPsWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMEnSMTPConfigurationRead(context, d, meta)
+}
+
+func resourceIBMEnSMTPConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := &enSMTPConfiguration{}
+	response, err := enRequest(context, enClient, core.GET, `/v1/instances/{instance_id}/smtp/config/{id}`,
+		map[string]string{"instance_id": parts[0], "id": parts[1]}, nil, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetSMTPConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_guid", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_guid: %s", err))
+	}
+
+	if err = d.Set("smtp_config_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting smtp_config_id: %s", err))
+	}
+
+	if err = d.Set("name", result.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+
+	if err = d.Set("description", result.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+
+	if err = d.Set("domain", result.Domain); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting domain: %s", err))
+	}
+
+	if result.Config != nil {
+		if err = d.Set("config", enSMTPConfigurationFlattenConfig(*result.Config)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting config: %s", err))
+		}
+	}
+
+	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	allowedIPs := &enSMTPAllowedIPs{}
+	response, err = enRequest(context, enClient, core.GET, `/v1/instances/{instance_id}/smtp/config/{id}/allowed_ips`,
+		map[string]string{"instance_id": parts[0], "id": parts[1]}, nil, nil, allowedIPs)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("GetSMTPAllowedIPsWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("allowed_ips", allowedIPs.Subnets); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allowed_ips: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMEnSMTPConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		body := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		}
+		result := &enSMTPConfiguration{}
+		response, err := enRequest(context, enClient, core.PATCH, `/v1/instances/{instance_id}/smtp/config/{id}`,
+			map[string]string{"instance_id": parts[0], "id": parts[1]}, nil, body, result)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateSMTPConfigurationWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	if d.HasChange("allowed_ips") {
+		subnets := flex.ExpandStringList(d.Get("allowed_ips").(*schema.Set).List())
+		response, err := enUpdateSMTPAllowedIPs(context, enClient, parts[0], parts[1], subnets)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateSMTPAllowedIPsWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMEnSMTPConfigurationRead(context, d, meta)
+}
+
+func resourceIBMEnSMTPConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := enRequest(context, enClient, core.DELETE, `/v1/instances/{instance_id}/smtp/config/{id}`,
+		map[string]string{"instance_id": parts[0], "id": parts[1]}, nil, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("DeleteSMTPConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func enUpdateSMTPAllowedIPs(context context.Context, enClient *en.EventNotificationsV1, instanceID, id string, subnets []string) (*core.DetailedResponse, error) {
+	result := &enSMTPAllowedIPs{}
+	return enRequest(context, enClient, core.PATCH, `/v1/instances/{instance_id}/smtp/config/{id}/allowed_ips`,
+		map[string]string{"instance_id": instanceID, "id": id}, nil, &enSMTPAllowedIPs{Subnets: subnets}, result)
+}
+
+func enSMTPConfigurationFlattenConfig(config enSMTPConfigurationConfig) []map[string]interface{} {
+	configMap := map[string]interface{}{}
+	if config.Dkim != nil {
+		configMap["dkim"] = enSMTPFlattenDNSRecord(*config.Dkim)
+	}
+	if config.EnAuthorization != nil {
+		configMap["en_authorization"] = enSMTPFlattenDNSRecord(*config.EnAuthorization)
+	}
+	if config.Spf != nil {
+		configMap["spf"] = enSMTPFlattenDNSRecord(*config.Spf)
+	}
+	return []map[string]interface{}{configMap}
+}
+
+func enSMTPFlattenDNSRecord(record enSMTPDNSRecord) []map[string]interface{} {
+	recordMap := map[string]interface{}{}
+	if record.TxtName != nil {
+		recordMap["txt_name"] = *record.TxtName
+	}
+	if record.TxtValue != nil {
+		recordMap["txt_value"] = *record.TxtValue
+	}
+	if record.Verification != nil {
+		recordMap["verification"] = *record.Verification
+	}
+	return []map[string]interface{}{recordMap}
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnSMTPConfigurationAllArgs(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	newName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	newDescription := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnSMTPConfigurationConfig(instanceName, name, description, "10.0.0.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "name", name),
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "description", description),
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "domain", "mailx.com"),
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "allowed_ips.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_en_smtp_configuration.en_smtp_config_resource_1", "smtp_config_id"),
+					resource.TestCheckResourceAttrSet("ibm_en_smtp_configuration.en_smtp_config_resource_1", "config.0.dkim.0.txt_name"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnSMTPConfigurationConfig(instanceName, newName, newDescription, "10.0.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "name", newName),
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "description", newDescription),
+					resource.TestCheckResourceAttr("ibm_en_smtp_configuration.en_smtp_config_resource_1", "allowed_ips.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_en_smtp_configuration.en_smtp_config_resource_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnSMTPConfigurationConfig(instanceName, name, description, allowedIP string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_smtp_config_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_smtp_configuration" "en_smtp_config_resource_1" {
+		instance_guid = ibm_resource_instance.en_smtp_config_resource.guid
+		name          = "%s"
+		description   = "%s"
+		domain        = "mailx.com"
+		allowed_ips   = ["%s"]
+	}
+	`, instanceName, name, description, allowedIP)
+}
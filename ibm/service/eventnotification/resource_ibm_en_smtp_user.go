@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type enSMTPUser struct {
+	ID           *string `json:"id,omitempty"`
+	SMTPConfigID *string `json:"smtp_config_id,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Domain       *string `json:"domain,omitempty"`
+	Username     *string `json:"username,omitempty"`
+	Password     *string `json:"password,omitempty"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+	UpdatedAt    *string `json:"updated_at,omitempty"`
+}
+
+func ResourceIBMEnSMTPUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnSMTPUserCreate,
+		ReadContext:   resourceIBMEnSMTPUserRead,
+		UpdateContext: resourceIBMEnSMTPUserUpdate,
+		DeleteContext: resourceIBMEnSMTPUserDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"smtp_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for the SMTP configuration.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The SMTP user description.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value, changing it replaces the user to generate new credentials.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SMTP user ID.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The domain of the SMTP configuration.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SMTP user name.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The SMTP user password, only returned when the user is created.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Created time.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+		},
+	}
+}
+
+func resourceIBMEnSMTPUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_guid").(string)
+	smtpConfigID := d.Get("smtp_config_id").(string)
+	body := map[string]interface{}{}
+	if _, ok := d.GetOk("description"); ok {
+		body["description"] = d.Get("description").(string)
+	}
+
+	result := &enSMTPUser{}
+	response, err := enRequest(context, enClient, core.POST, `/v1/instances/{instance_id}/smtp/config/{id}/users`,
+		map[string]string{"instance_id": instanceID, "id": smtpConfigID}, nil, body, result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("CreateSMTPUserWithContext failed %s\n%s", err, response))
+	}
+	if result.ID == nil {
+		return diag.FromErr(fmt.Errorf("CreateSMTPUserWithContext failed, SMTP user ID missing from response\n%s", response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, smtpConfigID, *result.ID))
+
+	// the password can't be retrieved afterwards
+	if err = d.Set("password", result.Password); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting password: %s", err))
+	}
+
+	return resourceIBMEnSMTPUserRead(context, d, meta)
+}
+
+func resourceIBMEnSMTPUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instance_guid/smtp_config_id/user_id", d.Id()))
+	}
+
+	result := &enSMTPUser{}
+	response, err := enRequest(context, enClient, core.GET, `/v1/instances/{instance_id}/smtp/config/{id}/users/{user_id}`,
+		map[string]string{"instance_id": parts[0], "id": parts[1], "user_id": parts[2]}, nil, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetSMTPUserWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_guid", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_guid: %s", err))
+	}
+
+	if err = d.Set("smtp_config_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting smtp_config_id: %s", err))
+	}
+
+	if err = d.Set("user_id", parts[2]); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting user_id: %s", err))
+	}
+
+	if err = d.Set("description", result.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+
+	if err = d.Set("domain", result.Domain); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting domain: %s", err))
+	}
+
+	if err = d.Set("username", result.Username); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting username: %s", err))
+	}
+
+	if err = d.Set("created_at", result.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+
+	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMEnSMTPUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("description") {
+		body := map[string]interface{}{
+			"description": d.Get("description").(string),
+		}
+		result := &enSMTPUser{}
+		response, err := enRequest(context, enClient, core.PATCH, `/v1/instances/{instance_id}/smtp/config/{id}/users/{user_id}`,
+			map[string]string{"instance_id": parts[0], "id": parts[1], "user_id": parts[2]}, nil, body, result)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("UpdateSMTPUserWithContext failed %s\n%s", err, response))
+		}
+
+		return resourceIBMEnSMTPUserRead(context, d, meta)
+	}
+
+	return nil
+}
+
+func resourceIBMEnSMTPUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := enRequest(context, enClient, core.DELETE, `/v1/instances/{instance_id}/smtp/config/{id}/users/{user_id}`,
+		map[string]string{"instance_id": parts[0], "id": parts[1], "user_id": parts[2]}, nil, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("DeleteSMTPUserWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
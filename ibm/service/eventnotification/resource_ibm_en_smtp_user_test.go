@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEnSMTPUserAllArgs(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	newDescription := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnSMTPUserConfig(instanceName, name, description, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_smtp_user.en_smtp_user_resource_1", "description", description),
+					resource.TestCheckResourceAttrSet("ibm_en_smtp_user.en_smtp_user_resource_1", "user_id"),
+					resource.TestCheckResourceAttrSet("ibm_en_smtp_user.en_smtp_user_resource_1", "username"),
+					resource.TestCheckResourceAttrSet("ibm_en_smtp_user.en_smtp_user_resource_1", "password"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnSMTPUserConfig(instanceName, name, newDescription, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_smtp_user.en_smtp_user_resource_1", "description", newDescription),
+				),
+			},
+			{
+				// credential rotation
+				Config: testAccCheckIBMEnSMTPUserConfig(instanceName, name, newDescription, "rotated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_smtp_user.en_smtp_user_resource_1", "rotation_trigger", "rotated"),
+					resource.TestCheckResourceAttrSet("ibm_en_smtp_user.en_smtp_user_resource_1", "password"),
+				),
+			},
+			{
+				ResourceName:            "ibm_en_smtp_user.en_smtp_user_resource_1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "rotation_trigger"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnSMTPUserConfig(instanceName, name, description, rotationTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_smtp_user_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_smtp_configuration" "en_smtp_config_resource_1" {
+		instance_guid = ibm_resource_instance.en_smtp_user_resource.guid
+		name          = "%s"
+		domain        = "mailx.com"
+	}
+
+	resource "ibm_en_smtp_user" "en_smtp_user_resource_1" {
+		instance_guid    = ibm_resource_instance.en_smtp_user_resource.guid
+		smtp_config_id   = ibm_en_smtp_configuration.en_smtp_config_resource_1.smtp_config_id
+		description      = "%s"
+		rotation_trigger = "%s"
+
+		lifecycle {
+			create_before_destroy = true
+		}
+	}
+	`, instanceName, name, description, rotationTrigger)
+}
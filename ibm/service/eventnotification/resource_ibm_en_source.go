@@ -42,13 +42,30 @@ func ResourceIBMEnSource() *schema.Resource {
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
-				Required:    true,
+				Optional:    true,
+				Default:     true,
 				Description: "The enabled flag for source",
 			},
 			"source_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Destination ID",
+				Description: "Source ID",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Source type.",
+			},
+			"topic_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of topics the source is connected to.",
+			},
+			"topic_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the topics the source is connected to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"updated_at": {
 				Type:        schema.TypeString,
@@ -131,6 +148,18 @@ func resourceIBMEnSourceRead(context context.Context, d *schema.ResourceData, me
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enabled: %s", err))
 	}
 
+	if err = d.Set("type", result.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting type: %s", err))
+	}
+
+	if err = d.Set("topic_count", flex.IntValue(result.TopicCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting topic_count: %s", err))
+	}
+
+	if err = d.Set("topic_names", result.TopicNames); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting topic_names: %s", err))
+	}
+
 	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
 	}
@@ -156,11 +185,7 @@ func resourceIBMEnSourceUpdate(context context.Context, d *schema.ResourceData,
 
 	if ok := d.HasChanges("name", "description", "enabled"); ok {
 		options.SetName(d.Get("name").(string))
-
-		if _, ok := d.GetOk("description"); ok {
-			options.SetDescription(d.Get("description").(string))
-		}
-
+		options.SetDescription(d.Get("description").(string))
 		options.SetEnabled(d.Get("enabled").(bool))
 
 		_, response, err := enClient.UpdateSourceWithContext(context, options)
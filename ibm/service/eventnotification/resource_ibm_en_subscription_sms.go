@@ -76,6 +76,18 @@ func ResourceIBMEnSMSSubscription() *schema.Resource {
 							Description: "The phone number to remove in case of update to send the SMS to in case of sms_ibm. The email id in case of smtp_ibm destination type.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"remove_subscribed": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The verified phone numbers to unsubscribe in case of update.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"remove_unsubscribed": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The phone numbers to remove from the unsubscribed list in case of update, so they can be invited again.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -99,6 +111,24 @@ func ResourceIBMEnSMSSubscription() *schema.Resource {
 				Computed:    true,
 				Description: "Name of the topic.",
 			},
+			"invited_numbers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The phone numbers invited that did not verify the subscription yet.",
+				Elem:        enSMSNumberSchema(true),
+			},
+			"subscribed_numbers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The phone numbers that verified the subscription.",
+				Elem:        enSMSNumberSchema(false),
+			},
+			"unsubscribed_numbers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The phone numbers that unsubscribed.",
+				Elem:        enSMSNumberSchema(false),
+			},
 			"updated_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -108,6 +138,29 @@ func ResourceIBMEnSMSSubscription() *schema.Resource {
 	}
 }
 
+func enSMSNumberSchema(invited bool) *schema.Resource {
+	numberSchema := map[string]*schema.Schema{
+		"phone_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Phone number.",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Last updated time.",
+		},
+	}
+	if invited {
+		numberSchema["expires_at"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Expiration time of the invitation, the number has to be invited again once expired.",
+		}
+	}
+	return &schema.Resource{Schema: numberSchema}
+}
+
 func resourceIBMEnSMSSubscriptionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
 	if err != nil {
@@ -212,6 +265,18 @@ func resourceIBMEnSMSSubscriptionRead(context context.Context, d *schema.Resourc
 		}
 	}
 
+	if attributes, ok := result.Attributes.(*en.SubscriptionAttributes); ok {
+		if err = d.Set("invited_numbers", enSMSInvitedNumbersToList(attributes.Invited)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting invited_numbers: %s", err))
+		}
+		if err = d.Set("subscribed_numbers", enSMSNumbersToList(attributes.Subscribed)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscribed_numbers: %s", err))
+		}
+		if err = d.Set("unsubscribed_numbers", enSMSNumbersToList(attributes.Unsubscribed)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting unsubscribed_numbers: %s", err))
+		}
+	}
+
 	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
 	}
@@ -317,5 +382,19 @@ func SMSattributesMapToAttributes(attributeMap map[string]interface{}) (en.Subsc
 		attributesUpdate.Invited = updateTo
 	}
 
+	if attributeMap["remove_subscribed"] != nil {
+		remove := flex.ExpandStringList(attributeMap["remove_subscribed"].([]interface{}))
+		if len(remove) > 0 {
+			attributesUpdate.Subscribed = &en.UpdateAttributesSubscribed{Remove: remove}
+		}
+	}
+
+	if attributeMap["remove_unsubscribed"] != nil {
+		remove := flex.ExpandStringList(attributeMap["remove_unsubscribed"].([]interface{}))
+		if len(remove) > 0 {
+			attributesUpdate.Unsubscribed = &en.UpdateAttributesUnsubscribed{Remove: remove}
+		}
+	}
+
 	return attributesCreate, attributesUpdate
 }
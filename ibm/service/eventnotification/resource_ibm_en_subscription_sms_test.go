@@ -44,7 +44,11 @@ func TestAccIBMEnSMSSubscriptionAllArgs(t *testing.T) {
 					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "destination_type"),
 					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "subscription_id"),
 					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "attributes.#"),
-					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "attributes.0.to"),
+					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "attributes.0.invited.#"),
+					resource.TestCheckResourceAttr("ibm_en_subscription_sms.en_subscription_resource_1", "invited_numbers.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "invited_numbers.0.expires_at"),
+					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "subscribed_numbers.#"),
+					resource.TestCheckResourceAttrSet("ibm_en_subscription_sms.en_subscription_resource_1", "unsubscribed_numbers.#"),
 				),
 			},
 			{
@@ -54,6 +58,13 @@ func TestAccIBMEnSMSSubscriptionAllArgs(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_en_subscription_sms.en_subscription_resource_1", "description", newDescription),
 				),
 			},
+			{
+				Config: testAccCheckIBMEnSMSSubscriptionUpdateConfig(instanceName, newName, newDescription),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_subscription_sms.en_subscription_resource_1", "invited_numbers.#", "1"),
+					resource.TestCheckResourceAttr("ibm_en_subscription_sms.en_subscription_resource_1", "invited_numbers.0.phone_number", "+16382922821"),
+				),
+			},
 			{
 				ResourceName:      "ibm_en_subscription_sms.en_subscription_resource_1",
 				ImportState:       true,
@@ -86,7 +97,36 @@ func testAccCheckIBMEnSMSSubscriptionConfig(instanceName, name, description stri
 		topic_id       = ibm_en_topic.en_topic_resource_2.topic_id
 		destination_id = "set sms destination id"
 		attributes {
-			to = ["+16382922821", "+18976569023"]
+			invited = ["+16382922821", "+18976569023"]
+		}
+	}
+	`, instanceName, name, description)
+}
+
+func testAccCheckIBMEnSMSSubscriptionUpdateConfig(instanceName, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_subscription_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_topic" "en_topic_resource_2" {
+		instance_guid = ibm_resource_instance.en_subscription_resource.guid
+		name        = "tf_topic_name_0234"
+		description = "tf_topic_description_0235"
+	}
+
+	resource "ibm_en_subscription_sms" "en_subscription_resource_1" {
+		name           = "%s"
+		description    = "%s"
+		instance_guid  = ibm_resource_instance.en_subscription_resource.guid
+		topic_id       = ibm_en_topic.en_topic_resource_2.topic_id
+		destination_id = "set sms destination id"
+		attributes {
+			remove              = ["+18976569023"]
+			remove_unsubscribed = ["+18976569023"]
 		}
 	}
 	`, instanceName, name, description)
@@ -52,6 +52,11 @@ func ResourceIBMEnTopic() *schema.Resource {
 							Required:    true,
 							Description: "ID of the source.",
 						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the source.",
+						},
 						"rules": {
 							Type:        schema.TypeList,
 							Optional:    true,
@@ -75,6 +80,16 @@ func ResourceIBMEnTopic() *schema.Resource {
 										Default:     "",
 										Description: "Notification filter.",
 									},
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Autogenerated rule ID.",
+									},
+									"updated_at": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Last time the rule was updated.",
+									},
 								},
 							},
 						},
@@ -223,15 +238,13 @@ func resourceIBMEnTopicRead(context context.Context, d *schema.ResourceData, met
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
 	}
 
-	if result.Sources != nil {
-		sources := []map[string]interface{}{}
-		for _, sourcesItem := range result.Sources {
-			sourcesItemMap := enTopicUpdateSourcesItemToMap(sourcesItem)
-			sources = append(sources, sourcesItemMap)
-		}
-		if err = d.Set("sources", sources); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error setting sources: %s", err))
-		}
+	sources := []map[string]interface{}{}
+	for _, sourcesItem := range result.Sources {
+		sourcesItemMap := enTopicUpdateSourcesItemToMap(sourcesItem)
+		sources = append(sources, sourcesItemMap)
+	}
+	if err = d.Set("sources", sources); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting sources: %s", err))
 	}
 
 	if err = d.Set("updated_at", result.UpdatedAt); err != nil {
@@ -260,6 +273,10 @@ func resourceIBMEnTopicRead(context context.Context, d *schema.ResourceData, met
 }
 
 func resourceIBMEnTopicUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges("name", "description", "sources") {
+		return nil
+	}
+
 	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
 	if err != nil {
 		return diag.FromErr(err)
@@ -274,23 +291,20 @@ func resourceIBMEnTopicUpdate(context context.Context, d *schema.ResourceData, m
 
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
-	options.SetInstanceID(d.Get("instance_guid").(string))
 
 	options.SetName(d.Get("name").(string))
 
-	if _, ok := d.GetOk("description"); ok {
-		options.SetDescription(d.Get("description").(string))
-	}
+	// ReplaceTopic replaces the whole topic, so the description and the complete list of
+	// sources are always sent; an empty list detaches all sources from the topic.
+	options.SetDescription(d.Get("description").(string))
 
-	if _, ok := d.GetOk("sources"); ok {
-		var sources []en.SourcesItems
-		for _, e := range d.Get("sources").([]interface{}) {
-			value := e.(map[string]interface{})
-			sourcesItem := enTopicUpdateSourcesItem(value)
-			sources = append(sources, sourcesItem)
-		}
-		options.SetSources(sources)
+	sources := []en.SourcesItems{}
+	for _, e := range d.Get("sources").([]interface{}) {
+		value := e.(map[string]interface{})
+		sourcesItem := enTopicUpdateSourcesItem(value)
+		sources = append(sources, sourcesItem)
 	}
+	options.SetSources(sources)
 
 	_, response, err := enClient.ReplaceTopicWithContext(context, options)
 	if err != nil {
@@ -371,6 +385,10 @@ func enTopicUpdateSourcesItemToMap(source en.SourcesListItems) map[string]interf
 		sourceMap["id"] = source.ID
 	}
 
+	if source.Name != nil {
+		sourceMap["name"] = source.Name
+	}
+
 	if source.Rules != nil {
 		rules := []map[string]interface{}{}
 		for _, rulesItem := range source.Rules {
@@ -396,6 +414,14 @@ func resourceIBMEnTopicRulesToMap(rules en.RulesGet) map[string]interface{} {
 		rulesMap["notification_filter"] = rules.NotificationFilter
 	}
 
+	if rules.ID != nil {
+		rulesMap["id"] = rules.ID
+	}
+
+	if rules.UpdatedAt != nil {
+		rulesMap["updated_at"] = rules.UpdatedAt
+	}
+
 	return rulesMap
 }
 
@@ -55,6 +55,79 @@ func TestAccIBMEnTopicAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMEnTopicSources(t *testing.T) {
+	var conf en.Topic
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	eventTypeFilter := "$.notification_event_info.event_type == 'cert_manager'"
+	eventTypeFilterUpdate := "$.notification_event_info.event_type == 'cert_renewed'"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnTopicSourcesConfig(instanceName, name, eventTypeFilter, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEnTopicExists("ibm_en_topic.en_topic_resource_1", conf),
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.#", "1"),
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.0.rules.0.event_type_filter", eventTypeFilter),
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.0.rules.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet("ibm_en_topic.en_topic_resource_1", "sources.0.rules.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnTopicSourcesConfig(instanceName, name, eventTypeFilterUpdate, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.0.rules.0.event_type_filter", eventTypeFilterUpdate),
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.0.rules.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEnTopicConfig(instanceName, name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "sources.#", "0"),
+					resource.TestCheckResourceAttr("ibm_en_topic.en_topic_resource_1", "source_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnTopicSourcesConfig(instanceName, name, eventTypeFilter string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_topic_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_source" "en_source_resource_1" {
+		instance_guid = ibm_resource_instance.en_topic_resource.guid
+		name          = "tf_topic_source"
+		description   = "Source for topic rules"
+		enabled       = true
+	}
+
+	resource "ibm_en_topic" "en_topic_resource_1" {
+		instance_guid = ibm_resource_instance.en_topic_resource.guid
+		name          = "%s"
+		description   = "Topic with event filters"
+		sources {
+			id = ibm_en_source.en_source_resource_1.source_id
+			rules {
+				enabled             = %t
+				event_type_filter   = "%s"
+				notification_filter = "$.notification.severity == 'HIGH'"
+			}
+		}
+	}
+	`, instanceName, name, enabled, eventTypeFilter)
+}
+
 func testAccCheckIBMEnTopicConfig(instanceName, name, description string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_topic_resource" {
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+)
+
+// enRawDestination is the destination payload returned by the Event Notifications API
+// for destination types that are not yet modelled by the SDK.
+type enRawDestination struct {
+	ID                *string                 `json:"id,omitempty"`
+	Name              *string                 `json:"name,omitempty"`
+	Description       *string                 `json:"description,omitempty"`
+	Type              *string                 `json:"type,omitempty"`
+	Config            *enRawDestinationConfig `json:"config,omitempty"`
+	UpdatedAt         *string                 `json:"updated_at,omitempty"`
+	SubscriptionCount *int64                  `json:"subscription_count,omitempty"`
+	SubscriptionNames []string                `json:"subscription_names,omitempty"`
+}
+
+type enRawDestinationConfig struct {
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// enRequest sends a request to the Event Notifications API using the service URL and
+// authenticator of the SDK client. Form values of type string are sent as plain form
+// fields, any other value is sent as a JSON form part. The JSON response is decoded into result.
+func enRequest(context context.Context, enClient *en.EventNotificationsV1, method, path string, pathParams map[string]string, form map[string]interface{}, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	return enRequestWithQuery(context, enClient, method, path, pathParams, nil, form, body, result)
+}
+
+// enRequestWithQuery is enRequest with additional query parameters.
+func enRequestWithQuery(context context.Context, enClient *en.EventNotificationsV1, method, path string, pathParams map[string]string, query map[string]string, form map[string]interface{}, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = enClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(enClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+
+	for name, value := range form {
+		if s, ok := value.(string); ok {
+			builder.AddFormData(name, "", "", s)
+		} else {
+			builder.AddFormData(name, "", "application/json", value)
+		}
+	}
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return enClient.Service.Request(request, result)
+}
+
+func enCreateRawDestination(context context.Context, enClient *en.EventNotificationsV1, instanceID string, form map[string]interface{}) (*enRawDestination, *core.DetailedResponse, error) {
+	result := &enRawDestination{}
+	response, err := enRequest(context, enClient, core.POST, `/v1/instances/{instance_id}/destinations`,
+		map[string]string{"instance_id": instanceID}, form, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	if result.ID == nil {
+		return nil, response, fmt.Errorf("destination ID missing from create response")
+	}
+	return result, response, nil
+}
+
+func enGetRawDestination(context context.Context, enClient *en.EventNotificationsV1, instanceID, id string) (*enRawDestination, *core.DetailedResponse, error) {
+	result := &enRawDestination{}
+	response, err := enRequest(context, enClient, core.GET, `/v1/instances/{instance_id}/destinations/{id}`,
+		map[string]string{"instance_id": instanceID, "id": id}, nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func enUpdateRawDestination(context context.Context, enClient *en.EventNotificationsV1, instanceID, id string, form map[string]interface{}) (*core.DetailedResponse, error) {
+	result := &enRawDestination{}
+	return enRequest(context, enClient, core.PATCH, `/v1/instances/{instance_id}/destinations/{id}`,
+		map[string]string{"instance_id": instanceID, "id": id}, form, nil, result)
+}
+
+// enListItemMatches reports whether value equals the optional filter argument
+// key of a list data source, an unset filter matches every item.
+func enListItemMatches(d *schema.ResourceData, key string, value *string) bool {
+	filter, ok := d.GetOk(key)
+	if !ok {
+		return true
+	}
+	return value != nil && *value == filter.(string)
+}
+
+func enListFiltered(d *schema.ResourceData, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEventStreamsConsumerGroupRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the consumer group",
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the topic consumed by the consumer group",
+			},
+			"total_lag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The sum of the lag of the consumer group across all partitions of the topic",
+			},
+			"partitions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The offsets and lag of the consumer group for each partition of the topic",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The partition ID",
+						},
+						"current_offset": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The offset committed by the consumer group, -1 if the group has not committed an offset",
+						},
+						"log_end_offset": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The offset of the next message that will be produced to the partition",
+						},
+						"lag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of messages the consumer group has not consumed yet",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, brokerAddress, instanceCRN, err := createSaramaConfig(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead createSaramaConfig err %s", err)
+		return diag.FromErr(err)
+	}
+	client, err := sarama.NewClient(brokerAddress, config)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead NewClient err %s", err)
+		return diag.FromErr(err)
+	}
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead NewClusterAdminFromClient err %s", err)
+		return diag.FromErr(err)
+	}
+	// closing the admin client also closes the underlying client
+	defer adminClient.Close()
+
+	groupName := d.Get("name").(string)
+	topicName := d.Get("topic").(string)
+	partitionIDs, err := client.Partitions(topicName)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead Partitions err %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting partitions of topic %s: %s", topicName, err))
+	}
+	sort.Slice(partitionIDs, func(i, j int) bool { return partitionIDs[i] < partitionIDs[j] })
+
+	offsets, err := adminClient.ListConsumerGroupOffsets(groupName, map[string][]int32{topicName: partitionIDs})
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead ListConsumerGroupOffsets err %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing offsets of consumer group %s: %s", groupName, err))
+	}
+	if offsets.Err != sarama.ErrNoError {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing offsets of consumer group %s: %s", groupName, offsets.Err))
+	}
+
+	var totalLag int64
+	partitions := make([]map[string]interface{}, 0, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		logEndOffset, err := client.GetOffset(topicName, partitionID, sarama.OffsetNewest)
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead GetOffset err %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting log end offset of topic %s partition %d: %s", topicName, partitionID, err))
+		}
+		currentOffset := int64(-1)
+		if block := offsets.GetBlock(topicName, partitionID); block != nil {
+			if block.Err != sarama.ErrNoError {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error getting offset of consumer group %s for partition %d: %s", groupName, partitionID, block.Err))
+			}
+			currentOffset = block.Offset
+		}
+		// a group without a committed offset has not consumed anything yet,
+		// so everything still retained in the partition counts as lag
+		consumedOffset := currentOffset
+		if consumedOffset < 0 {
+			consumedOffset, err = client.GetOffset(topicName, partitionID, sarama.OffsetOldest)
+			if err != nil {
+				log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupRead GetOffset err %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error getting log start offset of topic %s partition %d: %s", topicName, partitionID, err))
+			}
+		}
+		lag := logEndOffset - consumedOffset
+		if lag < 0 {
+			lag = 0
+		}
+		totalLag += lag
+		partitions = append(partitions, map[string]interface{}{
+			"partition":      int(partitionID),
+			"current_offset": int(currentOffset),
+			"log_end_offset": int(logEndOffset),
+			"lag":            int(lag),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceCRN, groupName, topicName))
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("partitions", partitions)
+	d.Set("total_lag", int(totalLag))
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testConsumerGroupName = "kafka-java-console-sample-group"
+
+func getTestConsumerGroupName() string {
+	group := os.Getenv("TEST_CONSUMER_GROUP")
+	if len(group) == 0 {
+		group = testConsumerGroupName
+	}
+	return group
+}
+
+func TestAccIBMEventStreamsConsumerGroupDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupDataSourceConfigBasic(getTestInstanceName(mzrKey), getTestConsumerGroupName(), getTestTopicName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_group.es_group", "id"),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_group.es_group", "name", getTestConsumerGroupName()),
+					resource.TestCheckResourceAttr("data.ibm_event_streams_consumer_group.es_group", "topic", getTestTopicName()),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_group.es_group", "total_lag"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_group.es_group", "partitions.0.lag"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_group.es_group", "partitions.0.log_end_offset"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConsumerGroupDataSourceConfigBasic(instanceName, groupName, topicName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "my_group" {
+		is_default=true
+	  }
+	data "ibm_resource_instance" "es_instance" {
+		resource_group_id = data.ibm_resource_group.my_group.id
+		name              = "%s"
+	}
+	data "ibm_event_streams_consumer_group" "es_group" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		name                 = "%s"
+		topic                = "%s"
+	}`, instanceName, groupName, topicName)
+}
@@ -257,39 +257,52 @@ func resourceIBMEventStreamsTopicDelete(context context.Context, d *schema.Resou
 }
 
 func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
-	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	config, brokerAddress, instanceCRN, err := createSaramaConfig(d, meta)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
 		return nil, "", err
 	}
-	apiKey := bxSession.Config.BluemixAPIKey
-	if len(apiKey) == 0 {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixAPIKey is empty")
-		return nil, "", fmt.Errorf("failed to get IBM cloud API key")
-	}
+	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient ResourceControllerAPI err %s", err)
+		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
 		return nil, "", err
 	}
+	clientPool[instanceCRN] = adminClient
+	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
+	return adminClient, instanceCRN, nil
+}
+
+// createSaramaConfig resolves the brokers of the instance referenced by
+// resource_instance_id and returns a Kafka client configuration for them.
+func createSaramaConfig(d *schema.ResourceData, meta interface{}) (*sarama.Config, []string, string, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		log.Printf("[DEBUG] createSaramaConfig BluemixSession err %s", err)
+		return nil, nil, "", err
+	}
+	apiKey := bxSession.Config.BluemixAPIKey
+	if len(apiKey) == 0 {
+		log.Printf("[DEBUG] createSaramaConfig BluemixAPIKey is empty")
+		return nil, nil, "", fmt.Errorf("failed to get IBM cloud API key")
+	}
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
 		topicID := d.Id()
 		if len(topicID) == 0 || !strings.Contains(topicID, ":") {
-			log.Printf("[DEBUG] createSaramaAdminClient resource_instance_id is missing")
-			return nil, "", fmt.Errorf("resource_instance_id is required")
+			log.Printf("[DEBUG] createSaramaConfig resource_instance_id is missing")
+			return nil, nil, "", fmt.Errorf("resource_instance_id is required")
 		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
 	instance, err := getInstanceDetails(instanceCRN, meta)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 	adminURL := instance.Extensions["kafka_http_url"].(string)
 	d.Set("kafka_http_url", adminURL)
-	log.Printf("[INFO] createSaramaAdminClient kafka_http_url is set to %s", adminURL)
+	log.Printf("[INFO] createSaramaConfig kafka_http_url is set to %s", adminURL)
 	brokerAddress := flex.ExpandStringList(instance.Extensions["kafka_brokers_sasl"].([]interface{}))
 	d.Set("kafka_brokers_sasl", brokerAddress)
-	log.Printf("[INFO] createSaramaAdminClient kafka_brokers_sasl is set to %s", brokerAddress)
+	log.Printf("[INFO] createSaramaConfig kafka_brokers_sasl is set to %s", brokerAddress)
 	tenantID := strings.TrimPrefix(strings.Split(adminURL, ".")[0], "https://")
 
 	config := sarama.NewConfig()
@@ -303,14 +316,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	config.Net.TLS.Enable = true
 	config.Version = brokerVersion
 	config.Admin.Timeout = adminClientTimeout
-	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
-		return nil, "", err
-	}
-	clientPool[instanceCRN] = adminClient
-	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
-	return adminClient, instanceCRN, nil
+	return config, brokerAddress, instanceCRN, nil
 }
 
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func DataSourceIBMIamAccessTag() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIamAccessTagRead,
+
+		Schema: map[string]*schema.Schema{
+			accessTagName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_tag", accessTagName),
+				Description:  "The name of the access management tag, in the key:value format",
+			},
+			accessTagType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the tag, always access",
+			},
+			accessTagResources: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources the access tag is attached to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CRN of the resource",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the resource",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamAccessTagRead(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	name := d.Get(accessTagName).(string)
+	tagName, err := getAccessTag(&gtClient, name)
+	if err != nil {
+		return err
+	}
+	if tagName == "" {
+		return fmt.Errorf("[ERROR] Access tag %s does not exist", name)
+	}
+
+	items, err := searchAccessTagResources(meta, tagName, []string{"crn", "name", "type"})
+	if err != nil {
+		return err
+	}
+
+	resources := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		resource := map[string]interface{}{}
+		if item.CRN != nil {
+			resource["crn"] = *item.CRN
+		}
+		if v, ok := item.GetProperty("name").(string); ok {
+			resource["name"] = v
+		}
+		if v, ok := item.GetProperty("type").(string); ok {
+			resource["type"] = v
+		}
+		resources = append(resources, resource)
+	}
+
+	d.SetId(tagName)
+	d.Set(accessTagName, tagName)
+	d.Set(accessTagType, accessTagTypeAccess)
+	if err := d.Set(accessTagResources, resources); err != nil {
+		return fmt.Errorf("[ERROR] Error setting %s: %s", accessTagResources, err)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	accessTagName        = "name"
+	accessTagType        = "tag_type"
+	accessTagForceDelete = "force_delete"
+	accessTagResources   = "resources"
+	accessTagTypeAccess  = "access"
+	// DetachTag accepts at most 100 resources per request
+	accessTagDetachBatchSize = 100
+)
+
+func ResourceIBMIamAccessTag() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMIamAccessTagCreate,
+		Read:     resourceIBMIamAccessTagRead,
+		Update:   resourceIBMIamAccessTagUpdate,
+		Delete:   resourceIBMIamAccessTagDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			accessTagName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_tag", accessTagName),
+				Description:  "The name of the access management tag, in the key:value format",
+			},
+			accessTagForceDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Detach the tag from all the resources it is attached to before deleting it. When false, deleting a tag that is still attached fails",
+			},
+			accessTagType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the tag, always access",
+			},
+		},
+	}
+}
+
+func ResourceIBMIamAccessTagValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 accessTagName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9_.-]+:[A-Za-z0-9_ .-]+$`,
+			MinValueLength:             3,
+			MaxValueLength:             128})
+
+	ibmIamAccessTagValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_tag", Schema: validateSchema}
+	return &ibmIamAccessTagValidator
+}
+
+func resourceIBMIamAccessTagCreate(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	name := d.Get(accessTagName).(string)
+	createTagOptions := &globaltaggingv1.CreateTagOptions{
+		TagNames: []string{name},
+		TagType:  flex.PtrToString(accessTagTypeAccess),
+	}
+
+	result, resp, err := gtClient.CreateTag(createTagOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating access tag %s: %s\n%s", name, err, resp)
+	}
+	if result != nil {
+		for _, r := range result.Results {
+			if r.IsError != nil && *r.IsError {
+				return fmt.Errorf("[ERROR] Error creating access tag %s: %s", name, resp)
+			}
+		}
+	}
+
+	d.SetId(name)
+
+	return resourceIBMIamAccessTagRead(d, meta)
+}
+
+func resourceIBMIamAccessTagRead(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	tagName, err := getAccessTag(&gtClient, d.Id())
+	if err != nil {
+		return err
+	}
+	if tagName == "" {
+		log.Printf("[WARN] Access tag %s not found, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(accessTagName, tagName)
+	d.Set(accessTagType, accessTagTypeAccess)
+	if _, ok := d.GetOkExists(accessTagForceDelete); !ok {
+		d.Set(accessTagForceDelete, false)
+	}
+
+	return nil
+}
+
+func resourceIBMIamAccessTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	// force_delete is the only attribute that can be updated and it is only used on delete
+	return resourceIBMIamAccessTagRead(d, meta)
+}
+
+func resourceIBMIamAccessTagDelete(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	name := d.Id()
+	if d.Get(accessTagForceDelete).(bool) {
+		crns, err := getAccessTagResources(meta, name)
+		if err != nil {
+			return err
+		}
+		for start := 0; start < len(crns); start += accessTagDetachBatchSize {
+			end := start + accessTagDetachBatchSize
+			if end > len(crns) {
+				end = len(crns)
+			}
+			resources := []globaltaggingv1.Resource{}
+			for _, crn := range crns[start:end] {
+				resources = append(resources, globaltaggingv1.Resource{ResourceID: flex.PtrToString(crn)})
+			}
+			detachTagOptions := &globaltaggingv1.DetachTagOptions{
+				Resources: resources,
+				TagNames:  []string{name},
+				TagType:   flex.PtrToString(accessTagTypeAccess),
+			}
+			_, resp, err := gtClient.DetachTag(detachTagOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error detaching access tag %s: %s\n%s", name, err, resp)
+			}
+		}
+	}
+
+	deleteTagOptions := &globaltaggingv1.DeleteTagOptions{
+		TagName: flex.PtrToString(name),
+		TagType: flex.PtrToString(accessTagTypeAccess),
+	}
+	result, resp, err := gtClient.DeleteTag(deleteTagOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting access tag %s: %s\n%s", name, err, resp)
+	}
+	if result != nil {
+		for _, r := range result.Results {
+			if r.IsError != nil && *r.IsError {
+				return fmt.Errorf("[ERROR] Error deleting access tag %s, it may still be attached to resources, set force_delete to detach it first: %s", name, resp)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getAccessTag returns the name of the access tag as stored by the tagging service, or an
+// empty string when the tag does not exist. Tag names are compared case insensitively.
+func getAccessTag(gtClient *globaltaggingv1.GlobalTaggingV1, name string) (string, error) {
+	var offset int64 = 0
+	var limit int64 = 1000
+	listTagsOptions := &globaltaggingv1.ListTagsOptions{
+		TagType: flex.PtrToString(accessTagTypeAccess),
+		Limit:   &limit,
+	}
+	for {
+		listTagsOptions.Offset = &offset
+		result, resp, err := gtClient.ListTags(listTagsOptions)
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error listing access tags: %s\n%s", err, resp)
+		}
+		for _, item := range result.Items {
+			if item.Name != nil && strings.EqualFold(*item.Name, name) {
+				return *item.Name, nil
+			}
+		}
+		offset += limit
+		if result.TotalCount == nil || offset >= *result.TotalCount {
+			break
+		}
+	}
+	return "", nil
+}
+
+// getAccessTagResources returns the CRNs of the resources the access tag is attached to.
+func getAccessTagResources(meta interface{}, name string) ([]string, error) {
+	items, err := searchAccessTagResources(meta, name, []string{"crn"})
+	if err != nil {
+		return nil, err
+	}
+	crns := []string{}
+	for _, item := range items {
+		if item.CRN != nil {
+			crns = append(crns, *item.CRN)
+		}
+	}
+	return crns, nil
+}
+
+func searchAccessTagResources(meta interface{}, name string, fields []string) ([]globalsearchv2.ResultItem, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	options := &globalsearchv2.SearchOptions{}
+	options.SetQuery(fmt.Sprintf("access_tags:\"%s\"", name))
+	options.SetFields(fields)
+	var limit int64 = 1000
+	options.SetLimit(limit)
+
+	items := []globalsearchv2.ResultItem{}
+	for {
+		result, resp, err := gsClient.Search(options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error searching resources with access tag %s: %s\n%s", name, err, resp)
+		}
+		items = append(items, result.Items...)
+		if int64(len(result.Items)) < limit || result.SearchCursor == nil {
+			break
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+	return items, nil
+}
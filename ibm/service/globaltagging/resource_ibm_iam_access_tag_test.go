@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIamAccessTag_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-env:access-tag-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIamAccessTagCreate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_tag.tag", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_access_tag.tag", "tag_type", "access"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_tag.tag",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIamAccessTag_ForceDelete(t *testing.T) {
+	name := fmt.Sprintf("tf-env:access-tag-%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("tf-vpc-access-tag-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIamAccessTagAttached(name, vpcName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_tag.tag", "force_delete", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_tag.tag", "resources.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_access_tag.tag", "resources.0.crn", "ibm_is_vpc.vpc", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIamAccessTagCreate(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_tag" "tag" {
+		name = "%s"
+	}
+	`, name)
+}
+
+func testAccCheckIamAccessTagAttached(name, vpcName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_tag" "tag" {
+		name         = "%s"
+		force_delete = true
+	}
+
+	resource "ibm_is_vpc" "vpc" {
+		name        = "%s"
+		access_tags = [ibm_iam_access_tag.tag.name]
+	}
+
+	data "ibm_iam_access_tag" "tag" {
+		name       = ibm_iam_access_tag.tag.name
+		depends_on = [ibm_is_vpc.vpc]
+	}
+	`, name, vpcName)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 )
 
@@ -111,6 +113,33 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 				Computed:    true,
 				Description: "The user or system that last updated the assignment.",
 			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The propagation status of the template resources in each target account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account that the resources are propagated to.",
+						},
+						"group": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The access group propagated to the account.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group":   assignmentResourceEntrySchema("The access group."),
+									"members": assignmentResourceEntrySchema("The members of the access group."),
+									"rules":   assignmentResourceEntrySchema("The dynamic rules of the access group."),
+								},
+							},
+						},
+						"policy_template_references": assignmentResourceEntrySchema("The policy templates propagated to the account."),
+					},
+				},
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,6 +148,53 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 	}
 }
 
+func assignmentResourceEntrySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the resource.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the resource.",
+				},
+				"version": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The version of the resource.",
+				},
+				"resource": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the resource created in the target account.",
+				},
+				"error": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The error raised while propagating the resource.",
+				},
+				"operation": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The operation applied to the resource.",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The propagation status of the resource.",
+				},
+			},
+		},
+	}
+}
+
 func ResourceIBMIAMAccessGroupTemplateAssignmentValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	validateSchema = append(validateSchema,
@@ -262,6 +338,9 @@ func resourceIBMIAMAccessGroupTemplateAssignmentRead(context context.Context, d
 	if err = d.Set("last_modified_by_id", templateAssignmentVerboseResponse.LastModifiedByID); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting last_modified_by_id: %s", err))
 	}
+	if err = d.Set("resources", flattenAssignmentResources(templateAssignmentVerboseResponse.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resources: %s", err))
+	}
 	if err = d.Set("etag", response.Headers.Get("Etag")); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting etag: %s", err))
 	}
@@ -309,7 +388,10 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
 		}
-		waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating assignment %s", err))
+		}
 	}
 
 	return resourceIBMIAMAccessGroupTemplateAssignmentRead(context, d, meta)
@@ -331,7 +413,10 @@ func resourceIBMIAMAccessGroupTemplateAssignmentDelete(context context.Context,
 		return diag.FromErr(fmt.Errorf("DeleteAssignmentWithContext failed %s\n%s", err, response))
 	}
 
-	waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error removing assignment %s", err))
+	}
 
 	d.SetId("")
 
@@ -381,7 +466,7 @@ func isAccessGroupTemplateAssigned(id string, meta interface{}) resource.StateRe
 			}
 
 			if *assignment.Status == "failed" {
-				return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors: %s\n", id, response)
+				return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status: %s\n", id, strings.Join(assignmentResourceErrors(assignment.Resources), "; "))
 			}
 		}
 
@@ -414,3 +499,64 @@ func isAccessGroupTemplateAssignmentDeleted(id string, meta interface{}) resourc
 		return assignment, InProgress, nil
 	}
 }
+
+func flattenAssignmentResources(resources []iamaccessgroupsv2.ResourceListWithTargetAccountID) []map[string]interface{} {
+	resourceList := []map[string]interface{}{}
+	for _, r := range resources {
+		resource := map[string]interface{}{
+			"policy_template_references": flattenAssignmentResourceEntries(r.PolicyTemplateReferences),
+		}
+		if r.Target != nil {
+			resource["target"] = r.Target
+		}
+		if r.Group != nil {
+			group := map[string]interface{}{
+				"members": flattenAssignmentResourceEntries(r.Group.Members),
+				"rules":   flattenAssignmentResourceEntries(r.Group.Rules),
+			}
+			if r.Group.Group != nil {
+				group["group"] = flattenAssignmentResourceEntries([]iamaccessgroupsv2.AssignmentResourceEntry{*r.Group.Group})
+			}
+			resource["group"] = []map[string]interface{}{group}
+		}
+		resourceList = append(resourceList, resource)
+	}
+	return resourceList
+}
+
+func flattenAssignmentResourceEntries(entries []iamaccessgroupsv2.AssignmentResourceEntry) []map[string]interface{} {
+	entryList := []map[string]interface{}{}
+	for _, e := range entries {
+		entryList = append(entryList, map[string]interface{}{
+			"id":        core.StringNilMapper(e.ID),
+			"name":      core.StringNilMapper(e.Name),
+			"version":   core.StringNilMapper(e.Version),
+			"resource":  core.StringNilMapper(e.Resource),
+			"error":     core.StringNilMapper(e.Error),
+			"operation": core.StringNilMapper(e.Operation),
+			"status":    core.StringNilMapper(e.Status),
+		})
+	}
+	return entryList
+}
+
+// assignmentResourceErrors returns the errors of the resources that failed to propagate to the target accounts
+func assignmentResourceErrors(resources []iamaccessgroupsv2.ResourceListWithTargetAccountID) []string {
+	errs := []string{}
+	for _, r := range resources {
+		entries := append([]iamaccessgroupsv2.AssignmentResourceEntry{}, r.PolicyTemplateReferences...)
+		if r.Group != nil {
+			if r.Group.Group != nil {
+				entries = append(entries, *r.Group.Group)
+			}
+			entries = append(entries, r.Group.Members...)
+			entries = append(entries, r.Group.Rules...)
+		}
+		for _, e := range entries {
+			if e.Error != nil && *e.Error != "" {
+				errs = append(errs, fmt.Sprintf("%s %s: %s", core.StringNilMapper(r.Target), core.StringNilMapper(e.ID), *e.Error))
+			}
+		}
+	}
+	return errs
+}
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -18,11 +19,12 @@ import (
 
 func ResourceIBMIAMUserPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMUserPolicyCreate,
-		Read:   resourceIBMIAMUserPolicyRead,
-		Update: resourceIBMIAMUserPolicyUpdate,
-		Delete: resourceIBMIAMUserPolicyDelete,
-		Exists: resourceIBMIAMUserPolicyExists,
+		Create:        resourceIBMIAMUserPolicyCreate,
+		Read:          resourceIBMIAMUserPolicyRead,
+		Update:        resourceIBMIAMUserPolicyUpdate,
+		Delete:        resourceIBMIAMUserPolicyDelete,
+		Exists:        resourceIBMIAMUserPolicyExists,
+		CustomizeDiff: validatePolicyRoles,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importUserPolicy(d, meta)
@@ -780,3 +782,92 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	d.Set("resource_tags", flex.FlattenV2PolicyResourceTags(*userPolicy.Resource))
 	return resources, resource_attributes, nil
 }
+
+// validatePolicyRoles checks the roles of a policy against the roles the targeted service, service
+// group or account management services support, so that a role which can't be granted there, such
+// as a service role on an account management service like billing, fails the plan instead of the
+// policy creation.
+func validatePolicyRoles(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("roles", "resources", "resource_attributes", "account_management") {
+		return nil
+	}
+	for _, key := range []string{"roles", "resources", "resource_attributes", "account_management"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	roleNames := []string{}
+	for i, role := range diff.Get("roles").([]interface{}) {
+		if !diff.NewValueKnown(fmt.Sprintf("roles.%d", i)) {
+			return nil
+		}
+		roleNames = append(roleNames, role.(string))
+	}
+
+	var serviceName, serviceGroupID, resourceType string
+	if _, ok := diff.GetOk("resources"); ok {
+		for _, key := range []string{"resources.0.service", "resources.0.service_group_id", "resources.0.resource_type"} {
+			if !diff.NewValueKnown(key) {
+				return nil
+			}
+		}
+		serviceName = diff.Get("resources.0.service").(string)
+		serviceGroupID = diff.Get("resources.0.service_group_id").(string)
+		resourceType = diff.Get("resources.0.resource_type").(string)
+	}
+	for _, attribute := range diff.Get("resource_attributes").(*schema.Set).List() {
+		a := attribute.(map[string]interface{})
+		switch a["name"].(string) {
+		case "serviceName":
+			serviceName = a["value"].(string)
+		case "service_group_id":
+			serviceGroupID = a["value"].(string)
+		case "resourceType":
+			resourceType = a["value"].(string)
+		}
+	}
+	accountManagement := diff.Get("account_management").(bool)
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	// the same role lookup as for the policy creation
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID: &userDetails.UserAccount,
+	}
+	target := "all account management services"
+	if serviceName == "" && !accountManagement && resourceType != "resource-group" && serviceGroupID == "" {
+		listRoleOptions.ServiceName = core.StringPtr("alliamserviceroles")
+		target = "all IAM enabled services"
+	}
+	if resourceType == "resource-group" {
+		target = "resource groups"
+	}
+	if serviceName != "" {
+		listRoleOptions.ServiceName = &serviceName
+		target = fmt.Sprintf("service %s", serviceName)
+	}
+	if serviceGroupID != "" {
+		listRoleOptions.ServiceGroupID = &serviceGroupID
+		target = fmt.Sprintf("service group %s", serviceGroupID)
+	}
+
+	roleList, response, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing roles to validate the policy: %s\n%s", err, response)
+	}
+	roles := flex.MapRoleListToPolicyRoles(*roleList)
+	for _, roleName := range roleNames {
+		if _, err := flex.FindRoleByName(roles, roleName); err != nil {
+			return fmt.Errorf("[ERROR] Role %s can't be granted on %s: %s", roleName, target, err)
+		}
+	}
+	return nil
+}
@@ -242,6 +242,20 @@ func TestAccIBMIAMUserPolicy_Invalid_User(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMUserPolicy_Invalid_Account_Management_Role(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIAMUserPolicyInvalidAccountManagementRole(),
+				ExpectError: regexp.MustCompile(`Role Reader can't be granted on service billing`),
+			},
+		},
+	})
+}
+
 func TestAccIBMIAMUserPolicyWithCustomRole(t *testing.T) {
 	var conf iampolicymanagementv1.V2PolicyTemplateMetaData
 	crName := fmt.Sprintf("Terraform%d", acctest.RandIntRange(10, 100))
@@ -754,6 +768,21 @@ func testAccCheckIBMIAMUserPolicyInvalidUser() string {
 	`
 }
 
+func testAccCheckIBMIAMUserPolicyInvalidAccountManagementRole() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_user_policy" "policy" {
+			ibm_id = "%s"
+			roles  = ["Reader"]
+
+			resources {
+				service = "billing"
+			}
+		}
+
+	`, acc.IAMUser)
+}
+
 func testAccCheckIBMIAMUserPolicyAccountManagement(name string) string {
 	return fmt.Sprintf(`
 	
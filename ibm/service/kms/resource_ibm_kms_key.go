@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"strconv"
 	"strings"
@@ -16,9 +17,13 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Keys expiring within this period that still protect registered resources are reported on read
+const kmsKeyExpirationWarningPeriod = 30 * 24 * time.Hour
+
 func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool {
 	// TF currently uses GUID. So just check when instance crn is passed as input it has same GUID in it.
 	return old == getInstanceIDFromCRN(new)
@@ -35,12 +40,12 @@ func getInstanceIDFromCRN(crn string) string {
 
 func ResourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyCreate,
-		Read:     resourceIBMKmsKeyRead,
-		Update:   resourceIBMKmsKeyUpdate,
-		Delete:   resourceIBMKmsKeyDelete,
-		Exists:   resourceIBMKmsKeyExists,
-		Importer: &schema.ResourceImporter{},
+		Create:      resourceIBMKmsKeyCreate,
+		ReadContext: resourceIBMKmsKeyReadContext,
+		Update:      resourceIBMKmsKeyUpdate,
+		Delete:      resourceIBMKmsKeyDelete,
+		Exists:      resourceIBMKmsKeyExists,
+		Importer:    &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -202,6 +207,56 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
+func resourceIBMKmsKeyReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, err := populateSchemaData(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if kpAPI == nil || d.Id() == "" {
+		return nil
+	}
+	return kmsKeyExpirationDiagnostics(ctx, d, kpAPI)
+}
+
+// kmsKeyExpirationDiagnostics warns when the key expires soon, or has expired, while resources are still
+// registered with it, as those resources lose access to their data once the key is deactivated.
+func kmsKeyExpirationDiagnostics(ctx context.Context, d *schema.ResourceData, kpAPI *kp.Client) diag.Diagnostics {
+	expirationDate := d.Get("expiration_date").(string)
+	if expirationDate == "" {
+		return nil
+	}
+	expiration, err := time.Parse(time.RFC3339, expirationDate)
+	if err != nil || time.Until(expiration) > kmsKeyExpirationWarningPeriod {
+		return nil
+	}
+
+	_, _, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	regs, err := kpAPI.ListRegistrations(ctx, keyid, "")
+	if err != nil {
+		log.Printf("[WARN] Error listing registrations of key %s: %s", keyid, err)
+		return nil
+	}
+	if regs == nil || len(regs.Registrations) == 0 {
+		return nil
+	}
+
+	resources := make([]string, 0, len(regs.Registrations))
+	for _, reg := range regs.Registrations {
+		resources = append(resources, reg.ResourceCrn)
+	}
+	summary := fmt.Sprintf("Key %s expires on %s but still protects %d registered resources", keyid, expirationDate, len(resources))
+	if time.Now().After(expiration) {
+		summary = fmt.Sprintf("Key %s expired on %s but still protects %d registered resources", keyid, expirationDate, len(resources))
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("Rotate the protected resources to another key before the key is deactivated: %s", strings.Join(resources, ", ")),
+		},
+	}
+}
+
 func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("force_delete") {
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestKmsKeyExpirationDiagnostics(t *testing.T) {
+	keyCRN := "crn:v1:bluemix:public:kms:us-south:a/account:instance:key:key-id"
+	resourceCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/account:cos-instance:bucket:bucket"
+
+	testcases := []struct {
+		name           string
+		expirationDate string
+		registrations  int
+		summary        string
+	}{
+		{
+			name:           "no expiration",
+			expirationDate: "",
+			registrations:  1,
+		},
+		{
+			name:           "expiration outside the warning period",
+			expirationDate: time.Now().Add(kmsKeyExpirationWarningPeriod + 24*time.Hour).UTC().Format(time.RFC3339),
+			registrations:  1,
+		},
+		{
+			name:           "near expiry without registrations",
+			expirationDate: time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339),
+			registrations:  0,
+		},
+		{
+			name:           "near expiry",
+			expirationDate: time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339),
+			registrations:  1,
+			summary:        "Key key-id expires on %s but still protects 1 registered resources",
+		},
+		{
+			name:           "expired",
+			expirationDate: time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339),
+			registrations:  1,
+			summary:        "Key key-id expired on %s but still protects 1 registered resources",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.URL.Path, "/api/v2/keys/key-id/registrations")
+				resources := ""
+				if tc.registrations > 0 {
+					resources = fmt.Sprintf(`{"keyId": "key-id", "resourceCrn": "%s"}`, resourceCRN)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"metadata": {"collectionTotal": %d}, "resources": [%s]}`, tc.registrations, resources)
+			}))
+			defer server.Close()
+
+			kpAPI, err := kp.New(kp.ClientConfig{
+				BaseURL:       server.URL,
+				Authorization: "Bearer token",
+				InstanceID:    "instance",
+			}, kp.DefaultTransport())
+			assert.NilError(t, err)
+
+			d := schema.TestResourceDataRaw(t, ResourceIBMKmskey().Schema, map[string]interface{}{
+				"expiration_date": tc.expirationDate,
+			})
+			d.SetId(keyCRN)
+
+			diags := kmsKeyExpirationDiagnostics(context.Background(), d, kpAPI)
+			if tc.summary == "" {
+				assert.Equal(t, len(diags), 0)
+				return
+			}
+			assert.Equal(t, len(diags), 1)
+			assert.Equal(t, diags[0].Severity, diag.Warning)
+			assert.Equal(t, diags[0].Summary, fmt.Sprintf(tc.summary, tc.expirationDate))
+			assert.Equal(t, diags[0].Detail, "Rotate the protected resources to another key before the key is deactivated: "+resourceCRN)
+		})
+	}
+}
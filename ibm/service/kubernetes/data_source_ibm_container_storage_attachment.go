@@ -68,6 +68,11 @@ func DataSourceIBMContainerVpcWorkerVolumeAttachment() *schema.Resource {
 				Computed:    true,
 				Description: "The type of volume",
 			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the device of the attached volume on the worker",
+			},
 		},
 	}
 }
@@ -117,6 +122,7 @@ func dataSourceIBMContainerVpcWorkerVolumeAttachmentRead(context context.Context
 	d.Set("volume_attachment_name", volume.Name)
 	d.Set("status", volume.Status)
 	d.Set("volume_type", volume.Type)
+	d.Set("device_id", volume.Device.Id)
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterNameorID, workerID, volumeAttachmentID))
 	return nil
 }
@@ -96,6 +96,12 @@ func ResourceIBMContainerVpcWorkerVolumeAttachment() *schema.Resource {
 				Computed:    true,
 				Description: "The type of volume",
 			},
+
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the device of the attached volume on the worker",
+			},
 		},
 	}
 }
@@ -137,6 +143,11 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentCreate(context context.Context
 		Worker:   workerID,
 	}
 
+	// Attach one volume at a time per worker, the device order isn't guaranteed, use device_id to find the device
+	storageAttachmentKey := storageAttachmentMutexKey(workerID)
+	conns.IbmMutexKV.Lock(storageAttachmentKey)
+	defer conns.IbmMutexKV.Unlock(storageAttachmentKey)
+
 	volumeattached, err := workersAPI.CreateStorageAttachment(attachVolumeRequest, target)
 	if err != nil {
 		return diag.FromErr(err)
@@ -180,6 +191,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentRead(context context.Context,
 	d.Set("volume_attachment_name", volumeAttachment.Name)
 	d.Set("status", volumeAttachment.Status)
 	d.Set("volume_type", volumeAttachment.Type)
+	d.Set("device_id", volumeAttachment.Device.Id)
 	return nil
 }
 
@@ -209,6 +221,27 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentDelete(context context.Context
 		Worker:             workerID,
 	}
 
+	storageAttachmentKey := storageAttachmentMutexKey(workerID)
+	conns.IbmMutexKV.Lock(storageAttachmentKey)
+	defer conns.IbmMutexKV.Unlock(storageAttachmentKey)
+
+	// A detach request sent while the volume is still attaching leaves the volume stuck,
+	// let a pending attach settle first
+	volumeAttachment, err := workersAPI.GetStorageAttachment(clusterNameorID, workerID, volumeAttachmentID, target)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting storage attachment (%s): %s", d.Id(), err))
+	}
+	if volumeAttachment.Status == volumeAttaching {
+		_, err = waitforVolumetoAttach(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage attachment (%s) to be attached before detaching it: %s", d.Id(), err))
+		}
+	}
+
 	response, deleteErr := workersAPI.DeleteStorageAttachment(detachVolumeRequest, target)
 	if deleteErr != nil && !strings.Contains(deleteErr.Error(), "EmptyResponseBody") {
 		if response != "Ok" && strings.Contains(response, "Not found") {
@@ -259,6 +292,10 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentExists(d *schema.ResourceData,
 	return true, nil
 }
 
+func storageAttachmentMutexKey(workerID string) string {
+	return "storage_attachment_" + workerID
+}
+
 func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -34,6 +34,8 @@ func TestAccIBMContainerVpcClusterWorkerVolumeAttachment_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"ibm_container_storage_attachment.volume_attach", "status", "attached"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_storage_attachment.volume_attach", "device_id"),
 				),
 			},
 			{
@@ -195,6 +195,20 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Number of worker nodes in the cluster",
 			},
 
+			"hibernate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Scale all the worker pools of the cluster to zero, the master and the configuration of the cluster are kept. Set back to false to restore the worker pools to their previous size",
+			},
+
+			"hibernated_worker_pools": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The number of workers per zone of each worker pool before the cluster was hibernated",
+			},
+
 			"worker_labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -855,7 +869,12 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("worker_count") && !d.IsNewResource() {
+	// the default worker pool of a hibernated cluster is resized to worker_count when the cluster is restored
+	if d.HasChange("worker_count") && !d.IsNewResource() && d.Get("hibernate").(bool) && !d.HasChange("hibernate") {
+		hibernatedWorkerPools := d.Get("hibernated_worker_pools").(map[string]interface{})
+		hibernatedWorkerPools["default"] = d.Get("worker_count").(int)
+		d.Set("hibernated_worker_pools", hibernatedWorkerPools)
+	} else if d.HasChange("worker_count") && !d.IsNewResource() && !d.Get("hibernate").(bool) {
 		count := d.Get("worker_count").(int)
 		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
@@ -932,6 +951,20 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		d.Set("force_delete_storage", forceDeleteStorage)
 	}
 
+	if d.HasChange("hibernate") {
+		hibernate := d.Get("hibernate").(bool)
+		if hibernate {
+			err = vpcClusterHibernate(d, csClient, clusterID, targetEnv)
+		} else {
+			err = vpcClusterResume(d, csClient, clusterID, targetEnv)
+		}
+		if err != nil {
+			// the old value is kept, so that the next apply resizes the remaining worker pools
+			d.Set("hibernate", !hibernate)
+			return err
+		}
+	}
+
 	if d.HasChange("image_security_enforcement") && !d.IsNewResource() {
 		var imageSecurity bool
 		if v, ok := d.GetOk("image_security_enforcement"); ok {
@@ -946,6 +979,59 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	return resourceIBMContainerVpcClusterRead(d, meta)
 }
+
+// vpcClusterHibernate records the size of the worker pools of the cluster and resizes them to zero.
+func vpcClusterHibernate(d *schema.ResourceData, csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader) error {
+	workerPools, err := csClient.WorkerPools().ListWorkerPools(clusterID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pools of the cluster %s: %s", clusterID, err)
+	}
+	// the worker pools hibernated by a previous, failed, hibernation are already resized to zero
+	hibernatedWorkerPools := d.Get("hibernated_worker_pools").(map[string]interface{})
+	for _, workerPool := range workerPools {
+		if workerPool.WorkerCount == 0 {
+			continue
+		}
+		if workerPool.AutoscaleEnabled {
+			log.Printf("[WARN] Worker pool %s of the cluster %s is managed by the cluster autoscaler, which can scale it up while the cluster is hibernated", workerPool.PoolName, clusterID)
+		}
+		hibernatedWorkerPools[workerPool.PoolName] = workerPool.WorkerCount
+		// the worker pools resized so far are kept in the state, so that a failed hibernation can be restored
+		d.Set("hibernated_worker_pools", hibernatedWorkerPools)
+		err = csClient.WorkerPools().ResizeWorkerPool(v2.ResizeWorkerPoolReq{
+			Cluster:    clusterID,
+			Workerpool: workerPool.PoolName,
+			Size:       0,
+		}, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error hibernating worker pool %s of the cluster %s: %s", workerPool.PoolName, clusterID, err)
+		}
+	}
+	d.Set("hibernated_worker_pools", hibernatedWorkerPools)
+	return nil
+}
+
+// vpcClusterResume resizes the worker pools of a hibernated cluster back to the size recorded on hibernation.
+func vpcClusterResume(d *schema.ResourceData, csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader) error {
+	hibernatedWorkerPools := d.Get("hibernated_worker_pools").(map[string]interface{})
+	if _, ok := hibernatedWorkerPools["default"]; ok {
+		hibernatedWorkerPools["default"] = d.Get("worker_count").(int)
+	}
+	for poolName, count := range hibernatedWorkerPools {
+		err := csClient.WorkerPools().ResizeWorkerPool(v2.ResizeWorkerPoolReq{
+			Cluster:    clusterID,
+			Workerpool: poolName,
+			Size:       int64(count.(int)),
+		}, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error restoring worker pool %s of the cluster %s: %s", poolName, clusterID, err)
+		}
+		delete(hibernatedWorkerPools, poolName)
+		d.Set("hibernated_worker_pools", hibernatedWorkerPools)
+	}
+	return nil
+}
+
 func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -1034,7 +1120,12 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	} else {
 		d.Set("kube_version", strings.Split(cls.MasterKubeVersion, "_")[0])
 	}
-	d.Set("worker_count", workerPool.WorkerCount)
+	// the default worker pool of a hibernated cluster has no workers, the size it is restored to is kept
+	if count, ok := d.Get("hibernated_worker_pools").(map[string]interface{})["default"]; ok && d.Get("hibernate").(bool) {
+		d.Set("worker_count", count)
+	} else {
+		d.Set("worker_count", workerPool.WorkerCount)
+	}
 	d.Set("worker_labels", flex.IgnoreSystemLabels(workerPool.Labels))
 	if cls.Vpcs != nil {
 		d.Set("vpc_id", cls.Vpcs[0])
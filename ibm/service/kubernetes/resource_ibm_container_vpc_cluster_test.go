@@ -125,6 +125,44 @@ func TestAccIBMContainerVpcClusterImageSecuritySetting(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerVpcClusterHibernate(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterHibernate(clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "hibernate", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterHibernate(clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "hibernate", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "hibernated_worker_pools.default", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "worker_count", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterHibernate(clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "hibernate", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.testacc_vpc_cluster", "hibernated_worker_pools.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMContainerVpcClusterDedicatedHost(t *testing.T) {
 	clusterName := fmt.Sprintf("tf-vpc-cluster-dhost-%d", acctest.RandIntRange(10, 100))
 	hostPoolID := acc.HostPoolID
@@ -384,6 +422,23 @@ func testAccCheckIBMContainerVpcClusterImageSecuritySetting(name, setting string
 	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, setting)
 }
 
+func testAccCheckIBMContainerVpcClusterHibernate(name string, hibernate bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "testacc_vpc_cluster" {
+		name              = "%s"
+		vpc_id            = "%s"
+		flavor            = "bx2.2x8"
+		worker_count      = "1"
+		resource_group_id = "%s"
+		zones {
+			subnet_id = "%s"
+			name      = "us-south-1"
+		  }
+		wait_till         = "OneWorkerNodeReady"
+		hibernate         = %t
+	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, hibernate)
+}
+
 func testAccCheckIBMContainerVpcClusterDedicatedHostSetting(name, vpcID, flavor, subnetID, rgroupID, hostpoolID string) string {
 	return fmt.Sprintf(`
 	resource "ibm_container_vpc_cluster" "testacc_dhost_vpc_cluster" {
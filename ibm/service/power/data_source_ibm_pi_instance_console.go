@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+/*
+Datasource to generate a short-lived console URL for an instance. A new URL is
+minted on every read, so nothing long-lived ends up in the state.
+*/
+func DataSourceIBMPIInstanceConsole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIInstanceConsoleRead,
+		Schema: map[string]*schema.Schema{
+
+			// Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			helpers.PIInstanceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The unique identifier or name of the instance",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ConsoleURL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The time-boxed URL to the noVNC console of the instance",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIInstanceConsoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	// session
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// arguments
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceName := d.Get(helpers.PIInstanceName).(string)
+
+	// generate console url
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	console, err := client.PostConsoleURL(instanceName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// set attributes
+	d.SetId(instanceName)
+	d.Set(Attr_ConsoleURL, console.ConsoleURL)
+
+	return nil
+}
@@ -47,6 +47,7 @@ const (
 	Arg_PVMInstanceActionType   = "pi_action"
 	Arg_PVMInstanceHealthStatus = "pi_health_status"
 
+	Attr_ConsoleURL   = "console_url"
 	Attr_Status       = "status"
 	Attr_Progress     = "progress"
 	Attr_HealthStatus = "health_status"
@@ -171,4 +172,7 @@ const (
 	PIWorkspaceDatacenter    = "pi_datacenter"
 	PIWorkspaceResourceGroup = "pi_resource_group_id"
 	PIWorkspacePlan          = "pi_plan"
+	PIWorkspaceUserTags      = "pi_user_tags"
+	PIWorkspaceAuthPolicies  = "pi_authorizations"
+	PIWorkspaceCRN           = "crn"
 )
@@ -30,6 +30,7 @@ func ResourceIBMPICapture() *schema.Resource {
 		ReadContext:   resourceIBMPICaptureRead,
 		DeleteContext: resourceIBMPICaptureDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMPICaptureValidateCloudStorage,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -114,6 +115,30 @@ func ResourceIBMPICapture() *schema.Resource {
 	}
 }
 
+// resourceIBMPICaptureValidateCloudStorage requires the cloud storage arguments during plan when
+// the capture is exported to cloud storage, instead of failing after the plan was approved.
+func resourceIBMPICaptureValidateCloudStorage(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown(helpers.PIInstanceCaptureDestination) {
+		return nil
+	}
+	capturedestination := diff.Get(helpers.PIInstanceCaptureDestination).(string)
+	if capturedestination == imageCatalogDestination {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	for _, key := range []string{
+		helpers.PIInstanceCaptureCloudStorageRegion,
+		helpers.PIInstanceCaptureCloudStorageAccessKey,
+		helpers.PIInstanceCaptureCloudStorageSecretKey,
+		helpers.PIInstanceCaptureCloudStorageImagePath,
+	} {
+		if config.GetAttr(key).IsNull() {
+			return fmt.Errorf("%s is required when capture destination is %s", key, capturedestination)
+		}
+	}
+	return nil
+}
+
 func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -140,17 +165,17 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageAccessKey); ok {
 			captureBody.CloudStorageAccessKey = v.(string)
 		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageAccessKey, capturedestination)
+			return diag.Errorf("%s is required when capture destination is %s", helpers.PIInstanceCaptureCloudStorageAccessKey, capturedestination)
 		}
 		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageImagePath); ok {
 			captureBody.CloudStorageImagePath = v.(string)
 		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageImagePath, capturedestination)
+			return diag.Errorf("%s is required when capture destination is %s", helpers.PIInstanceCaptureCloudStorageImagePath, capturedestination)
 		}
 		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageSecretKey); ok {
 			captureBody.CloudStorageSecretKey = v.(string)
 		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageSecretKey, capturedestination)
+			return diag.Errorf("%s is required when capture destination is %s", helpers.PIInstanceCaptureCloudStorageSecretKey, capturedestination)
 		}
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -94,6 +95,20 @@ func TestAccIBMPICaptureBoth(t *testing.T) {
 	})
 }
 
+func TestAccIBMPICaptureCloudStorageMissingKey(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-capture-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPICaptureCloudStorageMissingKeyConfig(name),
+				ExpectError: regexp.MustCompile(`pi_capture_cloud_storage_secret_key is required when capture destination is cloud-storage`),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPICaptureExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -206,3 +221,17 @@ func testAccCheckIBMPICaptureBothConfig(name string) string {
 	}
 	`, acc.Pi_cloud_instance_id, name, acc.Pi_instance_name, acc.Pi_capture_cloud_storage_access_key, acc.Pi_capture_cloud_storage_secret_key, acc.Pi_capture_storage_image_path)
 }
+
+func testAccCheckIBMPICaptureCloudStorageMissingKeyConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_capture" "capture_instance" {
+		pi_cloud_instance_id="%[1]s"
+		pi_capture_name  = "%s"
+		pi_instance_name = "%s"
+		pi_capture_destination = "cloud-storage"
+		pi_capture_cloud_storage_region = "us-east"
+		pi_capture_cloud_storage_access_key = "%s"
+		pi_capture_storage_image_path = "%s"
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_instance_name, acc.Pi_capture_cloud_storage_access_key, acc.Pi_capture_storage_image_path)
+}
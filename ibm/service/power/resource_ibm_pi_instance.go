@@ -94,7 +94,8 @@ func ResourceIBMPIInstance() *schema.Resource {
 			helpers.PIInstanceUserData: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Base64 encoded data to be passed in for invoking a cloud init script",
+				ForceNew:    true,
+				Description: "User data to pass to the instance on creation, a cloud-init script or an ignition config for RHCOS, plain text or base64 encoded",
 			},
 
 			helpers.PIInstanceStorageType: {
@@ -864,6 +865,15 @@ func checkBase64(input string) error {
 	return err
 }
 
+// encodeUserData returns the user data base64 encoded as the API expects it, user data that
+// is not already encoded, such as a cloud-init script or an ignition config, is encoded.
+func encodeUserData(userData string) string {
+	if checkBase64(userData) == nil {
+		return userData
+	}
+	return base64.StdEncoding.EncodeToString([]byte(userData))
+}
+
 func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
@@ -1080,13 +1090,7 @@ func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceCli
 		body.SSHKeyName = sshkey
 	}
 	if u, ok := d.GetOk(helpers.PIInstanceUserData); ok {
-		userData := u.(string)
-		err := checkBase64(userData)
-		if err != nil {
-			log.Printf("Data is not base64 encoded")
-			return nil, err
-		}
-		body.UserData = userData
+		body.UserData = encodeUserData(u.(string))
 	}
 	if sys, ok := d.GetOk(helpers.PIInstanceSystemType); ok {
 		body.SysType = sys.(string)
@@ -1203,12 +1207,7 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 
 	var userData string
 	if u, ok := d.GetOk(helpers.PIInstanceUserData); ok {
-		userData = u.(string)
-	}
-	err := checkBase64(userData)
-	if err != nil {
-		log.Printf("Data is not base64 encoded")
-		return nil, err
+		userData = encodeUserData(u.(string))
 	}
 
 	//publicinterface := d.Get(helpers.PIInstancePublicNetwork).(bool)
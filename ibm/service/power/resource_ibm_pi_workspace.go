@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +22,7 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIWorkspaceCreate,
 		ReadContext:   resourceIBMPIWorkspaceRead,
+		UpdateContext: resourceIBMPIWorkspaceUpdate,
 		DeleteContext: resourceIBMPIWorkspaceDelete,
 		Importer:      &schema.ResourceImporter{},
 
@@ -50,6 +56,48 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 				ForceNew:    true,
 				Description: "Plan associated with the offering; Valid values are public or private.",
 			},
+			PIWorkspaceUserTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The user tags attached to the workspace.",
+			},
+			PIWorkspaceAuthPolicies: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Service to service authorizations created with the workspace as source, for example to import images from Cloud Object Storage. Only the added, removed or changed authorizations are updated.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_service_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the target service, for example cloud-object-storage.",
+						},
+						"target_service_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The GUID of the target service instance, all the instances of the target service when not set.",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The roles granted to the workspace on the target, for example Reader.",
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the authorization policy.",
+						},
+					},
+				},
+			},
+			PIWorkspaceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the workspace.",
+			},
 		},
 	}
 }
@@ -79,9 +127,170 @@ func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if tags, ok := d.GetOk(PIWorkspaceUserTags); ok {
+		if err = flex.UpdateTagsUsingCRN(new(schema.Set), tags, meta, *controller.CRN); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error on create of workspace (%s) tags: %s", d.Id(), err))
+		}
+	}
+
+	if authorizations, ok := d.GetOk(PIWorkspaceAuthPolicies); ok {
+		if err = updatePIWorkspaceAuthPolicies(d, meta, *controller.GUID, nil, authorizations.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMPIWorkspaceRead(ctx, d, meta)
 }
 
+// updatePIWorkspaceAuthPolicies creates the authorization policies of pi_authorizations missing from the old list,
+// with the workspace as source, and deletes the ones no longer configured. An authorization whose target or roles
+// changed is recreated. The list is saved after each policy so that the policies handled so far stay in the state.
+func updatePIWorkspaceAuthPolicies(d *schema.ResourceData, meta interface{}, workspaceID string, oldAuthorizations, newAuthorizations []interface{}) error {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	current := map[string]map[string]interface{}{}
+	for _, a := range oldAuthorizations {
+		authorization := a.(map[string]interface{})
+		if authorization["policy_id"].(string) != "" {
+			current[piWorkspaceAuthPolicyKey(authorization)] = authorization
+		}
+	}
+
+	authorizations := make([]map[string]interface{}, 0, len(newAuthorizations))
+	var created []map[string]interface{}
+	for _, a := range newAuthorizations {
+		authorization := a.(map[string]interface{})
+		key := piWorkspaceAuthPolicyKey(authorization)
+		if existing, ok := current[key]; ok {
+			delete(current, key)
+			authorizations = append(authorizations, existing)
+			continue
+		}
+		created = append(created, authorization)
+	}
+
+	// the remaining old policies are kept in the state until they are deleted
+	remaining := func() []map[string]interface{} {
+		list := append([]map[string]interface{}{}, authorizations...)
+		for _, authorization := range current {
+			list = append(list, authorization)
+		}
+		return list
+	}
+
+	for _, authorization := range created {
+		policyID, err := createPIWorkspaceAuthPolicy(iampapClient, meta, workspaceID, authorization)
+		if err != nil {
+			return err
+		}
+		authorizations = append(authorizations, map[string]interface{}{
+			"target_service_name":        authorization["target_service_name"].(string),
+			"target_service_instance_id": authorization["target_service_instance_id"].(string),
+			"roles":                      authorization["roles"].([]interface{}),
+			"policy_id":                  policyID,
+		})
+		d.Set(PIWorkspaceAuthPolicies, remaining())
+	}
+
+	for key, authorization := range current {
+		if err := deletePIWorkspaceAuthPolicy(iampapClient, authorization["policy_id"].(string)); err != nil {
+			return err
+		}
+		delete(current, key)
+		d.Set(PIWorkspaceAuthPolicies, remaining())
+	}
+
+	return d.Set(PIWorkspaceAuthPolicies, authorizations)
+}
+
+func piWorkspaceAuthPolicyKey(authorization map[string]interface{}) string {
+	roles := flex.ExpandStringList(authorization["roles"].([]interface{}))
+	sort.Strings(roles)
+	return fmt.Sprintf("%s/%s/%s", authorization["target_service_name"].(string), authorization["target_service_instance_id"].(string), strings.Join(roles, ","))
+}
+
+func createPIWorkspaceAuthPolicy(iampapClient *iampolicymanagementv1.IamPolicyManagementV1, meta interface{}, workspaceID string, authorization map[string]interface{}) (string, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+
+	sourceServiceName := "power-iaas"
+	policyType := "authorization"
+	targetServiceName := authorization["target_service_name"].(string)
+
+	roleList, response, err := iampapClient.ListRoles(&iampolicymanagementv1.ListRolesOptions{
+		ServiceName:       &targetServiceName,
+		SourceServiceName: &sourceServiceName,
+		PolicyType:        &policyType,
+	})
+	if err != nil || roleList == nil {
+		return "", fmt.Errorf("[ERROR] Error listing roles of %s: %s %s", targetServiceName, err, response)
+	}
+	roles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(authorization["roles"].([]interface{})), flex.MapRoleListToPolicyRoles(*roleList))
+	if err != nil {
+		return "", err
+	}
+
+	subject := iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{Name: core.StringPtr("accountId"), Value: &userDetails.UserAccount},
+			{Name: core.StringPtr("serviceName"), Value: &sourceServiceName},
+			{Name: core.StringPtr("serviceInstance"), Value: &workspaceID},
+		},
+	}
+	target := iampolicymanagementv1.PolicyResource{
+		Attributes: []iampolicymanagementv1.ResourceAttribute{
+			{Name: core.StringPtr("accountId"), Value: &userDetails.UserAccount},
+			{Name: core.StringPtr("serviceName"), Value: &targetServiceName},
+		},
+	}
+	if instanceID := authorization["target_service_instance_id"].(string); instanceID != "" {
+		target.Attributes = append(target.Attributes, iampolicymanagementv1.ResourceAttribute{
+			Name:  core.StringPtr("serviceInstance"),
+			Value: &instanceID,
+		})
+	}
+
+	createPolicyOptions := iampapClient.NewCreatePolicyOptions(policyType, []iampolicymanagementv1.PolicySubject{subject}, roles, []iampolicymanagementv1.PolicyResource{target})
+	policy, response, err := iampapClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error creating authorization of workspace %s to %s: %s %s", workspaceID, targetServiceName, err, response)
+	}
+	return *policy.ID, nil
+}
+
+func deletePIWorkspaceAuthPolicy(iampapClient *iampolicymanagementv1.IamPolicyManagementV1, policyID string) error {
+	response, err := iampapClient.DeletePolicy(&iampolicymanagementv1.DeletePolicyOptions{
+		PolicyID: &policyID,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting authorization policy %s: %s %s", policyID, err, response)
+	}
+	return nil
+}
+
+// deletePIWorkspaceAuthPolicies deletes the authorization policies created with the workspace.
+func deletePIWorkspaceAuthPolicies(d *schema.ResourceData, meta interface{}) error {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, a := range d.Get(PIWorkspaceAuthPolicies).([]interface{}) {
+		policyID := a.(map[string]interface{})["policy_id"].(string)
+		if policyID == "" {
+			continue
+		}
+		if err := deletePIWorkspaceAuthPolicy(iampapClient, policyID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func waitForResourceInstanceCreate(ctx context.Context, client *st.IBMPIWorkspacesClient, id string, timeout time.Duration) (interface{}, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"in progress", "inactive", "provisioning"},
@@ -121,16 +330,49 @@ func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 	d.Set(PIWorkspaceName, controller.Name)
+	d.Set(PIWorkspaceCRN, controller.CRN)
+
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, *controller.CRN, "", "user")
+	if err != nil {
+		log.Printf("[DEBUG] Error on get of workspace (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(PIWorkspaceUserTags, tags)
 
 	return nil
 }
 
+func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// on error, only the values set so far are saved, so that a retry applies the remaining changes
+	d.Partial(true)
+	if d.HasChange(PIWorkspaceUserTags) {
+		oldList, newList := d.GetChange(PIWorkspaceUserTags)
+		if err := flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get(PIWorkspaceCRN).(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error on update of workspace (%s) tags: %s", d.Id(), err))
+		}
+		d.Set(PIWorkspaceUserTags, newList)
+	}
+
+	if d.HasChange(PIWorkspaceAuthPolicies) {
+		oldList, newList := d.GetChange(PIWorkspaceAuthPolicies)
+		if err := updatePIWorkspaceAuthPolicies(d, meta, d.Id(), oldList.([]interface{}), newList.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.Partial(false)
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
 func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err = deletePIWorkspaceAuthPolicies(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	cloudInstanceID := d.Id()
 	client := st.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
 	response, err := client.Delete(cloudInstanceID)
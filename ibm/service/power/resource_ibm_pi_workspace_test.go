@@ -33,6 +33,41 @@ func TestAccIBMPIWorkspaceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIWorkspaceTagsAndAuthorizations(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccIBMPIWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceTagsAndAuthorizationsConfig(name, `["env:test"]`, `["Reader"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "crn"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_user_tags.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "pi_authorizations.0.policy_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceTagsAndAuthorizationsConfig(name, `["env:test", "team:power"]`, `["Reader"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_user_tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceTagsAndAuthorizationsConfig(name, `["env:test", "team:power"]`, `["Reader", "Writer"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_authorizations.#", "1"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_authorizations.0.roles.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "pi_authorizations.0.policy_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIWorkspaceConfig(name string) string {
 	return fmt.Sprintf(`
 	 resource "ibm_pi_workspace" "powervs_service_instance" {
@@ -44,6 +79,22 @@ func testAccCheckIBMPIWorkspaceConfig(name string) string {
 	`, name, acc.Pi_resource_group_id)
 }
 
+func testAccCheckIBMPIWorkspaceTagsAndAuthorizationsConfig(name, tags, roles string) string {
+	return fmt.Sprintf(`
+	 resource "ibm_pi_workspace" "powervs_service_instance" {
+		pi_name              = "%[1]s"
+		pi_datacenter        = "dal"
+		pi_resource_group_id = "%[2]s"
+		pi_plan              = "public"
+		pi_user_tags         = %[3]s
+		pi_authorizations {
+			target_service_name = "cloud-object-storage"
+			roles               = %[4]s
+		}
+	  }
+	`, name, acc.Pi_resource_group_id, tags, roles)
+}
+
 func testAccIBMPIWorkspaceDestroy(s *terraform.State) error {
 	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
 	if err != nil {
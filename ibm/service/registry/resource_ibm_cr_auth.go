@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func ResourceIBMCrAuth() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrAuthCreate,
+		ReadContext:   resourceIBMCrAuthRead,
+		UpdateContext: resourceIBMCrAuthUpdate,
+		DeleteContext: resourceIBMCrAuthDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"iam_authz": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable role based authorization when authenticating with IBM Cloud IAM.",
+			},
+			"private_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restrict the account to only be able to push and pull images over private connections.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrAuthCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := resourceIBMCrAuthSet(context, d.Get("iam_authz").(bool), d.Get("private_only").(bool), meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(userDetails.UserAccount)
+
+	return resourceIBMCrAuthRead(context, d, meta)
+}
+
+func resourceIBMCrAuthRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authOptions, response, err := containerRegistryClient.GetAuthWithContext(context, &containerregistryv1.GetAuthOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetAuthWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("iam_authz", authOptions.IamAuthz); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting iam_authz: %s", err))
+	}
+	if err = d.Set("private_only", authOptions.PrivateOnly); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_only: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCrAuthUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("iam_authz", "private_only") {
+		if diags := resourceIBMCrAuthSet(context, d.Get("iam_authz").(bool), d.Get("private_only").(bool), meta); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCrAuthRead(context, d, meta)
+}
+
+func resourceIBMCrAuthDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The auth settings always exist for an account, deleting the resource restores the defaults
+	if diags := resourceIBMCrAuthSet(context, false, false, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMCrAuthSet(context context.Context, iamAuthz, privateOnly bool, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateAuthOptions := &containerregistryv1.UpdateAuthOptions{}
+	updateAuthOptions.SetIamAuthz(iamAuthz)
+	updateAuthOptions.SetPrivateOnly(privateOnly)
+
+	response, err := containerRegistryClient.UpdateAuthWithContext(context, updateAuthOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateAuthWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrAuthBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrAuthConfig(true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cr_auth.cr_auth", "id"),
+					resource.TestCheckResourceAttr("ibm_cr_auth.cr_auth", "iam_authz", "true"),
+					resource.TestCheckResourceAttr("ibm_cr_auth.cr_auth", "private_only", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCrAuthConfig(true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_auth.cr_auth", "iam_authz", "true"),
+					resource.TestCheckResourceAttr("ibm_cr_auth.cr_auth", "private_only", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrAuthConfig(iamAuthz, privateOnly bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_cr_auth" "cr_auth" {
+			iam_authz    = %t
+			private_only = %t
+		}
+	`, iamAuthz, privateOnly)
+}
@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// The user name IBM Cloud Container Registry expects when authenticating with an IAM API key
+const crPullSecretUsername = "iamapikey"
+
+// crRegistryDomains are the public domains of IBM Cloud Container Registry, the private
+// domains are the same names with a "private." prefix.
+var crRegistryDomains = []string{
+	"icr.io",
+	"au.icr.io",
+	"br.icr.io",
+	"ca.icr.io",
+	"de.icr.io",
+	"es.icr.io",
+	"fr2.icr.io",
+	"jp.icr.io",
+	"jp2.icr.io",
+	"uk.icr.io",
+	"us.icr.io",
+}
+
+func ResourceIBMCrPullSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrPullSecretCreate,
+		ReadContext:   resourceIBMCrPullSecretRead,
+		UpdateContext: resourceIBMCrPullSecretUpdate,
+		DeleteContext: resourceIBMCrPullSecretDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the Kubernetes image pull secret.",
+			},
+			"apikey": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The IAM API key, typically of a service ID, that the clusters use to pull images.",
+			},
+			"private_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Generate credentials for the private registry endpoints only.",
+			},
+			"registries": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The registry domains to generate credentials for. Defaults to all IBM Cloud Container Registry domains.",
+			},
+			"cluster": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The clusters to propagate the image pull secret to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"config_file_path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path of the downloaded cluster config, for example the config_file_path of the ibm_container_cluster_config data source.",
+						},
+						"namespaces": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The Kubernetes namespaces to create the image pull secret in.",
+						},
+						"patch_default_service_account": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Add the image pull secret to the default service account of each namespace.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCrPullSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := crPullSecretApply(context, d, d.Get("cluster").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceIBMCrPullSecretRead(context, d, meta)
+}
+
+func resourceIBMCrPullSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusters := d.Get("cluster").([]interface{})
+	found := false
+	for i, c := range clusters {
+		cluster := c.(map[string]interface{})
+		clientset, err := crPullSecretClientset(cluster["config_file_path"].(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		namespaces := []interface{}{}
+		for _, namespace := range cluster["namespaces"].(*schema.Set).List() {
+			_, err := clientset.CoreV1().Secrets(namespace.(string)).Get(context, d.Id(), metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					log.Printf("[DEBUG] Image pull secret %s not found in namespace %s", d.Id(), namespace)
+					continue
+				}
+				return diag.FromErr(fmt.Errorf("[ERROR] Error getting image pull secret %s in namespace %s: %s", d.Id(), namespace, err))
+			}
+			namespaces = append(namespaces, namespace)
+			found = true
+		}
+		cluster["namespaces"] = schema.NewSet(schema.HashString, namespaces)
+		clusters[i] = cluster
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("cluster", clusters); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCrPullSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("cluster") {
+		// remove the secret from the namespaces that are no longer targeted
+		o, n := d.GetChange("cluster")
+		wanted := map[string]map[string]bool{}
+		patched := map[string]bool{}
+		for _, c := range n.([]interface{}) {
+			cluster := c.(map[string]interface{})
+			path := cluster["config_file_path"].(string)
+			if wanted[path] == nil {
+				wanted[path] = map[string]bool{}
+			}
+			patched[path] = patched[path] || cluster["patch_default_service_account"].(bool)
+			for _, namespace := range cluster["namespaces"].(*schema.Set).List() {
+				wanted[path][namespace.(string)] = true
+			}
+		}
+		for _, c := range o.([]interface{}) {
+			cluster := c.(map[string]interface{})
+			path := cluster["config_file_path"].(string)
+			stale := []string{}
+			kept := []string{}
+			for _, namespace := range cluster["namespaces"].(*schema.Set).List() {
+				if !wanted[path][namespace.(string)] {
+					stale = append(stale, namespace.(string))
+				} else {
+					kept = append(kept, namespace.(string))
+				}
+			}
+			if len(stale) > 0 {
+				if err := crPullSecretRemove(context, d.Id(), path, stale, cluster["patch_default_service_account"].(bool)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			// the default service accounts of the kept namespaces are no longer patched
+			if cluster["patch_default_service_account"].(bool) && !patched[path] && len(kept) > 0 {
+				clientset, err := crPullSecretClientset(path)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				for _, namespace := range kept {
+					if err := crPullSecretUnpatchServiceAccount(context, clientset, namespace, d.Id()); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+			}
+		}
+	}
+
+	if d.HasChanges("apikey", "private_only", "registries", "cluster") {
+		if err := crPullSecretApply(context, d, d.Get("cluster").([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCrPullSecretRead(context, d, meta)
+}
+
+func resourceIBMCrPullSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	for _, c := range d.Get("cluster").([]interface{}) {
+		cluster := c.(map[string]interface{})
+		namespaces := flex.ExpandStringList(cluster["namespaces"].(*schema.Set).List())
+		if err := crPullSecretRemove(context, d.Id(), cluster["config_file_path"].(string), namespaces, cluster["patch_default_service_account"].(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func crPullSecretClientset(configFilePath string) (*kubernetes.Clientset, error) {
+	config, err := clientcmd.BuildConfigFromFlags("", configFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid kubeconfig %s, failed to set context: %s", configFilePath, err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid kubeconfig %s, failed to create clientset: %s", configFilePath, err)
+	}
+	return clientset, nil
+}
+
+// crPullSecretRegistries returns the configured registry domains, or every IBM Cloud
+// Container Registry domain (public or private) when none are configured.
+func crPullSecretRegistries(d *schema.ResourceData) []string {
+	if v, ok := d.GetOk("registries"); ok {
+		return flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	registries := make([]string, 0, len(crRegistryDomains))
+	for _, domain := range crRegistryDomains {
+		if d.Get("private_only").(bool) {
+			domain = "private." + domain
+		}
+		registries = append(registries, domain)
+	}
+	return registries
+}
+
+// crPullSecretDockerConfig builds the .dockerconfigjson payload of the image pull secret
+func crPullSecretDockerConfig(registries []string, apikey string) ([]byte, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(crPullSecretUsername + ":" + apikey))
+	auths := map[string]interface{}{}
+	for _, registry := range registries {
+		auths[registry] = map[string]string{
+			"username": crPullSecretUsername,
+			"password": apikey,
+			"auth":     auth,
+		}
+	}
+	return json.Marshal(map[string]interface{}{"auths": auths})
+}
+
+func crPullSecretApply(context context.Context, d *schema.ResourceData, clusters []interface{}) error {
+	dockerConfig, err := crPullSecretDockerConfig(crPullSecretRegistries(d), d.Get("apikey").(string))
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	for _, c := range clusters {
+		cluster := c.(map[string]interface{})
+		clientset, err := crPullSecretClientset(cluster["config_file_path"].(string))
+		if err != nil {
+			return err
+		}
+		for _, namespace := range cluster["namespaces"].(*schema.Set).List() {
+			secret := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace.(string),
+				},
+				Type: v1.SecretTypeDockerConfigJson,
+				Data: map[string][]byte{
+					v1.DockerConfigJsonKey: dockerConfig,
+				},
+			}
+			secrets := clientset.CoreV1().Secrets(namespace.(string))
+			_, err := secrets.Create(context, secret, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				_, err = secrets.Update(context, secret, metav1.UpdateOptions{})
+			}
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error creating image pull secret %s in namespace %s: %s", name, namespace, err)
+			}
+			if cluster["patch_default_service_account"].(bool) {
+				if err := crPullSecretPatchServiceAccount(context, clientset, namespace.(string), name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func crPullSecretPatchServiceAccount(context context.Context, clientset *kubernetes.Clientset, namespace, name string) error {
+	serviceAccounts := clientset.CoreV1().ServiceAccounts(namespace)
+	serviceAccount, err := serviceAccounts.Get(context, "default", metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default service account in namespace %s: %s", namespace, err)
+	}
+	for _, ref := range serviceAccount.ImagePullSecrets {
+		if ref.Name == name {
+			return nil
+		}
+	}
+	pullSecrets := append(serviceAccount.ImagePullSecrets, v1.LocalObjectReference{Name: name})
+	patch, err := json.Marshal(map[string]interface{}{"imagePullSecrets": pullSecrets})
+	if err != nil {
+		return err
+	}
+	_, err = serviceAccounts.Patch(context, "default", types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error patching default service account in namespace %s: %s", namespace, err)
+	}
+	return nil
+}
+
+// crPullSecretUnpatchServiceAccount removes the image pull secret from the default service account
+// of the namespace, if it is there.
+func crPullSecretUnpatchServiceAccount(context context.Context, clientset *kubernetes.Clientset, namespace, name string) error {
+	serviceAccounts := clientset.CoreV1().ServiceAccounts(namespace)
+	serviceAccount, err := serviceAccounts.Get(context, "default", metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting default service account in namespace %s: %s", namespace, err)
+	}
+	pullSecrets := []v1.LocalObjectReference{}
+	for _, ref := range serviceAccount.ImagePullSecrets {
+		if ref.Name != name {
+			pullSecrets = append(pullSecrets, ref)
+		}
+	}
+	if len(pullSecrets) != len(serviceAccount.ImagePullSecrets) {
+		serviceAccount.ImagePullSecrets = pullSecrets
+		if _, err := serviceAccounts.Update(context, serviceAccount, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("[ERROR] Error updating default service account in namespace %s: %s", namespace, err)
+		}
+	}
+	return nil
+}
+
+func crPullSecretRemove(context context.Context, name, configFilePath string, namespaces []string, patchDefaultServiceAccount bool) error {
+	clientset, err := crPullSecretClientset(configFilePath)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		if patchDefaultServiceAccount {
+			if err := crPullSecretUnpatchServiceAccount(context, clientset, namespace, name); err != nil {
+				return err
+			}
+		}
+		err := clientset.CoreV1().Secrets(namespace).Delete(context, name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("[ERROR] Error deleting image pull secret %s in namespace %s: %s", name, namespace, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestAccIBMCrPullSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pull-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrPullSecretConfig(name, false, "default", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "private_only", "false"),
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "cluster.0.namespaces.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCrPullSecretConfig(name, true, "kube-system", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "private_only", "true"),
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "cluster.0.namespaces.#", "2"),
+					testAccCheckIBMCrPullSecretServiceAccount(name, "kube-system", true),
+				),
+			},
+			{
+				// turning patch_default_service_account off removes the secret from the service accounts
+				Config: testAccCheckIBMCrPullSecretConfig(name, true, "kube-system", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_pull_secret.cr_pull_secret", "cluster.0.patch_default_service_account", "false"),
+					testAccCheckIBMCrPullSecretServiceAccount(name, "kube-system", false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrPullSecretConfig(name string, privateOnly bool, namespace string, patchDefaultServiceAccount bool) string {
+	return fmt.Sprintf(`
+		data "ibm_container_cluster_config" "cluster_config" {
+			cluster_name_id = "%s"
+		}
+
+		resource "ibm_iam_service_id" "service_id" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_service_api_key" "api_key" {
+			name           = "%s"
+			iam_service_id = ibm_iam_service_id.service_id.iam_id
+		}
+
+		resource "ibm_cr_pull_secret" "cr_pull_secret" {
+			name         = "%s"
+			apikey       = ibm_iam_service_api_key.api_key.apikey
+			private_only = %t
+			cluster {
+				config_file_path = data.ibm_container_cluster_config.cluster_config.config_file_path
+				namespaces       = distinct(["default", "%s"])
+
+				patch_default_service_account = %t
+			}
+		}
+	`, acc.ClusterName, name, name, name, privateOnly, namespace, patchDefaultServiceAccount)
+}
+
+func testAccCheckIBMCrPullSecretServiceAccount(name, namespace string, patched bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["data.ibm_container_cluster_config.cluster_config"]
+		if !ok {
+			return fmt.Errorf("Not found: data.ibm_container_cluster_config.cluster_config")
+		}
+		config, err := clientcmd.BuildConfigFromFlags("", rs.Primary.Attributes["config_file_path"])
+		if err != nil {
+			return err
+		}
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return err
+		}
+		serviceAccount, err := clientset.CoreV1().ServiceAccounts(namespace).Get(context.Background(), "default", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, ref := range serviceAccount.ImagePullSecrets {
+			if ref.Name == name {
+				found = true
+			}
+		}
+		if found != patched {
+			return fmt.Errorf("image pull secret %s in the default service account of namespace %s: %t, expected %t", name, namespace, found, patched)
+		}
+		return nil
+	}
+}
@@ -72,6 +72,12 @@ func DataSourceIBMResourceKey() *schema.Resource {
 				Computed:    true,
 			},
 
+			"cos_hmac_keys": resourceKeyCosHmacKeysSchema(),
+
+			"cloudant": resourceKeyCloudantSchema(),
+
+			"event_streams": resourceKeyEventStreamsSchema(),
+
 			"most_recent": {
 				Description: "If true and multiple entries are found, the most recently created resource key is used. " +
 					"If false, an error is returned",
@@ -170,6 +176,9 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	if err = d.Set("credentials_json", string(creds)); err != nil {
 		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
 	}
+	if err = resourceKeySetTypedCredentials(d, key.Crn.ServiceName, key.Credentials); err != nil {
+		return err
+	}
 	d.Set("status", key.State)
 	d.Set("crn", key.Crn.String())
 	return nil
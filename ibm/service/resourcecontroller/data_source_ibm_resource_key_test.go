@@ -29,6 +29,7 @@ func TestAccIBMResourceKeyDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key", "role", "Writer"),
 					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key1", "name", resourceKey),
 					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key1", "credentials.%", "7"),
+					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key1", "cos_hmac_keys.#", "0"),
 					resource.TestCheckResourceAttr("data.ibm_resource_key.testacc_ds_resource_key1", "role", "Writer"),
 				),
 			},
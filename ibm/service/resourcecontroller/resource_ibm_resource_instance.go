@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -229,8 +230,9 @@ func ResourceIBMResourceInstance() *schema.Resource {
 			},
 
 			"locked": {
-				Description: "A boolean that dictates if the resource instance should be deleted (cleaned up) during the processing of a region instance delete call.",
+				Description: "A boolean that indicates the resource instance is locked, a locked instance can't be updated or deleted until it is unlocked.",
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 			},
 
@@ -529,6 +531,13 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.Get("locked").(bool) {
+		err = setResourceInstanceLock(rsConClient, d.Id(), true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return ResourceIBMResourceInstanceRead(d, meta)
 }
 func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -660,6 +669,14 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	instanceID := d.Id()
 
+	// A locked instance rejects any other update, unlock it first
+	if d.HasChange("locked") && !d.Get("locked").(bool) {
+		err = setResourceInstanceLock(rsConClient, instanceID, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	resourceInstanceUpdate := rc.UpdateResourceInstanceOptions{
 		ID: &instanceID,
 	}
@@ -766,14 +783,23 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+	if d.HasChangeExcept("locked") {
+		_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+		}
+
+		_, err = waitForResourceInstanceUpdate(d, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		}
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+	if d.HasChange("locked") && d.Get("locked").(bool) {
+		err = setResourceInstanceLock(rsConClient, instanceID, true)
+		if err != nil {
+			return err
+		}
 	}
 
 	return ResourceIBMResourceInstanceRead(d, meta)
@@ -791,6 +817,10 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		Recursive: &recursive,
 	}
 
+	if d.Get("locked").(bool) {
+		return fmt.Errorf("[ERROR] Resource instance (%s) is locked, set locked to false and apply before deleting it", id)
+	}
+
 	resp, error := rsConClient.DeleteResourceInstance(&resourceInstanceDelete)
 	if error != nil {
 		if resp != nil && resp.StatusCode == 410 {
@@ -834,6 +864,24 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
+func setResourceInstanceLock(rsConClient *rc.ResourceControllerV2, instanceID string, lock bool) error {
+	var resp *core.DetailedResponse
+	var err error
+	if lock {
+		_, resp, err = rsConClient.LockResourceInstance(&rc.LockResourceInstanceOptions{
+			ID: &instanceID,
+		})
+	} else {
+		_, resp, err = rsConClient.UnlockResourceInstance(&rc.UnlockResourceInstanceOptions{
+			ID: &instanceID,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting lock to %t on resource instance (%s): %s with resp code: %s", lock, instanceID, err, resp)
+	}
+	return nil
+}
+
 func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -123,6 +123,38 @@ func TestAccIBMResourceInstanceWithServiceendpoints(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceLocked(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceLocked(serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMResourceInstanceLocked(serviceName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("is locked"),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceLocked(serviceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceInstanceWithResourceGroup(t *testing.T) {
 	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceName := "ibm_resource_instance.instance"
@@ -381,6 +413,18 @@ func testAccCheckIBMResourceInstanceNewServiceType(updateName string) string {
 	`, updateName)
 }
 
+func testAccCheckIBMResourceInstanceLocked(serviceName string, locked bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+		locked   = %t
+	}
+	`, serviceName, locked)
+}
+
 func testAccCheckIBMResourceInstanceWithResourceGroup(serviceName string) string {
 	return fmt.Sprintf(`
 
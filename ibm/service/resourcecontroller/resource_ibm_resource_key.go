@@ -87,6 +87,9 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Sensitive:   true,
 				Computed:    true,
 			},
+			"cos_hmac_keys": resourceKeyCosHmacKeysSchema(),
+			"cloudant":      resourceKeyCloudantSchema(),
+			"event_streams": resourceKeyEventStreamsSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -314,6 +317,15 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	if err = d.Set("credentials_json", string(creds)); err != nil {
 		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
 	}
+	var serviceName string
+	if resourceKey.CRN != nil {
+		if crnParts := strings.Split(*resourceKey.CRN, ":"); len(crnParts) > 4 {
+			serviceName = crnParts[4]
+		}
+	}
+	if err = resourceKeySetTypedCredentials(d, serviceName, credInterface); err != nil {
+		return err
+	}
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
 	if resourceKey.Credentials != nil && resourceKey.Credentials.Redacted != nil {
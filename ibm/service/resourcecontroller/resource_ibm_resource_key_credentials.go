@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Service names, as found in the key CRN, of the services whose credentials are decoded into typed attributes
+const (
+	resourceKeyServiceCOS          = "cloud-object-storage"
+	resourceKeyServiceCloudant     = "cloudantnosqldb"
+	resourceKeyServiceEventStreams = "messagehub"
+)
+
+func resourceKeyCosHmacKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "HMAC keys of Cloud Object Storage credentials created with the HMAC parameter",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The HMAC access key ID",
+				},
+				"secret_access_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The HMAC secret access key",
+				},
+			},
+		},
+	}
+}
+
+func resourceKeyCloudantSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "Connection details of Cloudant credentials",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The URL of the Cloudant instance",
+				},
+				"host": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The host name of the Cloudant instance",
+				},
+				"apikey": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The IAM API key",
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The legacy user name",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The legacy password",
+				},
+			},
+		},
+	}
+}
+
+func resourceKeyEventStreamsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "Connection details of Event Streams credentials",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kafka_brokers_sasl": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The Kafka bootstrap brokers",
+				},
+				"kafka_admin_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The URL of the administration REST API",
+				},
+				"kafka_http_url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The URL of the Kafka HTTP endpoint",
+				},
+				"api_key": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The API key, used as the SASL password",
+				},
+				"user": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The SASL user name",
+				},
+			},
+		},
+	}
+}
+
+// resourceKeySetTypedCredentials decodes the credentials of the services with a well known credential shape
+// into their typed attributes, the attributes of the other services are left empty.
+func resourceKeySetTypedCredentials(d *schema.ResourceData, serviceName string, credentials map[string]interface{}) error {
+	cosHmacKeys := []map[string]interface{}{}
+	cloudant := []map[string]interface{}{}
+	eventStreams := []map[string]interface{}{}
+
+	switch serviceName {
+	case resourceKeyServiceCOS:
+		if hmac, ok := credentials["cos_hmac_keys"].(map[string]interface{}); ok {
+			cosHmacKeys = append(cosHmacKeys, map[string]interface{}{
+				"access_key_id":     resourceKeyCredentialString(hmac, "access_key_id"),
+				"secret_access_key": resourceKeyCredentialString(hmac, "secret_access_key"),
+			})
+		}
+	case resourceKeyServiceCloudant:
+		if _, ok := credentials["url"]; ok {
+			cloudant = append(cloudant, map[string]interface{}{
+				"url":      resourceKeyCredentialString(credentials, "url"),
+				"host":     resourceKeyCredentialString(credentials, "host"),
+				"apikey":   resourceKeyCredentialString(credentials, "apikey"),
+				"username": resourceKeyCredentialString(credentials, "username"),
+				"password": resourceKeyCredentialString(credentials, "password"),
+			})
+		}
+	case resourceKeyServiceEventStreams:
+		if brokers, ok := credentials["kafka_brokers_sasl"].([]interface{}); ok {
+			kafkaBrokers := make([]string, 0, len(brokers))
+			for _, broker := range brokers {
+				if b, ok := broker.(string); ok {
+					kafkaBrokers = append(kafkaBrokers, b)
+				}
+			}
+			eventStreams = append(eventStreams, map[string]interface{}{
+				"kafka_brokers_sasl": kafkaBrokers,
+				"kafka_admin_url":    resourceKeyCredentialString(credentials, "kafka_admin_url"),
+				"kafka_http_url":     resourceKeyCredentialString(credentials, "kafka_http_url"),
+				"api_key":            resourceKeyCredentialString(credentials, "api_key"),
+				"user":               resourceKeyCredentialString(credentials, "user"),
+			})
+		}
+	}
+
+	if err := d.Set("cos_hmac_keys", cosHmacKeys); err != nil {
+		return fmt.Errorf("[ERROR] Error setting cos_hmac_keys: %s", err)
+	}
+	if err := d.Set("cloudant", cloudant); err != nil {
+		return fmt.Errorf("[ERROR] Error setting cloudant: %s", err)
+	}
+	if err := d.Set("event_streams", eventStreams); err != nil {
+		return fmt.Errorf("[ERROR] Error setting event_streams: %s", err)
+	}
+	return nil
+}
+
+func resourceKeyCredentialString(credentials map[string]interface{}, key string) string {
+	if v, ok := credentials[key].(string); ok {
+		return v
+	}
+	return ""
+}
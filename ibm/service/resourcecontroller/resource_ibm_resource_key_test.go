@@ -92,6 +92,10 @@ func TestAccIBMResourceKey_Parameters(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "name", resourceKey),
 					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "role", "Manager"),
 					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "credentials.%"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "cos_hmac_keys.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "cos_hmac_keys.0.access_key_id"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "cos_hmac_keys.0.secret_access_key"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "event_streams.#", "0"),
 				),
 			},
 		},
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,8 +43,11 @@ func ResourceIbmSccProviderTypeInstance() *schema.Resource {
 				Description:  "The name of the provider type instance.",
 			},
 			"attributes": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The attributes for connecting to the provider type, for example the CRN of a Workload Protection instance or the credentials of a third-party provider.",
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -107,6 +112,10 @@ func resourceIbmSccProviderTypeInstanceCreate(context context.Context, d *schema
 	createProviderTypeInstanceOptions.SetProviderTypeID(d.Get("provider_type_id").(string))
 	createProviderTypeInstanceOptions.SetName(d.Get("name").(string))
 	createProviderTypeInstanceOptions.SetInstanceID(instanceID)
+	err = resourceIbmSccProviderTypeInstanceValidateAttributes(context, securityAndComplianceCenterApIsClient, d.Get("provider_type_id").(string), d.Get("attributes").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	attributesModel, err := resourceIbmSccProviderTypeInstanceMapToProviderTypeInstanceAttributes(d.Get("attributes").(map[string]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -164,6 +173,18 @@ func resourceIbmSccProviderTypeInstanceRead(context context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	// the values of secret attributes aren't returned, keep the configured ones
+	providerTypeAttributes, err := getSccProviderTypeAttributes(context, securityAndComplianceCenterApIsClient, parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for key, value := range d.Get("attributes").(map[string]interface{}) {
+		if attribute, ok := providerTypeAttributes[key]; ok && attribute.Type != nil &&
+			(*attribute.Type == securityandcompliancecenterapiv3.AdditionalProperty_Type_Secret || *attribute.Type == securityandcompliancecenterapiv3.AdditionalProperty_Type_Masked) {
+			attributesMap[key] = value
+		}
+	}
+
 	if err = d.Set("attributes", attributesMap); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting attributes: %s", err))
 	}
@@ -220,6 +241,10 @@ func resourceIbmSccProviderTypeInstanceUpdate(context context.Context, d *schema
 			" The resource must be re-created to update this property.", "provider_type_id"))
 	}
 	if d.HasChange("attributes") {
+		err = resourceIbmSccProviderTypeInstanceValidateAttributes(context, securityAndComplianceCenterApIsClient, parts[1], d.Get("attributes").(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		updateProviderTypeInstanceOptions.SetAttributes(d.Get("attributes").(map[string]interface{}))
 		hasChange = true
 	}
@@ -267,6 +292,43 @@ func resourceIbmSccProviderTypeInstanceDelete(context context.Context, d *schema
 	return nil
 }
 
+// resourceIbmSccProviderTypeInstanceValidateAttributes checks the attribute names against the
+// provider type before they are sent, so a misspelled attribute lists the supported ones.
+func resourceIbmSccProviderTypeInstanceValidateAttributes(context context.Context, securityAndComplianceCenterApIsClient *securityandcompliancecenterapiv3.SecurityAndComplianceCenterApiV3, providerTypeID string, attributes map[string]interface{}) error {
+	if providerTypeID == "" || len(attributes) == 0 {
+		return nil
+	}
+	providerTypeAttributes, err := getSccProviderTypeAttributes(context, securityAndComplianceCenterApIsClient, providerTypeID)
+	if err != nil {
+		return err
+	}
+
+	for key := range attributes {
+		if _, ok := providerTypeAttributes[key]; !ok {
+			supported := make([]string, 0, len(providerTypeAttributes))
+			for name := range providerTypeAttributes {
+				supported = append(supported, name)
+			}
+			sort.Strings(supported)
+			return fmt.Errorf("attribute %q isn't supported by the provider type %s, supported attributes are: %s",
+				key, providerTypeID, strings.Join(supported, ", "))
+		}
+	}
+	return nil
+}
+
+func getSccProviderTypeAttributes(context context.Context, securityAndComplianceCenterApIsClient *securityandcompliancecenterapiv3.SecurityAndComplianceCenterApiV3, providerTypeID string) (map[string]securityandcompliancecenterapiv3.AdditionalProperty, error) {
+	getProviderTypeByIdOptions := &securityandcompliancecenterapiv3.GetProviderTypeByIdOptions{}
+	getProviderTypeByIdOptions.SetProviderTypeID(providerTypeID)
+
+	providerTypeItem, response, err := securityAndComplianceCenterApIsClient.GetProviderTypeByIDWithContext(context, getProviderTypeByIdOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetProviderTypeByIDWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetProviderTypeByIDWithContext failed %s\n%s", err, response)
+	}
+	return providerTypeItem.Attributes, nil
+}
+
 func resourceIbmSccProviderTypeInstanceMapToProviderTypeInstanceAttributes(modelMap map[string]interface{}) (map[string]interface{}, error) {
 	return modelMap, nil
 }
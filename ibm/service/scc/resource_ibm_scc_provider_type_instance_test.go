@@ -5,6 +5,7 @@ package scc_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -76,6 +77,21 @@ func TestAccIbmSccProviderTypeInstanceAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSccProviderTypeInstanceUnsupportedAttribute(t *testing.T) {
+	name := fmt.Sprintf("tf_provider_type_instance_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckScc(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmSccProviderTypeInstanceConfigBasic(acc.SccInstanceID, name, `{"wp_crm" = "crn:v1:bluemix:public:sysdig-secure:us-south:a/0000::"}`),
+				ExpectError: regexp.MustCompile(`attribute "wp_crm" isn't supported by the provider type`),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSccProviderTypeInstanceConfigBasic(instanceID string, name string, attributes string) string {
 	return fmt.Sprintf(`
 		resource "ibm_scc_provider_type_instance" "scc_provider_type_instance_wlp" {
@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -28,6 +30,11 @@ func ResourceIBMSchematicsJob() *schema.Resource {
 		DeleteContext: resourceIBMSchematicsJobDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"command_object": {
 				Type:         schema.TypeString,
@@ -295,6 +302,12 @@ func ResourceIBMSchematicsJob() *schema.Resource {
 				Description: "User defined tags, while running the job.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the job to finish when it is created or rerun. The operation fails if the job does not finish successfully.",
+			},
 			"location": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -2944,9 +2957,67 @@ func resourceIBMSchematicsJobCreate(context context.Context, d *schema.ResourceD
 
 	d.SetId(*job.ID)
 
+	if d.Get("wait_for_completion").(bool) {
+		_, err = isWaitForJobCompleted(context, schematicsClient, *job.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMSchematicsJobRead(context, d, meta)
 }
 
+func isWaitForJobCompleted(context context.Context, schematicsClient *schematicsv1.SchematicsV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for job (%s) to complete.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry"},
+		Target:     []string{schematicsv1.JobStatusAction_StatusCode_JobFinished},
+		Refresh:    jobRefreshFunc(schematicsClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func jobRefreshFunc(schematicsClient *schematicsv1.SchematicsV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getJobOptions := &schematicsv1.GetJobOptions{
+			JobID: core.StringPtr(id),
+		}
+
+		job, response, err := schematicsClient.GetJob(getJobOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Job: %s\n%s", err, response)
+		}
+
+		statusCode, statusMessage := "", ""
+		if job.Status != nil {
+			if job.Status.ActionJobStatus != nil {
+				statusCode = core.StringNilMapper(job.Status.ActionJobStatus.StatusCode)
+				statusMessage = core.StringNilMapper(job.Status.ActionJobStatus.StatusMessage)
+			} else if job.Status.WorkspaceJobStatus != nil {
+				statusCode = core.StringNilMapper(job.Status.WorkspaceJobStatus.StatusCode)
+				statusMessage = core.StringNilMapper(job.Status.WorkspaceJobStatus.StatusMessage)
+			} else if job.Status.SystemJobStatus != nil {
+				statusCode = core.StringNilMapper(job.Status.SystemJobStatus.SystemStatusCode)
+				statusMessage = core.StringNilMapper(job.Status.SystemJobStatus.SystemStatusMessage)
+			} else if job.Status.FlowJobStatus != nil {
+				statusCode = core.StringNilMapper(job.Status.FlowJobStatus.StatusCode)
+				statusMessage = core.StringNilMapper(job.Status.FlowJobStatus.StatusMessage)
+			}
+		}
+
+		switch statusCode {
+		case schematicsv1.JobStatusAction_StatusCode_JobFinished:
+			return job, statusCode, nil
+		case schematicsv1.JobStatusAction_StatusCode_JobFailed, schematicsv1.JobStatusAction_StatusCode_JobCancelled, schematicsv1.JobStatusAction_StatusCode_JobStopped:
+			return job, statusCode, fmt.Errorf("[ERROR] Job (%s) did not complete, status %s: %s", id, statusCode, statusMessage)
+		}
+		return job, "retry", nil
+	}
+}
+
 func resourceIBMSchematicsJobMapToVariableData(variableDataMap map[string]interface{}) schematicsv1.VariableData {
 	variableData := schematicsv1.VariableData{}
 
@@ -4969,6 +5040,10 @@ func resourceIBMSchematicsJobJobLogSummarySystemJobToMap(jobLogSummarySystemJob
 }
 
 func resourceIBMSchematicsJobUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChangesExcept("wait_for_completion") {
+		return nil
+	}
+
 	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
 	if err != nil {
 		return diag.FromErr(err)
@@ -5065,6 +5140,13 @@ func resourceIBMSchematicsJobUpdate(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("UpdateJobWithContext failed %s\n%s", err, response))
 	}
 
+	if d.Get("wait_for_completion").(bool) {
+		_, err = isWaitForJobCompleted(context, schematicsClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMSchematicsJobRead(context, d, meta)
 }
 
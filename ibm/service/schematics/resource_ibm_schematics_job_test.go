@@ -55,6 +55,26 @@ func TestAccIBMSchematicsJobBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMSchematicsJobWaitForCompletion(t *testing.T) {
+	var conf schematicsv1.Job
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsJobWaitForCompletionConfig("action", acc.ActionID, "ansible_playbook_run", "ssh_user.yml"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsJobExists("ibm_schematics_job.schematics_job", conf),
+					resource.TestCheckResourceAttr("ibm_schematics_job.schematics_job", "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr("ibm_schematics_job.schematics_job", "status.0.action_job_status.0.status_code", "job_finished"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMSchematicsJobConfig(commandObject string, commandObjectID string, commandName string, commandParameter string) string {
 	return fmt.Sprintf(`
 
@@ -68,6 +88,20 @@ func testAccCheckIBMSchematicsJobConfig(commandObject string, commandObjectID st
 	`, commandObject, commandObjectID, commandName, commandParameter)
 }
 
+func testAccCheckIBMSchematicsJobWaitForCompletionConfig(commandObject string, commandObjectID string, commandName string, commandParameter string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_job" "schematics_job" {
+			command_object = "%s"
+			command_object_id = "%s"
+			command_name = "%s"
+			command_parameter = "%s"
+			location = "us"
+			wait_for_completion = true
+		}
+	`, commandObject, commandObjectID, commandName, commandParameter)
+}
+
 func testAccCheckIBMSchematicsJobExists(n string, obj schematicsv1.Job) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -27,6 +29,9 @@ const (
 	schematicsWorkspaceTemplateType = "template_type"
 )
 
+// schematicsWorkspaceSecretCRNRegexp matches the CRN of a Secrets Manager secret
+var schematicsWorkspaceSecretCRNRegexp = regexp.MustCompile(`^crn:v1:[^:]+:[^:]*:secrets-manager:[^:]*:[^:]+:[^:]+:secret:[^:]+$`)
+
 func ResourceIBMSchematicsWorkspace() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMSchematicsWorkspaceCreate,
@@ -35,6 +40,12 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 		DeleteContext: resourceIBMSchematicsWorkspaceDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMSchematicsWorkspaceTemplateInputsDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"applied_shareddata_ids": {
 				Type:        schema.TypeList,
@@ -368,9 +379,15 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 						},
 						"value": {
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
 							Description: "Enter the value as a string for the primitive types such as `bool`, `number`, `string`, and `HCL` format for the complex variables, as you provide in a `.tfvars` file. **You need to enter escaped string of `HCL` format for the complex variable value**. For more information, about how to declare variables in a terraform configuration file and provide value to schematics, see [Providing values for the declared variables](/docs/schematics?topic=schematics-create-tf-config#declare-variable).",
 						},
+						"secret_crn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(schematicsWorkspaceSecretCRNRegexp, "must be the CRN of a Secrets Manager secret"),
+							Description:  "The CRN of the Secrets Manager secret holding the value of the variable, resolved by Schematics when the template runs. The variable is sent as secure, and the secret content is never stored in the workspace definition or in the state. Conflicts with `value`.",
+						},
 					},
 				},
 			},
@@ -968,6 +985,10 @@ func resourceIBMSchematicsWorkspaceMapToWorkspaceVariableRequest(workspaceVariab
 	if workspaceVariableRequestMap["value"] != nil {
 		workspaceVariableRequest.Value = core.StringPtr(workspaceVariableRequestMap["value"].(string))
 	}
+	if secretCRN, ok := workspaceVariableRequestMap["secret_crn"].(string); ok && secretCRN != "" {
+		workspaceVariableRequest.Value = core.StringPtr(secretCRN)
+		workspaceVariableRequest.Secure = core.BoolPtr(true)
+	}
 
 	return workspaceVariableRequest
 }
@@ -1161,6 +1182,9 @@ func resourceIBMSchematicsWorkspaceRead(context context.Context, d *schema.Resou
 		if err = d.Set("template_values_metadata", templateData[0]["values_metadata"]); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error reading values_metadata: %s", err))
 		}
+		if variablestore, ok := templateData[0]["variablestore"].([]map[string]interface{}); ok {
+			resourceIBMSchematicsWorkspaceKeepSecretRefs(d, variablestore)
+		}
 		if err = d.Set("template_inputs", templateData[0]["variablestore"]); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error reading variablestore: %s", err))
 		}
@@ -1492,6 +1516,55 @@ func resourceIBMSchematicsWorkspaceWorkspaceVariableResponseToMap(workspaceVaria
 	return workspaceVariableRequestMap
 }
 
+// resourceIBMSchematicsWorkspaceKeepSecretRefs sets back the secret_crn of the variables declared with a Secrets
+// Manager reference, their value is the reference and not a value of the workspace.
+func resourceIBMSchematicsWorkspaceKeepSecretRefs(d *schema.ResourceData, variablestore []map[string]interface{}) {
+	secretCRNs := map[string]string{}
+	for _, input := range d.Get("template_inputs").([]interface{}) {
+		if inputMap, ok := input.(map[string]interface{}); ok && inputMap["secret_crn"].(string) != "" {
+			secretCRNs[inputMap["name"].(string)] = inputMap["secret_crn"].(string)
+		}
+	}
+	for _, variable := range variablestore {
+		name, ok := variable["name"].(*string)
+		if !ok || name == nil {
+			continue
+		}
+		if secretCRN, ok := secretCRNs[*name]; ok {
+			variable["secret_crn"] = secretCRN
+			variable["value"] = ""
+		}
+	}
+}
+
+// resourceIBMSchematicsWorkspaceTemplateInputsDiff checks that each of the template_inputs is given either a value
+// or a secret_crn.
+func resourceIBMSchematicsWorkspaceTemplateInputsDiff(diff *schema.ResourceDiff) error {
+	rawInputs := diff.GetRawConfig().GetAttr("template_inputs")
+	if !rawInputs.IsKnown() || rawInputs.IsNull() {
+		return nil
+	}
+	for it := rawInputs.ElementIterator(); it.Next(); {
+		_, input := it.Element()
+		if !input.IsKnown() || input.IsNull() {
+			continue
+		}
+		name := "unknown"
+		if rawName := input.GetAttr("name"); rawName.IsKnown() && !rawName.IsNull() {
+			name = rawName.AsString()
+		}
+		hasValue := !input.GetAttr("value").IsNull()
+		hasSecretCRN := !input.GetAttr("secret_crn").IsNull()
+		if hasValue && hasSecretCRN {
+			return fmt.Errorf("[ERROR] template_inputs %s: value conflicts with secret_crn", name)
+		}
+		if !hasValue && !hasSecretCRN {
+			return fmt.Errorf("[ERROR] template_inputs %s: one of value or secret_crn must be set", name)
+		}
+	}
+	return nil
+}
+
 func resourceIBMSchematicsWorkspaceTemplateRepoRequestToMap(templateRepoRequest schematicsv1.TemplateRepoRequest) map[string]interface{} {
 	templateRepoRequestMap := map[string]interface{}{}
 
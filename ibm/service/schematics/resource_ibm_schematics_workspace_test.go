@@ -5,6 +5,7 @@ package schematics_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -109,6 +110,32 @@ func TestAccIBMSchematicsWorkspaceAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMSchematicsWorkspaceSecretRef(t *testing.T) {
+	var conf schematicsv1.WorkspaceResponse
+	description := fmt.Sprintf("tf-acc-test-schematics-secret-ref_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-acc-test-schematics_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSchematicsWorkspaceConfigSecretRef(description, name, ""),
+				ExpectError: regexp.MustCompile("one of value or secret_crn must be set"),
+			},
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceConfigSecretRef(description, name, acc.SecretCRN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsWorkspaceExists("ibm_schematics_workspace.schematics_workspace", conf),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.schematics_workspace", "template_inputs.0.secret_crn", acc.SecretCRN),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.schematics_workspace", "template_inputs.0.value", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMSchematicsWorkspaceConfigBasic() string {
 	return `
 
@@ -192,6 +219,28 @@ func testAccCheckIBMSchematicsWorkspaceConfigUpdateRepoBranch(description string
 	`, description, name, repoURL, repoBranch)
 }
 
+func testAccCheckIBMSchematicsWorkspaceConfigSecretRef(description string, name string, secretCRN string) string {
+	secretRef := ""
+	if secretCRN != "" {
+		secretRef = fmt.Sprintf("secret_crn = \"%s\"", secretCRN)
+	}
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_workspace" "schematics_workspace" {
+			description = "%s"
+			location = "us-east"
+			name = "%s"
+			resource_group = "default"
+			template_type = "terraform_v0.13.5"
+			template_inputs {
+				name = "api_key"
+				type = "string"
+				%s
+			  }
+		}
+	`, description, name, secretRef)
+}
+
 func testAccCheckIBMSchematicsWorkspaceExists(n string, obj schematicsv1.WorkspaceResponse) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
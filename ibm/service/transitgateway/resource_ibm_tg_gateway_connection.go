@@ -4,11 +4,13 @@
 package transitgateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -40,6 +42,8 @@ const (
 	tgRemoteTunnelIp                    = "remote_tunnel_ip"
 	tgZone                              = "zone"
 	tgMtu                               = "mtu"
+	tgNetworkTypeGreTunnel              = "gre_tunnel"
+	tgNetworkTypeUnboundGreTunnel       = "unbound_gre_tunnel"
 )
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
@@ -51,6 +55,12 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 		Update:   resourceIBMTransitGatewayConnectionUpdate,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMTransitGatewayConnectionGreValidate(diff)
+			},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -152,6 +162,16 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				ForceNew:    true,
 				Description: "Location of GRE tunnel. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
 			},
+			tgLocalBgpAsn: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The local network BGP ASN. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
+			},
+			tgMtu: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "GRE tunnel MTU. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
+			},
 			tgCreatedAt: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -205,6 +225,32 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
+
+// resourceIBMTransitGatewayConnectionGreValidate checks at plan time that the
+// tunnel arguments required by gre_tunnel and unbound_gre_tunnel connections are set.
+func resourceIBMTransitGatewayConnectionGreValidate(diff *schema.ResourceDiff) error {
+	networkType := diff.Get(tgNetworkType).(string)
+	if networkType != tgNetworkTypeGreTunnel && networkType != tgNetworkTypeUnboundGreTunnel {
+		return nil
+	}
+	required := []string{tgLocalGatewayIp, tgLocalTunnelIp, tgRemoteGatewayIp, tgRemoteTunnelIp, tgZone}
+	if networkType == tgNetworkTypeGreTunnel {
+		required = append(required, tgBaseConnectionId)
+	} else {
+		required = append(required, tgBaseNetworkType)
+	}
+	for _, key := range required {
+		// unknown values, e.g. from resources not yet created, are checked on apply
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		if _, ok := diff.GetOk(key); !ok {
+			return fmt.Errorf("[ERROR] %s is required for network type %s", key, networkType)
+		}
+	}
+	return nil
+}
+
 func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
@@ -374,6 +420,36 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	if instance.RequestStatus != nil {
 		d.Set(tgRequestStatus, *instance.RequestStatus)
 	}
+	if instance.BaseConnectionID != nil {
+		d.Set(tgBaseConnectionId, *instance.BaseConnectionID)
+	}
+	if instance.BaseNetworkType != nil {
+		d.Set(tgBaseNetworkType, *instance.BaseNetworkType)
+	}
+	if instance.LocalBgpAsn != nil {
+		d.Set(tgLocalBgpAsn, *instance.LocalBgpAsn)
+	}
+	if instance.LocalGatewayIp != nil {
+		d.Set(tgLocalGatewayIp, *instance.LocalGatewayIp)
+	}
+	if instance.LocalTunnelIp != nil {
+		d.Set(tgLocalTunnelIp, *instance.LocalTunnelIp)
+	}
+	if instance.RemoteBgpAsn != nil {
+		d.Set(tgRemoteBgpAsn, *instance.RemoteBgpAsn)
+	}
+	if instance.RemoteGatewayIp != nil {
+		d.Set(tgRemoteGatewayIp, *instance.RemoteGatewayIp)
+	}
+	if instance.RemoteTunnelIp != nil {
+		d.Set(tgRemoteTunnelIp, *instance.RemoteTunnelIp)
+	}
+	if instance.Zone != nil && instance.Zone.Name != nil {
+		d.Set(tgZone, *instance.Zone.Name)
+	}
+	if instance.Mtu != nil {
+		d.Set(tgMtu, *instance.Mtu)
+	}
 	d.Set(tgConnectionId, *instance.ID)
 	d.Set(tgGatewayId, gatewayId)
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
@@ -6,6 +6,7 @@ package transitgateway_test
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
@@ -64,6 +65,9 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_gre_connection", tgConnection),
 					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_gre_connection", "name", tgSecondConnectionName),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_gre_connection", "zone", "us-south-1"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_gre_connection", "local_bgp_asn"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_gre_connection", "mtu"),
 				),
 			},
 			// tg unbound gre test
@@ -73,6 +77,8 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", tgConnection),
 					resource.TestCheckResourceAttr("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", "base_network_type", "classic"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", "local_bgp_asn"),
+					resource.TestCheckResourceAttrSet("ibm_tg_connection.test_ibm_tg_unbound_gre_connection", "mtu"),
 				),
 			},
 			// tg directlink test
@@ -98,6 +104,22 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 	)
 }
 
+func TestAccIBMTransitGatewayConnection_greMissingArguments(t *testing.T) {
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", acctest.RandIntRange(10, 100))
+	greConnName := fmt.Sprintf("tg-connection-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMTransitGatewayUnboundGreConnectionMissingConfig(gatewayName, greConnName),
+				ExpectError: regexp.MustCompile("base_network_type is required for network type unbound_gre_tunnel"),
+			},
+		},
+	},
+	)
+}
+
 func testAccCheckIBMTransitGatewayCrossAccConnectionConfig(vcName, gatewayName, vpcName string) string {
 	return fmt.Sprintf(`	
 	resource "ibm_is_vpc" "test_tg_vpc" {
@@ -197,6 +219,27 @@ resource "ibm_tg_connection" "test_ibm_tg_unbound_gre_connection"{
 	  `, gatewayName, greConnName, acc.Tg_cross_network_account_id)
 }
 
+func testAccCheckIBMTransitGatewayUnboundGreConnectionMissingConfig(gatewayName, greConnName string) string {
+	return fmt.Sprintf(`
+resource "ibm_tg_gateway" "test_tg_gateway"{
+	name="%s"
+	location="us-south"
+	global=true
+}
+
+resource "ibm_tg_connection" "test_ibm_tg_unbound_gre_connection"{
+	gateway = "${ibm_tg_gateway.test_tg_gateway.id}"
+	network_type = "unbound_gre_tunnel"
+	name = "%s"
+	local_gateway_ip = "192.168.100.1"
+	local_tunnel_ip = "192.168.101.1"
+	remote_gateway_ip = "10.242.63.12"
+	remote_tunnel_ip = "192.168.101.2"
+	zone = "us-south-1"
+}
+	  `, gatewayName, greConnName)
+}
+
 func testAccCheckIBMTransitGatewayDirectlinkConnectionConfig(dlGatewayName, gatewayName, dlConnectionName string) string {
 	return fmt.Sprintf(`	
 data "ibm_dl_ports" "test_dl_ports" {
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -58,6 +59,12 @@ func DataSourceIBMIsBackupPolicyJobs() *schema.Resource {
 				Description: "Filters the collection to backup policy jobs with the specified status",
 				Optional:    true,
 			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"created_at", "-created_at", "name", "-name"}),
+				Description:  "Sorts the returned collection by the specified property name in ascending order. A `-` may be prepended to the name to sort in descending order.",
+			},
 			"jobs": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -320,6 +327,10 @@ func dataSourceIBMIsBackupPolicyJobsRead(context context.Context, d *schema.Reso
 		listBackupPolicyJobsOptions.SetBackupPolicyPlanID(backupPolicyPlanId.(string))
 	}
 
+	if sort, ok := d.GetOk("sort"); ok {
+		listBackupPolicyJobsOptions.SetSort(sort.(string))
+	}
+
 	// Support for pagination
 	start := ""
 	allrecs := []vpcv1.BackupPolicyJob{}
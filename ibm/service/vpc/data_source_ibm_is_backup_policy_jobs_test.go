@@ -63,3 +63,32 @@ func testAccCheckIBMIsBackupPolicyJobsDataSourceConfigBasic() string {
 			backup_policy_id = "%s"
 		}`, acc.BackupPolicyID)
 }
+
+func TestAccIBMIsBackupPolicyJobsDataSourceSort(t *testing.T) {
+	if acc.BackupPolicyID == "" {
+		fmt.Println("[ERROR] Set the environment variable IS_BACKUP_POLICY_ID for testing ibm_is_backup_policy_jobs datasource")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsBackupPolicyJobsDataSourceConfigSort(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_backup_policy_jobs.is_backup_policy_jobs", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policy_jobs.is_backup_policy_jobs", "sort", "-created_at"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_backup_policy_jobs.is_backup_policy_jobs", "jobs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBackupPolicyJobsDataSourceConfigSort() string {
+	return fmt.Sprintf(`
+		data "ibm_is_backup_policy_jobs" "is_backup_policy_jobs" {
+			backup_policy_id = "%s"
+			sort             = "-created_at"
+		}`, acc.BackupPolicyID)
+}
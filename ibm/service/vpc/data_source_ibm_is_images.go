@@ -6,6 +6,7 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -18,6 +19,10 @@ const (
 	isImages                = "images"
 	isImagesResourceGroupID = "resource_group"
 	isImageCatalogManaged   = "catalog_managed"
+	isImagesOS              = "os"
+	isImagesOSVersion       = "os_version"
+	isImagesArchitecture    = "architecture"
+	isImagesMostRecent      = "most_recent"
 )
 
 func DataSourceIBMISImages() *schema.Resource {
@@ -52,6 +57,27 @@ func DataSourceIBMISImages() *schema.Resource {
 				Optional:    true,
 				Description: "Whether the image is publicly visible or private to the account",
 			},
+			isImagesOS: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the operating system of the images, for example ubuntu-22-04-amd64",
+			},
+			isImagesOSVersion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The major release version of the operating system of the images",
+			},
+			isImagesArchitecture: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_images", isImagesArchitecture),
+				Description:  "The operating system architecture of the images",
+			},
+			isImagesMostRecent: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Return only the most recently created image matching the filters, among the available images unless status is set",
+			},
 
 			isImages: {
 				Type:        schema.TypeList,
@@ -89,6 +115,16 @@ func DataSourceIBMISImages() *schema.Resource {
 							Computed:    true,
 							Description: "The operating system architecture",
 						},
+						isImagesOSVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The major release version of the operating system",
+						},
+						isImageCreatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the image was created",
+						},
 						"crn": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -114,6 +150,16 @@ func DataSourceIBMISImages() *schema.Resource {
 							Computed:    true,
 							Description: "Source volume id of the image",
 						},
+						isImageDeprecationAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
+						},
+						isImageObsolescenceAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
+						},
 						isImageCatalogOffering: {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -179,7 +225,13 @@ func DataSourceIBMISImagesValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Optional:                   true,
-			AllowedValues:              status})
+			AllowedValues:              status},
+		validate.ValidateSchema{
+			Identifier:                 isImagesArchitecture,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "amd64, arm64, s390x"})
 	ibmISImageResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_images", Schema: validateSchema}
 	return &ibmISImageResourceValidator
 }
@@ -224,6 +276,10 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk(isImageCatalogManaged); ok {
 		catalogManaged = v.(bool)
 	}
+	mostRecent := d.Get(isImagesMostRecent).(bool)
+	if mostRecent && status == "" {
+		status = "available"
+	}
 
 	listImagesOptions := &vpcv1.ListImagesOptions{}
 	if resourceGroupID != "" {
@@ -235,6 +291,9 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 	if visibility != "" {
 		listImagesOptions.SetVisibility(visibility)
 	}
+	if status != "" {
+		listImagesOptions.Status = []string{status}
+	}
 
 	for {
 		if start != "" {
@@ -271,17 +330,44 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		allrecs = allrecsTemp
 	}
 
+	osName := d.Get(isImagesOS).(string)
+	osVersion := d.Get(isImagesOSVersion).(string)
+	architecture := d.Get(isImagesArchitecture).(string)
+	if osName != "" || osVersion != "" || architecture != "" {
+		allrecsTemp := []vpcv1.Image{}
+		for _, image := range allrecs {
+			os := image.OperatingSystem
+			if os == nil || (osName != "" && *os.Name != osName) || (osVersion != "" && *os.Version != osVersion) || (architecture != "" && *os.Architecture != architecture) {
+				continue
+			}
+			allrecsTemp = append(allrecsTemp, image)
+		}
+		allrecs = allrecsTemp
+	}
+
+	if mostRecent {
+		if len(allrecs) == 0 {
+			return fmt.Errorf("[ERROR] No image found matching the filters")
+		}
+		sort.SliceStable(allrecs, func(i, j int) bool {
+			return time.Time(*allrecs[i].CreatedAt).After(time.Time(*allrecs[j].CreatedAt))
+		})
+		allrecs = allrecs[:1]
+	}
+
 	imagesInfo := make([]map[string]interface{}, 0)
 	for _, image := range allrecs {
 
 		l := map[string]interface{}{
-			"name":         *image.Name,
-			"id":           *image.ID,
-			"status":       *image.Status,
-			"crn":          *image.CRN,
-			"visibility":   *image.Visibility,
-			"os":           *image.OperatingSystem.Name,
-			"architecture": *image.OperatingSystem.Architecture,
+			"name":            *image.Name,
+			"id":              *image.ID,
+			"status":          *image.Status,
+			"crn":             *image.CRN,
+			"visibility":      *image.Visibility,
+			"os":              *image.OperatingSystem.Name,
+			"architecture":    *image.OperatingSystem.Architecture,
+			isImagesOSVersion: *image.OperatingSystem.Version,
+			isImageCreatedAt:  image.CreatedAt.String(),
 		}
 		if image.File != nil && image.File.Checksums != nil {
 			l[isImageCheckSum] = *image.File.Checksums.Sha256
@@ -295,6 +381,12 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		if image.SourceVolume != nil {
 			l["source_volume"] = *image.SourceVolume.ID
 		}
+		if image.DeprecationAt != nil {
+			l[isImageDeprecationAt] = image.DeprecationAt.String()
+		}
+		if image.ObsolescenceAt != nil {
+			l[isImageObsolescenceAt] = image.ObsolescenceAt.String()
+		}
 		if image.CatalogOffering != nil {
 			catalogOfferingList := []map[string]interface{}{}
 			catalogOfferingMap := dataSourceImageCollectionCatalogOfferingToMap(*image.CatalogOffering)
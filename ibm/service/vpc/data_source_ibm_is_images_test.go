@@ -86,6 +86,44 @@ func TestAccIBMISImageDataSource_With_FilterStatus(t *testing.T) {
 	})
 }
 
+func TestAccIBMISImageDataSource_With_FilterStatusDeprecated(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceWithStatusPublic("deprecated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "images.0.name"),
+					resource.TestCheckResourceAttr(resName, "images.0.status", "deprecated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISImagesDataSource_MostRecent(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceMostRecent("amd64"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "images.#", "1"),
+					resource.TestCheckResourceAttr(resName, "images.0.status", "available"),
+					resource.TestCheckResourceAttr(resName, "images.0.visibility", "public"),
+					resource.TestCheckResourceAttr(resName, "images.0.architecture", "amd64"),
+					resource.TestCheckResourceAttrSet(resName, "images.0.os"),
+					resource.TestCheckResourceAttrSet(resName, "images.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISImagesDataSourceConfig() string {
 	// status filter defaults to empty
 	return fmt.Sprintf(`
@@ -115,3 +153,13 @@ func testAccCheckIBMISImagesDataSourceWithStatusPublic(status string) string {
 	}
 	`, status)
 }
+
+func testAccCheckIBMISImagesDataSourceMostRecent(architecture string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_images" "test1" {
+		visibility   = "public"
+		architecture = "%s"
+		most_recent  = true
+	}
+	`, architecture)
+}
@@ -9,13 +9,17 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	isInstances         = "instances"
 	isInstanceGroupName = "instance_group_name"
+	isInstancesName     = "instance_name"
+	isInstancesLimit    = "limit"
 )
 
 func DataSourceIBMISInstances() *schema.Resource {
@@ -35,6 +39,17 @@ func DataSourceIBMISInstances() *schema.Resource {
 				ConflictsWith: []string{"vpc", "vpc_crn", "vpc_name", isInstanceGroup},
 				Description:   "Instance group name to filter the instances attached to it",
 			},
+			isInstancesName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the instance to filter the instances",
+			},
+			isInstancesLimit: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of instances listed per request, 100 by default",
+			},
 			"vpc_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -745,7 +760,15 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	listInstancesOptions := &vpcv1.ListInstancesOptions{}
+	listInstancesOptions := &vpcv1.ListInstancesOptions{
+		Limit: core.Int64Ptr(int64(100)),
+	}
+	if limit, ok := d.GetOk(isInstancesLimit); ok {
+		listInstancesOptions.Limit = core.Int64Ptr(int64(limit.(int)))
+	}
+	if name, ok := d.GetOk(isInstancesName); ok {
+		listInstancesOptions.Name = core.StringPtr(name.(string))
+	}
 
 	if vpcName != "" {
 		listInstancesOptions.VPCName = &vpcName
@@ -366,11 +366,7 @@ func lbGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 			if lb.Logging != nil && lb.Logging.Datapath != nil {
 				d.Set(isLBLogging, *lb.Logging.Datapath.Active)
 			}
-			if *lb.IsPublic {
-				d.Set(isLBType, "public")
-			} else {
-				d.Set(isLBType, "private")
-			}
+			d.Set(isLBType, lbTypeOf(&lb))
 			d.Set(isLBStatus, *lb.ProvisioningStatus)
 			if lb.RouteMode != nil {
 				d.Set(isLBRouteMode, *lb.RouteMode)
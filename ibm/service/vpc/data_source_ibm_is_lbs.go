@@ -340,11 +340,7 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 		lbInfo[ProvisioningStatus] = *lb.ProvisioningStatus
 
 		lbInfo[CreatedAt] = lb.CreatedAt.String()
-		if *lb.IsPublic {
-			lbInfo[isLBType] = "public"
-		} else {
-			lbInfo[isLBType] = "private"
-		}
+		lbInfo[isLBType] = lbTypeOf(&lb)
 		lbInfo[isLBStatus] = *lb.ProvisioningStatus
 		lbInfo[isLBOperatingStatus] = *lb.OperatingStatus
 		publicIpList := make([]string, 0)
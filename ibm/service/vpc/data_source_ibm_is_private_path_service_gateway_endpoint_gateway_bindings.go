@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func DataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to endpoint gateway bindings with the specified status: abandoned, denied, expired, pending or permitted.",
+			},
+			"account": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to endpoint gateway bindings of the specified account.",
+			},
+			"endpoint_gateway_bindings": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of endpoint gateway bindings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account that created the endpoint gateway binding.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the endpoint gateway binding was created.",
+						},
+						"expiration_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expiration date and time for the endpoint gateway binding while it's pending.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this endpoint gateway binding.",
+						},
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this endpoint gateway binding.",
+						},
+						"lifecycle_state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the endpoint gateway binding.",
+						},
+						"resource_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the endpoint gateway binding.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the endpoint gateway binding was updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID := d.Get("private_path_service_gateway").(string)
+	query := map[string]string{}
+	if status, ok := d.GetOk("status"); ok {
+		query["status"] = status.(string)
+	}
+	if account, ok := d.GetOk("account"); ok {
+		query["account.id"] = account.(string)
+	}
+
+	bindings := []map[string]interface{}{}
+	for {
+		result := struct {
+			EndpointGatewayBindings []privatePathServiceGatewayEndpointGatewayBinding `json:"endpoint_gateway_bindings"`
+			Next                    *struct {
+				Href *string `json:"href"`
+			} `json:"next"`
+		}{}
+		response, err := vpcRequestWithQuery(context, vpcClient, core.GET, `/private_path_service_gateways/{private_path_service_gateway_id}/endpoint_gateway_bindings`,
+			map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID}, query, nil, &result)
+		if err != nil {
+			log.Printf("[DEBUG] ListPrivatePathServiceGatewayEndpointGatewayBindingsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListPrivatePathServiceGatewayEndpointGatewayBindingsWithContext failed %s\n%s", err, response))
+		}
+		for _, binding := range result.EndpointGatewayBindings {
+			bindingMap := map[string]interface{}{
+				"created_at":      core.StringNilMapper(binding.CreatedAt),
+				"expiration_at":   core.StringNilMapper(binding.ExpirationAt),
+				"href":            core.StringNilMapper(binding.Href),
+				"id":              core.StringNilMapper(binding.ID),
+				"lifecycle_state": core.StringNilMapper(binding.LifecycleState),
+				"resource_type":   core.StringNilMapper(binding.ResourceType),
+				"status":          core.StringNilMapper(binding.Status),
+				"updated_at":      core.StringNilMapper(binding.UpdatedAt),
+			}
+			if binding.Account != nil {
+				bindingMap["account"] = core.StringNilMapper(binding.Account.ID)
+			}
+			bindings = append(bindings, bindingMap)
+		}
+		start := flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+		query["start"] = start
+	}
+
+	d.SetId(privatePathServiceGatewayID)
+
+	if err = d.Set("endpoint_gateway_bindings", bindings); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_gateway_bindings %s", err))
+	}
+
+	return nil
+}
@@ -4,10 +4,13 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,6 +25,18 @@ func DataSourceIBMIsSecurityGroupRules() *schema.Resource {
 				Required:    true,
 				Description: "The security group identifier.",
 			},
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"inbound", "outbound"}),
+				Description:  "Filters the rules by the direction of traffic, either `inbound` or `outbound`.",
+			},
+			"protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"all", "icmp", "tcp", "udp"}),
+				Description:  "Filters the rules by protocol, one of `all`, `icmp`, `tcp` or `udp`.",
+			},
 			"rules": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -43,6 +58,11 @@ func DataSourceIBMIsSecurityGroupRules() *schema.Resource {
 							Computed:    true,
 							Description: "The unique identifier for this security group rule.",
 						},
+						"local": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The local IP address or CIDR block to which this rule allows inbound traffic (or from which, for outbound rules).",
+						},
 						"ip_version": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -109,12 +129,12 @@ func DataSourceIBMIsSecurityGroupRules() *schema.Resource {
 						"code": &schema.Schema{
 							Type:        schema.TypeInt,
 							Computed:    true,
-							Description: "The ICMP traffic code to allow.",
+							Description: "The ICMP traffic code to allow, not set when all codes are allowed.",
 						},
 						"type": &schema.Schema{
 							Type:        schema.TypeInt,
 							Computed:    true,
-							Description: "The ICMP traffic type to allow.",
+							Description: "The ICMP traffic type to allow, not set when all types are allowed.",
 						},
 						"port_max": &schema.Schema{
 							Type:        schema.TypeInt,
@@ -149,6 +169,32 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error fetching security group rules %s\n%s", err, response)
 	}
 
+	// The vpc-go-sdk doesn't decode the rules local yet
+	localRules := &struct {
+		Rules []struct {
+			ID    *string                 `json:"id"`
+			Local *securityGroupRuleLocal `json:"local"`
+		} `json:"rules"`
+	}{}
+	response, err = vpcRequest(context.TODO(), sess, core.GET, `/security_groups/{security_group_id}/rules`,
+		map[string]string{"security_group_id": secGrpId}, nil, localRules)
+	if err != nil {
+		return fmt.Errorf("Error fetching security group rules local %s\n%s", err, response)
+	}
+	locals := map[string]string{}
+	for _, rule := range localRules.Rules {
+		if rule.ID == nil || rule.Local == nil {
+			continue
+		}
+		if rule.Local.Address != nil {
+			locals[*rule.ID] = *rule.Local.Address
+		} else if rule.Local.CIDRBlock != nil {
+			locals[*rule.ID] = *rule.Local.CIDRBlock
+		}
+	}
+	direction := d.Get("direction").(string)
+	protocol := d.Get("protocol").(string)
+
 	rulesInfo := make([]map[string]interface{}, 0)
 	for _, rule := range ruleList.Rules {
 		l := map[string]interface{}{}
@@ -177,9 +223,14 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 				l["href"] = *rulex.Href
 				l["id"] = *rulex.ID
 				l["ip_version"] = *rulex.IPVersion
-				l["code"] = *rulex.Code
+				// type and code are absent when all ICMP types or codes are allowed
+				if rulex.Code != nil {
+					l["code"] = *rulex.Code
+				}
 				l["protocol"] = *rulex.Protocol
-				l["type"] = *rulex.Type
+				if rulex.Type != nil {
+					l["type"] = *rulex.Type
+				}
 				// remote
 				if rulex.Remote != nil {
 					remoteList := []map[string]interface{}{}
@@ -207,6 +258,15 @@ func dataSourceIBMIsSecurityGroupRulesRead(d *schema.ResourceData, meta interfac
 				}
 			}
 		}
+		if direction != "" && l["direction"] != direction {
+			continue
+		}
+		if protocol != "" && l["protocol"] != protocol {
+			continue
+		}
+		if id, ok := l["id"].(string); ok && locals[id] != "" {
+			l["local"] = locals[id]
+		}
 		rulesInfo = append(rulesInfo, l)
 	}
 	d.SetId(dataSourceIBMIsSecurityGroupRulesID(d))
@@ -29,6 +29,9 @@ func TestAccIBMIsSecurityGroupRulesDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_security_group_rules.example", "rules.0.ip_version"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_security_group_rules.example", "rules.0.protocol"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_security_group_rules.example", "rules.0.remote.#"),
+					resource.TestCheckResourceAttr("data.ibm_is_security_group_rules.outbound_tcp", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_security_group_rules.outbound_tcp", "rules.0.port_min", "8080"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_security_group_rules.outbound_tcp", "rules.0.local"),
 				),
 			},
 		},
@@ -68,5 +71,13 @@ func testAccCheckIBMIsSecurityGroupRulesDataSourceConfigBasic(vpcname, sgname st
 		]
 			security_group = ibm_is_security_group.example.id
 		}
+		data "ibm_is_security_group_rules" "outbound_tcp" {
+			depends_on = [
+				ibm_is_security_group_rule.example,
+		]
+			security_group = ibm_is_security_group.example.id
+			direction      = "outbound"
+			protocol       = "tcp"
+		}
 	`, vpcname, sgname)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -21,6 +22,7 @@ const (
 	isSubnetResourceVpc      = "vpc"
 	isSubnetResourceVpcCrn   = "vpc_crn"
 	isSubnetResourceVpcName  = "vpc_name"
+	isSubnetsLimit           = "limit"
 )
 
 func DataSourceIBMISSubnets() *schema.Resource {
@@ -28,6 +30,12 @@ func DataSourceIBMISSubnets() *schema.Resource {
 		Read: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isSubnetsLimit: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of subnets listed per request, 100 by default",
+			},
 			isSubnetResourceVpc: {
 				Type:        schema.TypeString,
 				Description: "ID of the VPC",
@@ -221,8 +229,14 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		vpcCrn = v.(string)
 	}
 
+	limit := int64(100)
+	if v, ok := d.GetOk(isSubnetsLimit); ok {
+		limit = int64(v.(int))
+	}
+
 	for {
 		options := &vpcv1.ListSubnetsOptions{}
+		options.SetLimit(limit)
 		if resourceGroup != "" {
 			options.SetResourceGroupID(resourceGroup)
 		}
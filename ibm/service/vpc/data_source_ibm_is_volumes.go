@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
@@ -91,6 +92,8 @@ const (
 	isVolumesZone                                          = "zone"
 	isVolumesZoneHref                                      = "href"
 	isVolumesZoneName                                      = "name"
+	isVolumesLimit                                         = "limit"
+	isVolumesTagsFilter                                    = "tags"
 )
 
 func DataSourceIBMIsVolumes() *schema.Resource {
@@ -128,6 +131,24 @@ func DataSourceIBMIsVolumes() *schema.Resource {
 				Optional:    true,
 				Description: "Zone name identifier.",
 			},
+			isVolumesResourceGroup: &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Resource group ID of the Volume, the listed volumes are filtered on it as the API can't filter on the resource group.",
+			},
+			isVolumesTagsFilter: &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "User tags of the Volume, only the volumes with all of the tags are returned. The listed volumes are filtered on them as the API can't filter on tags.",
+			},
+			isVolumesLimit: &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of volumes listed per request, 100 by default.",
+			},
 			isVolumes: &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -614,6 +635,10 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	start := ""
 	allrecs := []vpcv1.Volume{}
 	listVolumesOptions := &vpcv1.ListVolumesOptions{}
+	listVolumesOptions.SetLimit(100)
+	if limit, ok := d.GetOk(isVolumesLimit); ok {
+		listVolumesOptions.SetLimit(int64(limit.(int)))
+	}
 	if start != "" {
 		listVolumesOptions.Start = &start
 	}
@@ -648,7 +673,11 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 		}
 
 		start = flex.GetNext(volumeCollection.Next)
-		allrecs = append(allrecs, volumeCollection.Volumes...)
+		for _, volume := range volumeCollection.Volumes {
+			if dataSourceIBMIsVolumesMatch(d, volume) {
+				allrecs = append(allrecs, volume)
+			}
+		}
 
 		if start == "" {
 			break
@@ -666,6 +695,28 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// dataSourceIBMIsVolumesMatch reports whether the volume has the resource group and all the tags of the filters
+// the API can't apply.
+func dataSourceIBMIsVolumesMatch(d *schema.ResourceData, volume vpcv1.Volume) bool {
+	if resourceGroup, ok := d.GetOk(isVolumesResourceGroup); ok {
+		if volume.ResourceGroup == nil || *volume.ResourceGroup.ID != resourceGroup.(string) {
+			return false
+		}
+	}
+	if tags, ok := d.GetOk(isVolumesTagsFilter); ok {
+		userTags := map[string]bool{}
+		for _, tag := range volume.UserTags {
+			userTags[tag] = true
+		}
+		for _, tag := range tags.(*schema.Set).List() {
+			if !userTags[tag.(string)] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // dataSourceIBMIsVolumesID returns a reasonable ID for the list.
 func dataSourceIBMIsVolumesID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
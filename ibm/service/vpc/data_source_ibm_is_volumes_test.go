@@ -42,6 +42,23 @@ func TestAccIBMIsVolumesDataSourceBasic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMIsVolumesDataSourceFilterByTags(t *testing.T) {
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	tag := fmt.Sprintf("tf-vol-tag-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVolumesDataSourceConfigFilterByTags(volname, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.is_volumes", "volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_volumes.is_volumes", "volumes.0.name", volname),
+				),
+			},
+		},
+	})
+}
 func TestAccIBMIsVolumesFromSnapshotDataSourceBasic(t *testing.T) {
 	resName := "data.ibm_is_volumes.is_volumes"
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -130,3 +147,20 @@ func testAccCheckIBMIsVolumesDataSourceConfigFilterByName() string {
 		}
 	`)
 }
+
+func testAccCheckIBMIsVolumesDataSourceConfigFilterByTags(volname, tag string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_volume" "storage" {
+			name    = "%s"
+			profile = "10iops-tier"
+			zone    = "%s"
+			tags    = ["%s"]
+		}
+
+		data "ibm_is_volumes" "is_volumes" {
+			zone_name = ibm_is_volume.storage.zone
+			tags      = ibm_is_volume.storage.tags
+			limit     = 10
+		}
+	`, volname, acc.ISZoneName, tag)
+}
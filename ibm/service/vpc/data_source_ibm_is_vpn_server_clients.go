@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,6 +27,18 @@ func DataSourceIBMIsVPNServerClients() *schema.Resource {
 				Required:    true,
 				Description: "The VPN server identifier.",
 			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{vpcv1.VPNServerClientStatusConnectedConst, vpcv1.VPNServerClientStatusDisconnectedConst}),
+				Description:  "Filters the collection to VPN clients with the specified status, connected or disconnected.",
+			},
+			"sort": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"created_at", "-created_at"}),
+				Description:  "Sorts the collection by created_at, prepend - to sort in descending order.",
+			},
 			"clients": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -124,6 +137,9 @@ func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.Reso
 	for {
 		listVPNServerClientsOptions := &vpcv1.ListVPNServerClientsOptions{}
 		listVPNServerClientsOptions.SetVPNServerID(d.Get("vpn_server").(string))
+		if sort, ok := d.GetOk("sort"); ok {
+			listVPNServerClientsOptions.SetSort(sort.(string))
+		}
 		if start != "" {
 			listVPNServerClientsOptions.Start = &start
 		}
@@ -133,7 +149,12 @@ func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.Reso
 			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerClientsWithContext failed %s\n%s", err, response))
 		}
 		start = flex.GetNext(vpnServerClientCollection.Next)
-		allrecs = append(allrecs, vpnServerClientCollection.Clients...)
+		for _, client := range vpnServerClientCollection.Clients {
+			if status, ok := d.GetOk("status"); ok && (client.Status == nil || *client.Status != status.(string)) {
+				continue
+			}
+			allrecs = append(allrecs, client)
+		}
 		if start == "" {
 			break
 		}
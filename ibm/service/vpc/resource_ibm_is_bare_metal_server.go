@@ -52,6 +52,7 @@ const (
 	isBareMetalServerName                                = "name"
 	isBareMetalServerNetworkInterfaces                   = "network_interfaces"
 	isBareMetalServerPrimaryNetworkInterface             = "primary_network_interface"
+	isBareMetalServerPrimaryNetworkAttachment            = "primary_network_attachment"
 	isBareMetalServerProfile                             = "profile"
 	isBareMetalServerResourceGroup                       = "resource_group"
 	isBareMetalServerResourceType                        = "resource_type"
@@ -81,6 +82,7 @@ const (
 	isBareMetalServerStatusPending                       = "pending"
 	isBareMetalServerStatusRestarting                    = "restarting"
 	isBareMetalServerStatusFailed                        = "failed"
+	isBareMetalServerStatusReinitializing                = "reinitializing"
 	isBareMetalServerAccessTags                          = "access_tags"
 	isBareMetalServerUserTagType                         = "user"
 	isBareMetalServerAccessTagType                       = "access"
@@ -272,11 +274,12 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Description: "Enables stopping type of the bare metal server before deleting",
 			},
 			isBareMetalServerPrimaryNetworkInterface: {
-				Type:        schema.TypeList,
-				MinItems:    1,
-				MaxItems:    1,
-				Required:    true,
-				Description: "Primary Network interface info",
+				Type:         schema.TypeList,
+				MinItems:     1,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{isBareMetalServerPrimaryNetworkInterface, isBareMetalServerPrimaryNetworkAttachment},
+				Description:  "Primary Network interface info",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -390,6 +393,18 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				},
 			},
 
+			isBareMetalServerPrimaryNetworkAttachment: {
+				Type:          schema.TypeList,
+				MinItems:      1,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isBareMetalServerNetworkInterfaces},
+				ExactlyOneOf:  []string{isBareMetalServerPrimaryNetworkInterface, isBareMetalServerPrimaryNetworkAttachment},
+				Description:   "The primary network attachment, binding a virtual network interface to the bare metal server",
+				Elem:          bareMetalServerPrimaryNetworkAttachmentSchema(),
+			},
+
 			isBareMetalServerNetworkInterfaces: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -526,14 +541,13 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Elem:             &schema.Schema{Type: schema.TypeString},
 				Set:              schema.HashString,
 				DiffSuppressFunc: flex.ApplyOnce,
-				Description:      "SSH key Ids for the bare metal server",
+				Description:      "SSH key Ids for the bare metal server, changes are applied when the bare metal server is reinitialized",
 			},
 
 			isBareMetalServerImage: {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Required:    true,
-				Description: "image id",
+				Description: "image id, changing it reinitializes the bare metal server",
 			},
 			isBareMetalServerProfile: {
 				Type:        schema.TypeString,
@@ -544,9 +558,8 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 
 			isBareMetalServerUserData: {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
-				Description: "User data given for the bare metal server",
+				Description: "User data given for the bare metal server, changing it reinitializes the bare metal server",
 			},
 
 			isBareMetalServerZone: {
@@ -1301,7 +1314,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		}
 	}
 
-	bms, response, err := sess.CreateBareMetalServerWithContext(context, options)
+	bms, response, err := bareMetalServerCreateWithOptions(context, d, sess, options)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[DEBUG] Create bare metal server err %s\n%s", err, response))
 	}
@@ -1347,10 +1360,10 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 	if err != nil {
 		return err
 	}
-	options := &vpcv1.GetBareMetalServerOptions{
-		ID: &id,
-	}
-	bms, response, err := sess.GetBareMetalServerWithContext(context, options)
+	// the bare metal server is read raw, so that its primary network attachment, which the vpc-go-sdk version used
+	// by the provider doesn't model, is read from the same response
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context, sess, core.GET, `/bare_metal_servers/{id}`, map[string]string{"id": id}, nil, &result)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -1358,6 +1371,10 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 		}
 		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s\n%s", id, err, response)
 	}
+	var bms *vpcv1.BareMetalServer
+	if err = vpcv1.UnmarshalBareMetalServer(result, &bms); err != nil {
+		return fmt.Errorf("[ERROR] Error getting Bare Metal Server (%s): %s", id, err)
+	}
 	d.SetId(*bms.ID)
 	d.Set(isBareMetalServerBandwidth, bms.Bandwidth)
 	bmsBootTargetIntf := bms.BootTarget.(*vpcv1.BareMetalServerBootTarget)
@@ -1546,10 +1563,12 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 
 		primaryNicList = append(primaryNicList, currentPrimNic)
 		d.Set(isBareMetalServerPrimaryNetworkInterface, primaryNicList)
+	} else if err = bareMetalServerGetPrimaryNetworkAttachment(context, d, sess, result[isBareMetalServerPrimaryNetworkAttachment]); err != nil {
+		return err
 	}
 
 	//ni
-	if bms.NetworkInterfaces != nil {
+	if bms.NetworkInterfaces != nil && bms.PrimaryNetworkInterface != nil {
 		interfacesList := make([]map[string]interface{}, 0)
 		for _, intfc := range bms.NetworkInterfaces {
 			flagAllowFloat := false
@@ -2484,6 +2503,24 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange(isBareMetalServerImage) || d.HasChange(isBareMetalServerUserData) {
+		// the initialization can only be replaced while the server is stopped
+		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+		if err != nil {
+			return err
+		}
+		response, err := replaceBareMetalServerInitialization(context, sess, d, id)
+		if err != nil {
+			bareMetalServerReinitializeFailed(context, sess, d, id, isServerStopped)
+			return fmt.Errorf("[ERROR] Error reinitializing Bare Metal Server (%s): %s\n%s", id, err, response)
+		}
+		_, err = isWaitForBareMetalServerReinitialized(sess, id, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			bareMetalServerReinitializeFailed(context, sess, d, id, isServerStopped)
+			return err
+		}
+	}
+
 	if d.HasChange(isBareMetalServerAction) {
 		action := ""
 		if actionOk, ok := d.GetOk(isBareMetalServerAction); ok {
@@ -2793,6 +2830,77 @@ func resourceIBMBMSNicSet(v interface{}) int {
 	return conns.String(buf.String())
 }
 
+// replaceBareMetalServerInitialization reinitializes the stopped bare metal server with the
+// configured image, keys and user data. The SDK has no binding for this API yet.
+func replaceBareMetalServerInitialization(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id string) (*core.DetailedResponse, error) {
+	image := d.Get(isBareMetalServerImage).(string)
+	body := map[string]interface{}{
+		"image": map[string]string{"id": image},
+	}
+
+	// keys changes are suppressed in the plan, so take them from the configuration
+	keys := []map[string]string{}
+	rawKeys := d.GetRawConfig().GetAttr(isBareMetalServerKeys)
+	if rawKeys.IsKnown() && !rawKeys.IsNull() {
+		for it := rawKeys.ElementIterator(); it.Next(); {
+			_, key := it.Element()
+			keys = append(keys, map[string]string{"id": key.AsString()})
+		}
+	} else {
+		for _, key := range d.Get(isBareMetalServerKeys).(*schema.Set).List() {
+			keys = append(keys, map[string]string{"id": key.(string)})
+		}
+	}
+	body["keys"] = keys
+	if userdata, ok := d.GetOk(isBareMetalServerUserData); ok {
+		body["user_data"] = userdata.(string)
+	}
+
+	var result map[string]json.RawMessage
+	return vpcRequest(context, sess, core.PUT, `/bare_metal_servers/{id}/initialization`, map[string]string{"id": id}, body, &result)
+}
+
+// bareMetalServerReinitializeFailed keeps the old image, keys and user data in the state so that the
+// next plan retries the reinitialization, and starts the server again if it was stopped for it.
+func bareMetalServerReinitializeFailed(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id string, isServerStopped bool) {
+	for _, attr := range []string{isBareMetalServerImage, isBareMetalServerKeys, isBareMetalServerUserData} {
+		o, _ := d.GetChange(attr)
+		d.Set(attr, o)
+	}
+	if isServerStopped {
+		if _, err := resourceStartServerIfStopped(id, "hard", d, context, sess, isServerStopped); err != nil {
+			log.Printf("[WARN] Error starting Bare Metal Server (%s) after the failed reinitialization: %s", id, err)
+		}
+	}
+}
+
+func isWaitForBareMetalServerReinitialized(bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Bare Metal Server (%s) to be reinitialized.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerStatusReinitializing, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
+		Target:  []string{isBareMetalServerActionStatusStopped, isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			getbmsoptions := &vpcv1.GetBareMetalServerOptions{
+				ID: &id,
+			}
+			bms, response, err := bmsC.GetBareMetalServer(getbmsoptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting Bare Metal Server: %s\n%s", err, response)
+			}
+			if *bms.Status == isBareMetalServerStatusFailed {
+				return bms, *bms.Status, fmt.Errorf("[ERROR] The Bare Metal Server %s failed to reinitialize", id)
+			}
+			return bms, *bms.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData, context context.Context, sess *vpcv1.VpcV1, isServerStopped bool) (bool, error) {
 	getBmsOptions := &vpcv1.GetBareMetalServerOptions{
 		ID: &id,
@@ -2877,3 +2985,95 @@ func resourceIBMIsBareMetalServerBareMetalServerTrustedPlatformModulePrototypeTo
 	}
 	return modelMap, nil
 }
+
+// bareMetalServerNetworkAttachment is the bare metal server network attachment returned by the VPC API, the
+// vpc-go-sdk version used by the provider doesn't model network attachments.
+type bareMetalServerNetworkAttachment struct {
+	instanceNetworkAttachment
+	AllowedVlans  []int64 `json:"allowed_vlans,omitempty"`
+	InterfaceType *string `json:"interface_type,omitempty"`
+}
+
+// bareMetalServerPrimaryNetworkAttachmentSchema is the instance primary network attachment with the settings of the
+// physical interface, the primary network attachment of a bare metal server is always a PCI interface.
+func bareMetalServerPrimaryNetworkAttachmentSchema() *schema.Resource {
+	attachment := instancePrimaryNetworkAttachmentSchema()
+	attachment.Schema["id"].Description = "The unique identifier for this bare metal server network attachment"
+	attachment.Schema["virtual_network_interface"].Elem.(*schema.Resource).Schema["auto_delete"].Description = "Indicates whether the new virtual network interface is deleted when the bare metal server is deleted"
+	attachment.Schema[isBareMetalServerNicAllowedVlans] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Set:         schema.HashInt,
+		Description: "The VLAN IDs of the VLAN network attachments allowed to use this PCI network attachment",
+	}
+	attachment.Schema[isBareMetalServerNicInterfaceType] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The network attachment's interface type, pci for the primary network attachment",
+	}
+	return attachment
+}
+
+// bareMetalServerCreateWithOptions creates the bare metal server, the servers with a primary network attachment are
+// created with a raw request as the vpc-go-sdk version used by the provider doesn't model network attachments.
+func bareMetalServerCreateWithOptions(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, options *vpcv1.CreateBareMetalServerOptions) (*vpcv1.BareMetalServer, *core.DetailedResponse, error) {
+	if _, ok := d.GetOk(isBareMetalServerPrimaryNetworkAttachment); !ok {
+		return sess.CreateBareMetalServerWithContext(context, options)
+	}
+
+	prototype, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(prototype, &body); err != nil {
+		return nil, nil, err
+	}
+	delete(body, "Headers")
+	delete(body, isBareMetalServerPrimaryNetworkInterface)
+	delete(body, isBareMetalServerNetworkInterfaces)
+	attachment, err := expandInstancePrimaryNetworkAttachment(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachment["interface_type"] = "pci"
+	if vlans, ok := d.GetOk(isBareMetalServerPrimaryNetworkAttachment + ".0." + isBareMetalServerNicAllowedVlans); ok && vlans.(*schema.Set).Len() > 0 {
+		attachment["allowed_vlans"] = vlans.(*schema.Set).List()
+	}
+	body[isBareMetalServerPrimaryNetworkAttachment] = attachment
+
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context, sess, core.POST, `/bare_metal_servers`, nil, body, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	var bms *vpcv1.BareMetalServer
+	if err = vpcv1.UnmarshalBareMetalServer(result, &bms); err != nil {
+		return nil, response, err
+	}
+	return bms, response, nil
+}
+
+func bareMetalServerGetPrimaryNetworkAttachment(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, raw json.RawMessage) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	networkAttachment := &bareMetalServerNetworkAttachment{}
+	if err := json.Unmarshal(raw, networkAttachment); err != nil {
+		return fmt.Errorf("[ERROR] Error getting Bare Metal Server primary network attachment: %s", err)
+	}
+	attachment, err := flattenPrimaryNetworkAttachment(context, sess, &networkAttachment.instanceNetworkAttachment)
+	if err != nil {
+		return err
+	}
+	allowedVlans := make([]interface{}, 0, len(networkAttachment.AllowedVlans))
+	for _, vlan := range networkAttachment.AllowedVlans {
+		allowedVlans = append(allowedVlans, int(vlan))
+	}
+	attachment[isBareMetalServerNicAllowedVlans] = schema.NewSet(schema.HashInt, allowedVlans)
+	attachment[isBareMetalServerNicInterfaceType] = core.StringNilMapper(networkAttachment.InterfaceType)
+	return d.Set(isBareMetalServerPrimaryNetworkAttachment, []map[string]interface{}{attachment})
+}
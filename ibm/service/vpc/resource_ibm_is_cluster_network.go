@@ -0,0 +1,444 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isClusterNetworkStable   = "stable"
+	isClusterNetworkFailed   = "failed"
+	isClusterNetworkPending  = "pending"
+	isClusterNetworkUpdating = "updating"
+	isClusterNetworkWaiting  = "waiting"
+	isClusterNetworkDeleting = "deleting"
+	isClusterNetworkDeleted  = "deleted"
+)
+
+// clusterNetwork is the cluster network returned by the VPC API, the vpc-go-sdk version used by the
+// provider doesn't model cluster networks.
+type clusterNetwork struct {
+	CreatedAt        *string                         `json:"created_at,omitempty"`
+	CRN              *string                         `json:"crn,omitempty"`
+	Href             *string                         `json:"href,omitempty"`
+	ID               *string                         `json:"id,omitempty"`
+	LifecycleState   *string                         `json:"lifecycle_state,omitempty"`
+	Name             *string                         `json:"name,omitempty"`
+	Profile          *clusterNetworkProfileReference `json:"profile,omitempty"`
+	ResourceGroup    *vpcv1.ResourceGroupReference   `json:"resource_group,omitempty"`
+	ResourceType     *string                         `json:"resource_type,omitempty"`
+	SubnetPrefixes   []clusterNetworkSubnetPrefix    `json:"subnet_prefixes,omitempty"`
+	VPC              *vpcv1.VPCReference             `json:"vpc,omitempty"`
+	Zone             *vpcv1.ZoneReference            `json:"zone,omitempty"`
+	LifecycleReasons []clusterNetworkLifecycleReason `json:"lifecycle_reasons,omitempty"`
+}
+
+type clusterNetworkProfileReference struct {
+	Href *string `json:"href,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type clusterNetworkSubnetPrefix struct {
+	AllocationPolicy *string `json:"allocation_policy,omitempty"`
+	CIDR             *string `json:"cidr,omitempty"`
+}
+
+type clusterNetworkLifecycleReason struct {
+	Code     *string `json:"code,omitempty"`
+	Message  *string `json:"message,omitempty"`
+	MoreInfo *string `json:"more_info,omitempty"`
+}
+
+func ResourceIBMIsClusterNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsClusterNetworkCreate,
+		ReadContext:   resourceIBMIsClusterNetworkRead,
+		UpdateContext: resourceIBMIsClusterNetworkUpdate,
+		DeleteContext: resourceIBMIsClusterNetworkDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_cluster_network", "name"),
+				Description:  "The name for this cluster network. The name must not be used by another cluster network in the region.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the cluster network profile, for example h100.",
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC this cluster network resides in.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone this cluster network resides in.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group for this cluster network.",
+			},
+			"subnet_prefixes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The IP address ranges available for subnets for this cluster network, a prefix is allocated by the system if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The CIDR block for this prefix.",
+						},
+						"allocation_policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The allocation policy for this subnet prefix.",
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the cluster network was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this cluster network.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this cluster network.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the cluster network.",
+			},
+			"lifecycle_reasons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current lifecycle state, if any.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string succinctly identifying the reason for this lifecycle state.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the reason for this lifecycle state.",
+						},
+						"more_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Link to documentation about the reason for this lifecycle state.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsClusterNetworkValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_cluster_network", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsClusterNetworkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{
+		"profile": map[string]string{"name": d.Get("profile").(string)},
+		"vpc":     map[string]string{"id": d.Get("vpc").(string)},
+		"zone":    map[string]string{"name": d.Get("zone").(string)},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		body["resource_group"] = map[string]string{"id": resourceGroup.(string)}
+	}
+	if cidr, ok := d.GetOk("subnet_prefixes.0.cidr"); ok {
+		body["subnet_prefixes"] = []map[string]string{{"cidr": cidr.(string)}}
+	}
+
+	clusterNetwork := &clusterNetwork{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/cluster_networks`, nil, body, clusterNetwork)
+	if err != nil {
+		log.Printf("[DEBUG] CreateClusterNetworkWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateClusterNetworkWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*clusterNetwork.ID)
+
+	_, err = isWaitForClusterNetworkStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsClusterNetworkRead(context, d, meta)
+}
+
+func resourceIBMIsClusterNetworkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetwork, response, err := getClusterNetwork(context, vpcClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetClusterNetworkWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetClusterNetworkWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", clusterNetwork.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if clusterNetwork.Profile != nil {
+		if err = d.Set("profile", clusterNetwork.Profile.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting profile: %s", err))
+		}
+	}
+	if clusterNetwork.VPC != nil {
+		if err = d.Set("vpc", clusterNetwork.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting vpc: %s", err))
+		}
+	}
+	if clusterNetwork.Zone != nil {
+		if err = d.Set("zone", clusterNetwork.Zone.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting zone: %s", err))
+		}
+	}
+	if clusterNetwork.ResourceGroup != nil {
+		if err = d.Set("resource_group", clusterNetwork.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting resource_group: %s", err))
+		}
+	}
+
+	subnetPrefixes := []map[string]interface{}{}
+	for _, prefix := range clusterNetwork.SubnetPrefixes {
+		subnetPrefixes = append(subnetPrefixes, map[string]interface{}{
+			"cidr":              core.StringNilMapper(prefix.CIDR),
+			"allocation_policy": core.StringNilMapper(prefix.AllocationPolicy),
+		})
+	}
+	if err = d.Set("subnet_prefixes", subnetPrefixes); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting subnet_prefixes: %s", err))
+	}
+
+	lifecycleReasons := []map[string]interface{}{}
+	for _, reason := range clusterNetwork.LifecycleReasons {
+		lifecycleReasons = append(lifecycleReasons, map[string]interface{}{
+			"code":      core.StringNilMapper(reason.Code),
+			"message":   core.StringNilMapper(reason.Message),
+			"more_info": core.StringNilMapper(reason.MoreInfo),
+		})
+	}
+	if err = d.Set("lifecycle_reasons", lifecycleReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_reasons: %s", err))
+	}
+
+	if err = d.Set("created_at", clusterNetwork.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", clusterNetwork.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("href", clusterNetwork.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", clusterNetwork.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", clusterNetwork.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsClusterNetworkUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		body := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/cluster_networks/{id}`,
+			map[string]string{"id": d.Id()}, body, &clusterNetwork{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateClusterNetworkWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateClusterNetworkWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIsClusterNetworkRead(context, d, meta)
+}
+
+func resourceIBMIsClusterNetworkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/cluster_networks/{id}`,
+		map[string]string{"id": d.Id()}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteClusterNetworkWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteClusterNetworkWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForClusterNetworkDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getClusterNetwork(context context.Context, vpcClient *vpcv1.VpcV1, id string) (*clusterNetwork, *core.DetailedResponse, error) {
+	clusterNetwork := &clusterNetwork{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/cluster_networks/{id}`,
+		map[string]string{"id": id}, nil, clusterNetwork)
+	if err != nil {
+		return nil, response, err
+	}
+	return clusterNetwork, response, nil
+}
+
+func isWaitForClusterNetworkStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isClusterNetworkPending, isClusterNetworkUpdating, isClusterNetworkWaiting},
+		Target:     []string{isClusterNetworkStable, isClusterNetworkFailed},
+		Refresh:    isClusterNetworkRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isClusterNetworkRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		clusterNetwork, response, err := getClusterNetwork(context, vpcClient, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting cluster network: %s\n%s", err, response)
+		}
+
+		if *clusterNetwork.LifecycleState == isClusterNetworkFailed {
+			return clusterNetwork, *clusterNetwork.LifecycleState, fmt.Errorf("[ERROR] Cluster network (%s) went into failed state", id)
+		}
+		return clusterNetwork, *clusterNetwork.LifecycleState, nil
+	}
+}
+
+func isWaitForClusterNetworkDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isClusterNetworkDeleting, isClusterNetworkStable, isClusterNetworkUpdating},
+		Target:     []string{isClusterNetworkDeleted, isClusterNetworkFailed},
+		Refresh:    isClusterNetworkDeleteRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isClusterNetworkDeleteRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		clusterNetwork, response, err := getClusterNetwork(context, vpcClient, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return clusterNetwork, isClusterNetworkDeleted, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error getting cluster network: %s\n%s", err, response)
+		}
+
+		if *clusterNetwork.LifecycleState == isClusterNetworkFailed {
+			return clusterNetwork, *clusterNetwork.LifecycleState, fmt.Errorf("[ERROR] Cluster network (%s) failed to delete", id)
+		}
+		return clusterNetwork, *clusterNetwork.LifecycleState, nil
+	}
+}
@@ -0,0 +1,492 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// clusterNetworkInterface is an interface of a cluster network as returned by the VPC API.
+type clusterNetworkInterface struct {
+	AllowIPSpoofing         *bool                           `json:"allow_ip_spoofing,omitempty"`
+	AutoDelete              *bool                           `json:"auto_delete,omitempty"`
+	CreatedAt               *string                         `json:"created_at,omitempty"`
+	EnableInfrastructureNat *bool                           `json:"enable_infrastructure_nat,omitempty"`
+	Href                    *string                         `json:"href,omitempty"`
+	ID                      *string                         `json:"id,omitempty"`
+	LifecycleState          *string                         `json:"lifecycle_state,omitempty"`
+	MacAddress              *string                         `json:"mac_address,omitempty"`
+	Name                    *string                         `json:"name,omitempty"`
+	PrimaryIP               *clusterNetworkSubnetReservedIP `json:"primary_ip,omitempty"`
+	ResourceType            *string                         `json:"resource_type,omitempty"`
+	Subnet                  *clusterNetworkSubnetReference  `json:"subnet,omitempty"`
+	Target                  *clusterNetworkInterfaceTarget  `json:"target,omitempty"`
+	VPC                     *vpcv1.VPCReference             `json:"vpc,omitempty"`
+	Zone                    *vpcv1.ZoneReference            `json:"zone,omitempty"`
+}
+
+type clusterNetworkSubnetReservedIP struct {
+	Address *string `json:"address,omitempty"`
+	Href    *string `json:"href,omitempty"`
+	ID      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+}
+
+type clusterNetworkSubnetReference struct {
+	Href *string `json:"href,omitempty"`
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type clusterNetworkInterfaceTarget struct {
+	Href         *string `json:"href,omitempty"`
+	ID           *string `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	ResourceType *string `json:"resource_type,omitempty"`
+}
+
+func ResourceIBMIsClusterNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsClusterNetworkInterfaceCreate,
+		ReadContext:   resourceIBMIsClusterNetworkInterfaceRead,
+		UpdateContext: resourceIBMIsClusterNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMIsClusterNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_network_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The cluster network identifier.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_cluster_network_interface", "name"),
+				Description:  "The name for this cluster network interface. The name must not be used by another interface in the cluster network.",
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The identifier of the cluster network subnet for this cluster network interface.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The primary IP for this cluster network interface.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The IP address to reserve, which must not already be reserved on the subnet. If unspecified, an available address on the subnet will automatically be selected.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The name for this cluster network subnet reserved IP.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this cluster network subnet reserved IP.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this cluster network subnet reserved IP.",
+						},
+					},
+				},
+			},
+			"allow_ip_spoofing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether source IP spoofing is allowed on this cluster network interface.",
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether this cluster network interface will be automatically deleted when the target is deleted.",
+			},
+			"cluster_network_interface_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this cluster network interface.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the cluster network interface was created.",
+			},
+			"enable_infrastructure_nat": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If true, the VPC infrastructure performs any needed NAT operations.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this cluster network interface.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the cluster network interface.",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the cluster network interface.",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The target of this cluster network interface, if any.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for the target.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the target.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for the target.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type of the target.",
+						},
+					},
+				},
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPC this cluster network interface resides in.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone this cluster network interface resides in.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsClusterNetworkInterfaceValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_cluster_network_interface", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsClusterNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID := d.Get("cluster_network_id").(string)
+	body := map[string]interface{}{
+		"subnet": map[string]string{"id": d.Get("subnet").(string)},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	primaryIP := map[string]interface{}{}
+	if address, ok := d.GetOk("primary_ip.0.address"); ok {
+		primaryIP["address"] = address.(string)
+	}
+	if name, ok := d.GetOk("primary_ip.0.name"); ok {
+		primaryIP["name"] = name.(string)
+	}
+	if len(primaryIP) > 0 {
+		body["primary_ip"] = primaryIP
+	}
+
+	networkInterface := &clusterNetworkInterface{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/cluster_networks/{cluster_network_id}/interfaces`,
+		map[string]string{"cluster_network_id": clusterNetworkID}, body, networkInterface)
+	if err != nil {
+		log.Printf("[DEBUG] CreateClusterNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateClusterNetworkInterfaceWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterNetworkID, *networkInterface.ID))
+
+	_, err = isWaitForClusterNetworkInterfaceStable(context, vpcClient, clusterNetworkID, *networkInterface.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsClusterNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsClusterNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID, id, err := clusterNetworkChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	networkInterface, response, err := getClusterNetworkInterface(context, vpcClient, clusterNetworkID, id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetClusterNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetClusterNetworkInterfaceWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("cluster_network_id", clusterNetworkID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cluster_network_id: %s", err))
+	}
+	if err = d.Set("cluster_network_interface_id", networkInterface.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cluster_network_interface_id: %s", err))
+	}
+	if err = d.Set("name", networkInterface.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if networkInterface.Subnet != nil {
+		if err = d.Set("subnet", networkInterface.Subnet.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting subnet: %s", err))
+		}
+	}
+	primaryIP := []map[string]interface{}{}
+	if networkInterface.PrimaryIP != nil {
+		primaryIP = append(primaryIP, map[string]interface{}{
+			"address": core.StringNilMapper(networkInterface.PrimaryIP.Address),
+			"name":    core.StringNilMapper(networkInterface.PrimaryIP.Name),
+			"href":    core.StringNilMapper(networkInterface.PrimaryIP.Href),
+			"id":      core.StringNilMapper(networkInterface.PrimaryIP.ID),
+		})
+	}
+	if err = d.Set("primary_ip", primaryIP); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting primary_ip: %s", err))
+	}
+	target := []map[string]interface{}{}
+	if networkInterface.Target != nil {
+		target = append(target, map[string]interface{}{
+			"href":          core.StringNilMapper(networkInterface.Target.Href),
+			"id":            core.StringNilMapper(networkInterface.Target.ID),
+			"name":          core.StringNilMapper(networkInterface.Target.Name),
+			"resource_type": core.StringNilMapper(networkInterface.Target.ResourceType),
+		})
+	}
+	if err = d.Set("target", target); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target: %s", err))
+	}
+	if networkInterface.VPC != nil {
+		if err = d.Set("vpc", networkInterface.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting vpc: %s", err))
+		}
+	}
+	if networkInterface.Zone != nil {
+		if err = d.Set("zone", networkInterface.Zone.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting zone: %s", err))
+		}
+	}
+	if err = d.Set("allow_ip_spoofing", networkInterface.AllowIPSpoofing); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_ip_spoofing: %s", err))
+	}
+	if err = d.Set("auto_delete", networkInterface.AutoDelete); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting auto_delete: %s", err))
+	}
+	if err = d.Set("created_at", networkInterface.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("enable_infrastructure_nat", networkInterface.EnableInfrastructureNat); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enable_infrastructure_nat: %s", err))
+	}
+	if err = d.Set("href", networkInterface.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", networkInterface.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("mac_address", networkInterface.MacAddress); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting mac_address: %s", err))
+	}
+	if err = d.Set("resource_type", networkInterface.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsClusterNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID, id, err := clusterNetworkChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		body := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/cluster_networks/{cluster_network_id}/interfaces/{id}`,
+			map[string]string{"cluster_network_id": clusterNetworkID, "id": id}, body, &clusterNetworkInterface{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateClusterNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateClusterNetworkInterfaceWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIsClusterNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsClusterNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID, id, err := clusterNetworkChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/cluster_networks/{cluster_network_id}/interfaces/{id}`,
+		map[string]string{"cluster_network_id": clusterNetworkID, "id": id}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteClusterNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteClusterNetworkInterfaceWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForClusterNetworkInterfaceDeleted(context, vpcClient, clusterNetworkID, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getClusterNetworkInterface(context context.Context, vpcClient *vpcv1.VpcV1, clusterNetworkID, id string) (*clusterNetworkInterface, *core.DetailedResponse, error) {
+	networkInterface := &clusterNetworkInterface{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/cluster_networks/{cluster_network_id}/interfaces/{id}`,
+		map[string]string{"cluster_network_id": clusterNetworkID, "id": id}, nil, networkInterface)
+	if err != nil {
+		return nil, response, err
+	}
+	return networkInterface, response, nil
+}
+
+func isWaitForClusterNetworkInterfaceStable(context context.Context, vpcClient *vpcv1.VpcV1, clusterNetworkID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network interface (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isClusterNetworkPending, isClusterNetworkUpdating, isClusterNetworkWaiting},
+		Target:  []string{isClusterNetworkStable, isClusterNetworkFailed},
+		Refresh: func() (interface{}, string, error) {
+			networkInterface, response, err := getClusterNetworkInterface(context, vpcClient, clusterNetworkID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting cluster network interface: %s\n%s", err, response)
+			}
+			if *networkInterface.LifecycleState == isClusterNetworkFailed {
+				return networkInterface, *networkInterface.LifecycleState, fmt.Errorf("[ERROR] Cluster network interface (%s) went into failed state", id)
+			}
+			return networkInterface, *networkInterface.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForClusterNetworkInterfaceDeleted(context context.Context, vpcClient *vpcv1.VpcV1, clusterNetworkID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network interface (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isClusterNetworkDeleting, isClusterNetworkStable, isClusterNetworkUpdating},
+		Target:  []string{isClusterNetworkDeleted, isClusterNetworkFailed},
+		Refresh: func() (interface{}, string, error) {
+			networkInterface, response, err := getClusterNetworkInterface(context, vpcClient, clusterNetworkID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return networkInterface, isClusterNetworkDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting cluster network interface: %s\n%s", err, response)
+			}
+			if *networkInterface.LifecycleState == isClusterNetworkFailed {
+				return networkInterface, *networkInterface.LifecycleState, fmt.Errorf("[ERROR] Cluster network interface (%s) failed to delete", id)
+			}
+			return networkInterface, *networkInterface.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// clusterNetworkSubnet is a subnet of a cluster network as returned by the VPC API.
+type clusterNetworkSubnet struct {
+	AvailableIpv4AddressCount *int64                          `json:"available_ipv4_address_count,omitempty"`
+	CreatedAt                 *string                         `json:"created_at,omitempty"`
+	Href                      *string                         `json:"href,omitempty"`
+	ID                        *string                         `json:"id,omitempty"`
+	IPVersion                 *string                         `json:"ip_version,omitempty"`
+	Ipv4CIDRBlock             *string                         `json:"ipv4_cidr_block,omitempty"`
+	LifecycleState            *string                         `json:"lifecycle_state,omitempty"`
+	LifecycleReasons          []clusterNetworkLifecycleReason `json:"lifecycle_reasons,omitempty"`
+	Name                      *string                         `json:"name,omitempty"`
+	ResourceType              *string                         `json:"resource_type,omitempty"`
+	TotalIpv4AddressCount     *int64                          `json:"total_ipv4_address_count,omitempty"`
+}
+
+func ResourceIBMIsClusterNetworkSubnet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsClusterNetworkSubnetCreate,
+		ReadContext:   resourceIBMIsClusterNetworkSubnetRead,
+		UpdateContext: resourceIBMIsClusterNetworkSubnetUpdate,
+		DeleteContext: resourceIBMIsClusterNetworkSubnetDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_network_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The cluster network identifier.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_cluster_network_subnet", "name"),
+				Description:  "The name for this cluster network subnet. The name must not be used by another subnet in the cluster network.",
+			},
+			"ip_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_cluster_network_subnet", "ip_version"),
+				Description:  "The IP version for this cluster network subnet.",
+			},
+			"ipv4_cidr_block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"total_ipv4_address_count"},
+				Description:   "The IPv4 range of this cluster network subnet, expressed in CIDR format.",
+			},
+			"total_ipv4_address_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ipv4_cidr_block"},
+				Description:   "The total number of IPv4 addresses in this cluster network subnet, the range is allocated from the cluster network subnet prefixes.",
+			},
+			"available_ipv4_address_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of IPv4 addresses in this cluster network subnet that are not in use, and have not been reserved by the user or the provider.",
+			},
+			"cluster_network_subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this cluster network subnet.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the cluster network subnet was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this cluster network subnet.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the cluster network subnet.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsClusterNetworkSubnetValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "ip_version",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ipv4",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_cluster_network_subnet", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsClusterNetworkSubnetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID := d.Get("cluster_network_id").(string)
+	body := map[string]interface{}{}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	if ipVersion, ok := d.GetOk("ip_version"); ok {
+		body["ip_version"] = ipVersion.(string)
+	}
+	if cidr, ok := d.GetOk("ipv4_cidr_block"); ok {
+		body["ipv4_cidr_block"] = cidr.(string)
+	}
+	if count, ok := d.GetOk("total_ipv4_address_count"); ok {
+		body["total_ipv4_address_count"] = count.(int)
+	}
+
+	subnet := &clusterNetworkSubnet{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/cluster_networks/{cluster_network_id}/subnets`,
+		map[string]string{"cluster_network_id": clusterNetworkID}, body, subnet)
+	if err != nil {
+		log.Printf("[DEBUG] CreateClusterNetworkSubnetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateClusterNetworkSubnetWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterNetworkID, *subnet.ID))
+
+	_, err = isWaitForClusterNetworkSubnetStable(context, vpcClient, clusterNetworkID, *subnet.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsClusterNetworkSubnetRead(context, d, meta)
+}
+
+func resourceIBMIsClusterNetworkSubnetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID, id, err := clusterNetworkChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnet, response, err := getClusterNetworkSubnet(context, vpcClient, clusterNetworkID, id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetClusterNetworkSubnetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetClusterNetworkSubnetWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("cluster_network_id", clusterNetworkID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cluster_network_id: %s", err))
+	}
+	if err = d.Set("cluster_network_subnet_id", subnet.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cluster_network_subnet_id: %s", err))
+	}
+	if err = d.Set("name", subnet.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("ip_version", subnet.IPVersion); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ip_version: %s", err))
+	}
+	if err = d.Set("ipv4_cidr_block", subnet.Ipv4CIDRBlock); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ipv4_cidr_block: %s", err))
+	}
+	if err = d.Set("total_ipv4_address_count", flex.IntValue(subnet.TotalIpv4AddressCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_ipv4_address_count: %s", err))
+	}
+	if err = d.Set("available_ipv4_address_count", flex.IntValue(subnet.AvailableIpv4AddressCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting available_ipv4_address_count: %s", err))
+	}
+	if err = d.Set("created_at", subnet.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", subnet.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", subnet.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", subnet.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsClusterNetworkSubnetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID, id, err := clusterNetworkChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		body := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/cluster_networks/{cluster_network_id}/subnets/{id}`,
+			map[string]string{"cluster_network_id": clusterNetworkID, "id": id}, body, &clusterNetworkSubnet{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateClusterNetworkSubnetWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateClusterNetworkSubnetWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIsClusterNetworkSubnetRead(context, d, meta)
+}
+
+func resourceIBMIsClusterNetworkSubnetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNetworkID, id, err := clusterNetworkChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/cluster_networks/{cluster_network_id}/subnets/{id}`,
+		map[string]string{"cluster_network_id": clusterNetworkID, "id": id}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteClusterNetworkSubnetWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteClusterNetworkSubnetWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForClusterNetworkSubnetDeleted(context, vpcClient, clusterNetworkID, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getClusterNetworkSubnet(context context.Context, vpcClient *vpcv1.VpcV1, clusterNetworkID, id string) (*clusterNetworkSubnet, *core.DetailedResponse, error) {
+	subnet := &clusterNetworkSubnet{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/cluster_networks/{cluster_network_id}/subnets/{id}`,
+		map[string]string{"cluster_network_id": clusterNetworkID, "id": id}, nil, subnet)
+	if err != nil {
+		return nil, response, err
+	}
+	return subnet, response, nil
+}
+
+func isWaitForClusterNetworkSubnetStable(context context.Context, vpcClient *vpcv1.VpcV1, clusterNetworkID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network subnet (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isClusterNetworkPending, isClusterNetworkUpdating, isClusterNetworkWaiting},
+		Target:  []string{isClusterNetworkStable, isClusterNetworkFailed},
+		Refresh: func() (interface{}, string, error) {
+			subnet, response, err := getClusterNetworkSubnet(context, vpcClient, clusterNetworkID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting cluster network subnet: %s\n%s", err, response)
+			}
+			if *subnet.LifecycleState == isClusterNetworkFailed {
+				return subnet, *subnet.LifecycleState, fmt.Errorf("[ERROR] Cluster network subnet (%s) went into failed state", id)
+			}
+			return subnet, *subnet.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForClusterNetworkSubnetDeleted(context context.Context, vpcClient *vpcv1.VpcV1, clusterNetworkID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network subnet (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isClusterNetworkDeleting, isClusterNetworkStable, isClusterNetworkUpdating},
+		Target:  []string{isClusterNetworkDeleted, isClusterNetworkFailed},
+		Refresh: func() (interface{}, string, error) {
+			subnet, response, err := getClusterNetworkSubnet(context, vpcClient, clusterNetworkID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return subnet, isClusterNetworkDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting cluster network subnet: %s\n%s", err, response)
+			}
+			if *subnet.LifecycleState == isClusterNetworkFailed {
+				return subnet, *subnet.LifecycleState, fmt.Errorf("[ERROR] Cluster network subnet (%s) failed to delete", id)
+			}
+			return subnet, *subnet.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// clusterNetworkChildID splits a clusterNetworkID/childID resource ID.
+func clusterNetworkChildID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of clusterNetworkID/ID", id)
+	}
+	return parts[0], parts[1], nil
+}
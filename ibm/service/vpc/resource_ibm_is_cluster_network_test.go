@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsClusterNetworkBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-cn-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-cn-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsClusterNetworkConfig(vpcname, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_cluster_network.is_cluster_network", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network.is_cluster_network", "profile", acc.ISClusterNetworkProfileName),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network.is_cluster_network", "zone", acc.ISZoneName),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network.is_cluster_network", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network.is_cluster_network", "subnet_prefixes.0.cidr", "10.1.0.0/16"),
+					resource.TestCheckResourceAttrSet("ibm_is_cluster_network.is_cluster_network", "crn"),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network_subnet.is_cluster_network_subnet", "total_ipv4_address_count", "64"),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network_subnet.is_cluster_network_subnet", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_cluster_network_subnet.is_cluster_network_subnet", "ipv4_cidr_block"),
+					resource.TestCheckResourceAttrPair("ibm_is_cluster_network_interface.is_cluster_network_interface", "subnet", "ibm_is_cluster_network_subnet.is_cluster_network_subnet", "cluster_network_subnet_id"),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network_interface.is_cluster_network_interface", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_cluster_network_interface.is_cluster_network_interface", "primary_ip.0.address"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsClusterNetworkConfig(vpcname, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_cluster_network.is_cluster_network", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network_subnet.is_cluster_network_subnet", "name", fmt.Sprintf("%s-subnet", nameUpdate)),
+					resource.TestCheckResourceAttr("ibm_is_cluster_network_interface.is_cluster_network_interface", "name", fmt.Sprintf("%s-interface", nameUpdate)),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_cluster_network.is_cluster_network",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ibm_is_cluster_network_subnet.is_cluster_network_subnet",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ibm_is_cluster_network_interface.is_cluster_network_interface",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsClusterNetworkConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_cluster_network" "is_cluster_network" {
+		name    = "%s"
+		profile = "%s"
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		subnet_prefixes {
+			cidr = "10.1.0.0/16"
+		}
+	}
+
+	resource "ibm_is_cluster_network_subnet" "is_cluster_network_subnet" {
+		cluster_network_id       = ibm_is_cluster_network.is_cluster_network.id
+		name                     = "%s-subnet"
+		total_ipv4_address_count = 64
+	}
+
+	resource "ibm_is_cluster_network_interface" "is_cluster_network_interface" {
+		cluster_network_id = ibm_is_cluster_network.is_cluster_network.id
+		name               = "%s-interface"
+		subnet             = ibm_is_cluster_network_subnet.is_cluster_network_subnet.cluster_network_subnet_id
+	}
+	`, vpcname, name, acc.ISClusterNetworkProfileName, acc.ISZoneName, name, name)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -69,6 +70,12 @@ func ResourceIbmIsDedicatedHost() *schema.Resource {
 				Default:     true,
 				Description: "If set to true, instances can be placed on this dedicated host.",
 			},
+			"migrate_instances_on_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, instances placed on this dedicated host are stopped, moved to the other hosts of the dedicated host group and restarted when the dedicated host is deleted.",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -545,6 +552,9 @@ func resourceIbmIsDedicatedHostRead(context context.Context, d *schema.ResourceD
 	if err = d.Set("instance_placement_enabled", dedicatedHost.InstancePlacementEnabled); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instance_placement_enabled: %s", err))
 	}
+	if err = d.Set("migrate_instances_on_delete", d.Get("migrate_instances_on_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting migrate_instances_on_delete: %s", err))
+	}
 	instances := []map[string]interface{}{}
 	for _, instancesItem := range dedicatedHost.Instances {
 		instancesItemMap := resourceIbmIsDedicatedHostInstanceReferenceToMap(instancesItem)
@@ -740,6 +750,21 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 			return diag.FromErr(err)
 		}
 	}
+	if dedicatedHost != nil && len(dedicatedHost.Instances) > 0 {
+		if !d.Get("migrate_instances_on_delete").(bool) {
+			instanceIDs := []string{}
+			for _, instance := range dedicatedHost.Instances {
+				instanceIDs = append(instanceIDs, *instance.ID)
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Dedicated host (%s) still has instances %s, move them to another host or set migrate_instances_on_delete to true", d.Id(), strings.Join(instanceIDs, ", ")))
+		}
+		for _, instance := range dedicatedHost.Instances {
+			err = isDedicatedHostMigrateInstance(vpcClient, d, *instance.ID, *dedicatedHost.Group.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 	deleteDedicatedHostOptions := &vpcv1.DeleteDedicatedHostOptions{}
 
 	deleteDedicatedHostOptions.SetID(d.Id())
@@ -759,6 +784,68 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	return nil
 }
 
+// isDedicatedHostMigrateInstance moves an instance to the dedicated host group of its host. The
+// instance must be stopped for the move and is started again if it was running.
+func isDedicatedHostMigrateInstance(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, groupID string) error {
+	instance, response, err := instanceC.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+	}
+	isRunning := *instance.Status == isInstanceStatusRunning
+	if isRunning {
+		actiontype := "stop"
+		_, response, err = instanceC.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &actiontype,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+		}
+		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	instancePatchModel := &vpcv1.InstancePatch{
+		PlacementTarget: &vpcv1.InstancePlacementTargetPatch{
+			ID: &groupID,
+		},
+	}
+	instancePatch, err := instancePatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
+	}
+	_, response, err = instanceC.UpdateInstance(&vpcv1.UpdateInstanceOptions{
+		ID:            &id,
+		InstancePatch: instancePatch,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error moving Instance (%s) to dedicated host group (%s): %s\n%s", id, groupID, err, response)
+	}
+
+	if isRunning {
+		actiontype := "start"
+		_, response, err = instanceC.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &actiontype,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+		}
+		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -48,6 +49,43 @@ func TestAccIbmIsDedicatedHostBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmIsDedicatedHostMigrateInstancesOnDelete(t *testing.T) {
+	var conf vpcv1.DedicatedHost
+	groupname := fmt.Sprintf("tf-dhostgroup%d", acctest.RandIntRange(10, 100))
+	dhname := fmt.Sprintf("tf-dhost%d", acctest.RandIntRange(10, 100))
+	dhname2 := fmt.Sprintf("tf-dhost2%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("tf-vpc%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh%d", acctest.RandIntRange(10, 100))
+	instancename := fmt.Sprintf("tf-instance%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	resname := "ibm_is_dedicated_host.dhost"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIsDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsDedicatedHostConfigMigrate(acc.DedicatedHostGroupClass, acc.DedicatedHostGroupFamily, groupname, acc.DedicatedHostProfileName, dhname, dhname2, vpcname, subnetname, sshname, publicKey, instancename, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsDedicatedHostExists(resname, conf),
+					resource.TestCheckResourceAttr(resname, "name", dhname),
+					resource.TestCheckResourceAttr(resname, "migrate_instances_on_delete", "true"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_instance", "dedicated_host", resname, "id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIsDedicatedHostConfigMigrate(acc.DedicatedHostGroupClass, acc.DedicatedHostGroupFamily, groupname, acc.DedicatedHostProfileName, dhname, dhname2, vpcname, subnetname, sshname, publicKey, instancename, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsDedicatedHostInstanceMigrated("ibm_is_instance.testacc_instance", "ibm_is_dedicated_host_group.is_dedicated_host_group"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIsDedicatedHostConfigBasic(class string, family string, groupname string, profile string, dhname string) string {
 	return fmt.Sprintf(`
 	
@@ -70,6 +108,108 @@ func testAccCheckIbmIsDedicatedHostConfigBasic(class string, family string, grou
 	`, class, family, groupname, profile, dhname)
 }
 
+func testAccCheckIbmIsDedicatedHostConfigMigrate(class, family, groupname, profile, dhname, dhname2, vpcname, subnetname, sshname, publicKey, instancename string, withHost bool) string {
+	dhost := ""
+	dedicatedHost := ""
+	if withHost {
+		dhost = fmt.Sprintf(`
+	resource "ibm_is_dedicated_host" "dhost" {
+		profile = "%s"
+		host_group = ibm_is_dedicated_host_group.is_dedicated_host_group.id
+		name = "%s"
+		migrate_instances_on_delete = true
+	  }
+	`, profile, dhname)
+		dedicatedHost = "dedicated_host = ibm_is_dedicated_host.dhost.id"
+	}
+	return fmt.Sprintf(`
+	
+	data "ibm_resource_group" "default" {
+		is_default=true
+	}
+	resource "ibm_is_dedicated_host_group" "is_dedicated_host_group" {
+		class = "%s"
+		family = "%s"
+		name = "%s"
+		resource_group = data.ibm_resource_group.default.id
+		zone = "us-south-2"
+	}
+	%s
+	resource "ibm_is_dedicated_host" "dhost2" {
+		profile = "%s"
+		host_group = ibm_is_dedicated_host_group.is_dedicated_host_group.id
+		name = "%s"
+	  }
+
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "us-south-2"
+		total_ipv4_address_count = 16
+	  }
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		%s
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "us-south-2"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		lifecycle {
+		  ignore_changes = [dedicated_host, dedicated_host_group]
+		}
+		depends_on = [ibm_is_dedicated_host.dhost2]
+	  }
+	`, class, family, groupname, dhost, profile, dhname2, vpcname, subnetname, sshname, publicKey, instancename, acc.IsImage, acc.InstanceProfileName, dedicatedHost)
+}
+
+func testAccCheckIbmIsDedicatedHostInstanceMigrated(instance, group string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[instance]
+		if !ok {
+			return fmt.Errorf("Not found: %s", instance)
+		}
+		grs, ok := s.RootModule().Resources[group]
+		if !ok {
+			return fmt.Errorf("Not found: %s", group)
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		getInstanceOptions := &vpcv1.GetInstanceOptions{}
+		getInstanceOptions.SetID(rs.Primary.ID)
+		instanceC, _, err := vpcClient.GetInstance(getInstanceOptions)
+		if err != nil {
+			return err
+		}
+
+		placementTarget, ok := instanceC.PlacementTarget.(*vpcv1.InstancePlacementTarget)
+		if !ok || placementTarget.ID == nil || *placementTarget.ID != grs.Primary.ID {
+			return fmt.Errorf("Instance %s was not moved to dedicated host group %s", rs.Primary.ID, grs.Primary.ID)
+		}
+		if instanceC.Status == nil || *instanceC.Status != "running" {
+			return fmt.Errorf("Instance %s is not running after the move", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIbmIsDedicatedHostExists(n string, obj vpcv1.DedicatedHost) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
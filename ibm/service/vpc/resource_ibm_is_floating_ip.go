@@ -9,6 +9,7 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -49,6 +50,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -77,7 +79,8 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 							if err != nil {
 								return err
 							}
-							if checkIfZoneChanged(old.(string), new.(string), diff.Get(isFloatingIPZone).(string), sess) {
+							vpcID := floatingIPTargetVPC(diff.Get(floatingIPTargets).([]interface{}), sess)
+							if checkIfZoneChanged(old.(string), new.(string), diff.Get(isFloatingIPZone).(string), vpcID, sess) {
 								diff.ForceNew(isFloatingIPTarget)
 							}
 						}
@@ -371,7 +374,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForInstanceFloatingIP(sess, d.Id(), d)
+	_, err = isWaitForInstanceFloatingIP(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -546,8 +549,17 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
+			if d.HasChange(isFloatingIPTarget) {
+				return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s\nA floating IP can only be rebound to a target in its zone %s, replace it to move it to another zone", err, response, d.Get(isFloatingIPZone).(string))
+			}
 			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
 		}
+		if d.HasChange(isFloatingIPTarget) {
+			_, err = isWaitForInstanceFloatingIP(sess, id, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -649,14 +661,14 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isFloatingIPPending},
 		Target:     []string{isFloatingIPAvailable, ""},
 		Refresh:    isInstanceFloatingIPRefreshFunc(floatingipC, id),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -682,44 +694,120 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 	}
 }
 
-func checkIfZoneChanged(oldNic, newNic, currentZone string, floatingipC *vpcv1.VpcV1) bool {
-	var oldZone, newZone string
-	listInstancesOptions := &vpcv1.ListInstancesOptions{}
+// checkIfZoneChanged reports whether the new target is in another zone than the floating IP, in which case the
+// floating IP can't be rebound and has to be replaced. Targets in the same zone are rebound in place.
+func checkIfZoneChanged(oldNic, newNic, currentZone, vpcID string, floatingipC *vpcv1.VpcV1) bool {
+	if newNic == "" || currentZone == "" {
+		return false
+	}
+	newZone := floatingIPTargetZone(newNic, vpcID, floatingipC)
+	if newZone == "" {
+		// the zone of the target isn't known at plan time, the update reports a target in another zone
+		log.Printf("[DEBUG] Zone of floating IP target %s not found, previous target %s", newNic, oldNic)
+		return false
+	}
+	return newZone != currentZone
+}
+
+// floatingIPTargetVPC returns the VPC of the current target of the floating IP, with a single GET of the instance,
+// bare metal server or virtual network interface in the href of the target. It is empty when there is no target.
+func floatingIPTargetVPC(targets []interface{}, floatingipC *vpcv1.VpcV1) string {
+	if len(targets) == 0 {
+		return ""
+	}
+	target, ok := targets[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	href, _ := target[floatingIPTargetsHref].(string)
+	parts := strings.Split(href, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		id := parts[i+1]
+		switch parts[i] {
+		case "instances":
+			instance, _, err := floatingipC.GetInstance(&vpcv1.GetInstanceOptions{ID: &id})
+			if err == nil && instance.VPC != nil && instance.VPC.ID != nil {
+				return *instance.VPC.ID
+			}
+			return ""
+		case "bare_metal_servers":
+			server, _, err := floatingipC.GetBareMetalServer(&vpcv1.GetBareMetalServerOptions{ID: &id})
+			if err == nil && server.VPC != nil && server.VPC.ID != nil {
+				return *server.VPC.ID
+			}
+			return ""
+		case "virtual_network_interfaces":
+			vni, _, err := floatingipC.GetVirtualNetworkInterface(&vpcv1.GetVirtualNetworkInterfaceOptions{ID: &id})
+			if err == nil && vni.VPC != nil && vni.VPC.ID != nil {
+				return *vni.VPC.ID
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// floatingIPTargetZone returns the zone of the new target with a single GET for a virtual network interface. An
+// instance or bare metal server network interface can't be read by its ID alone, it is looked up among the
+// instances and bare metal servers of the VPC of the current target, never across the account. The zone is empty
+// when the target isn't found.
+func floatingIPTargetZone(target, vpcID string, floatingipC *vpcv1.VpcV1) string {
+	vni, _, err := floatingipC.GetVirtualNetworkInterface(&vpcv1.GetVirtualNetworkInterfaceOptions{
+		ID: &target,
+	})
+	if err == nil && vni != nil && vni.Zone != nil {
+		return *vni.Zone.Name
+	}
+	if vpcID == "" {
+		return ""
+	}
+
 	start := ""
-	allrecs := []vpcv1.Instance{}
 	for {
-
+		listInstancesOptions := &vpcv1.ListInstancesOptions{VPCID: &vpcID}
 		if start != "" {
 			listInstancesOptions.Start = &start
 		}
-
 		instances, _, err := floatingipC.ListInstances(listInstancesOptions)
 		if err != nil {
-			return false
+			return ""
+		}
+		for _, instance := range instances.Instances {
+			for _, nic := range instance.NetworkInterfaces {
+				if nic.ID != nil && target == *nic.ID {
+					return *instance.Zone.Name
+				}
+			}
 		}
 		start = flex.GetNext(instances.Next)
-		allrecs = append(allrecs, instances.Instances...)
 		if start == "" {
 			break
 		}
 	}
-	for _, instance := range allrecs {
-		for _, nic := range instance.NetworkInterfaces {
-			if oldNic == *nic.ID {
-				oldZone = *instance.Zone.Name
-			}
-			if newNic == *nic.ID {
-				newZone = *instance.Zone.Name
+
+	start = ""
+	for {
+		listBareMetalServersOptions := &vpcv1.ListBareMetalServersOptions{VPCID: &vpcID}
+		if start != "" {
+			listBareMetalServersOptions.Start = &start
+		}
+		servers, _, err := floatingipC.ListBareMetalServers(listBareMetalServersOptions)
+		if err != nil {
+			return ""
+		}
+		for _, server := range servers.BareMetalServers {
+			for _, nic := range server.NetworkInterfaces {
+				if nic.ID != nil && target == *nic.ID {
+					return *server.Zone.Name
+				}
 			}
 		}
-	}
-	if newZone != oldZone {
-		if oldZone == "" && newZone == currentZone {
-			return false
+		start = flex.GetNext(servers.Next)
+		if start == "" {
+			break
 		}
-		return true
 	}
-	return false
+	return ""
 }
 
 func floatingIPCollectionFloatingIpTargetToMap(targetItemIntf vpcv1.FloatingIPTargetIntf) (targetId string, targetMap map[string]interface{}) {
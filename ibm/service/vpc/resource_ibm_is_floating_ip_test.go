@@ -97,6 +97,64 @@ func TestAccIBMISFloatingIP_NoTarget(t *testing.T) {
 	})
 }
 
+func TestAccIBMISFloatingIP_retarget(t *testing.T) {
+	var ip string
+	var fipID string
+	vpcname := fmt.Sprintf("tfip-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfip-%d", acctest.RandIntRange(10, 100))
+	instancename := fmt.Sprintf("tfip-instance-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfip-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tfip-sshname-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISFloatingIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISFloatingIPRetargetConfig(vpcname, subnetname, sshname, publicKey, instancename, name, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISFloatingIPExists("ibm_is_floating_ip.testacc_floatingip", ip),
+					testAccCheckIBMISFloatingIPSameID("ibm_is_floating_ip.testacc_floatingip", &fipID),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_floating_ip.testacc_floatingip", "target", "ibm_is_instance.primary", "primary_network_interface.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISFloatingIPRetargetConfig(vpcname, subnetname, sshname, publicKey, instancename, name, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISFloatingIPSameID("ibm_is_floating_ip.testacc_floatingip", &fipID),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_floating_ip.testacc_floatingip", "target", "ibm_is_instance.secondary", "primary_network_interface.0.id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_floating_ip.testacc_floatingip", "status", "available"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMISFloatingIPSameID records the floating IP ID on first use and fails if it changes afterwards
+func testAccCheckIBMISFloatingIPSameID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if *id == "" {
+			*id = rs.Primary.ID
+			return nil
+		}
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("Floating IP was replaced: %s is now %s", *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMISFloatingIPDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -187,3 +245,52 @@ func testAccCheckIBMISFloatingIPNoTargetConfig(name string) string {
 	  }
 `, name, acc.ISZoneName)
 }
+
+func testAccCheckIBMISFloatingIPRetargetConfig(vpcname, subnetname, sshname, publicKey, instancename, name, target string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	  resource "ibm_is_instance" "primary" {
+		name    = "%s-primary"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet     = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	  }
+
+	  resource "ibm_is_instance" "secondary" {
+		name    = "%s-secondary"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet     = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	  }
+
+	  resource "ibm_is_floating_ip" "testacc_floatingip" {
+		name   = "%s"
+		target = ibm_is_instance.%s.primary_network_interface[0].id
+	  }
+`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, instancename, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, instancename, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, name, target)
+}
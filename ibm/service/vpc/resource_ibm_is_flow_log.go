@@ -10,8 +10,11 @@ import (
 	"os"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -256,6 +259,9 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
 	if err != nil {
+		if authErr := flowLogCheckStorageAuthorization(meta); authErr != nil {
+			return fmt.Errorf("Create Flow Log Collector err %s\n%s\n%s", err, authErr, response)
+		}
 		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
 	}
 	d.SetId(*flowlogCollector.ID)
@@ -282,6 +288,51 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 	return resourceIBMISFlowLogRead(d, meta)
 }
 
+// flowLogCheckStorageAuthorization returns an error explaining the missing service authorization
+// when no authorization policy of the account lets flow log collectors write to Cloud Object
+// Storage. It isn't run at plan time, as the policy is often created in the same configuration.
+func flowLogCheckStorageAuthorization(meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil
+	}
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(userDetails.UserAccount),
+		Type:      core.StringPtr("authorization"),
+	}
+	policyList, response, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil || policyList == nil {
+		// without access to the policies the cause can't be told
+		log.Printf("[DEBUG] Error listing authorization policies: %s\n%s", err, response)
+		return nil
+	}
+
+	for _, policy := range policyList.Policies {
+		if len(policy.Subjects) == 0 || len(policy.Resources) == 0 {
+			continue
+		}
+		source := policy.Subjects[0]
+		target := policy.Resources[0]
+		sourceResourceType := *flex.GetSubjectAttribute("resourceType", source)
+		if *flex.GetSubjectAttribute("serviceName", source) != "is" ||
+			(sourceResourceType != "" && sourceResourceType != "flow-log-collector") ||
+			*flex.GetResourceAttribute("serviceName", target) != "cloud-object-storage" {
+			continue
+		}
+		for _, role := range policy.Roles {
+			if role.DisplayName != nil && (*role.DisplayName == "Writer" || *role.DisplayName == "Manager") {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("No IAM service authorization grants flow log collectors (service is, resource type flow-log-collector) " +
+		"Writer access to cloud-object-storage. Create one, for example with the ibm_iam_authorization_policy resource, before the flow log collector")
+}
+
 func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 
 	sess, err := vpcClient(meta)
@@ -75,6 +75,10 @@ func ResourceIBMISImage() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISImageValidateLifecycleAt(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -119,16 +123,20 @@ func ResourceIBMISImage() *schema.Resource {
 				Description: "Set to obsolete. You can set an image to `obsolete` as a warning to transition away from soon-to-be deleted images. You can't use obsolete images to provision resources.",
 			},
 			isImageDeprecationAt: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateImageLifecycleAt,
+				DiffSuppressFunc: suppressEquivalentImageLifecycleAt,
+				Description:      "The deprecation date and time (UTC) for this image. If absent, no deprecation date and time has been set.",
 			},
 			isImageObsolescenceAt: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateImageLifecycleAt,
+				DiffSuppressFunc: suppressEquivalentImageLifecycleAt,
+				Description:      "The obsolescence date and time (UTC) for this image. If absent, no obsolescence date and time has been set.",
 			},
 
 			isImageEncryptionKey: {
@@ -287,6 +295,53 @@ func ResourceIBMISImageValidator() *validate.ResourceValidator {
 	return &ibmISImageResourceValidator
 }
 
+// validateImageLifecycleAt accepts an RFC 3339 date and time, or "null" to remove a scheduled one.
+func validateImageLifecycleAt(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" || value == "null" {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an RFC 3339 date and time like 2024-01-02T15:04:05Z, or null: %s", k, err))
+	}
+	return
+}
+
+// suppressEquivalentImageLifecycleAt ignores the difference between the configured date and time and the
+// one returned by the API, which always has milliseconds.
+func suppressEquivalentImageLifecycleAt(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func resourceIBMISImageValidateLifecycleAt(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown(isImageDeprecationAt) || !diff.NewValueKnown(isImageObsolescenceAt) {
+		return nil
+	}
+	if !diff.HasChanges(isImageDeprecationAt, isImageObsolescenceAt) {
+		return nil
+	}
+	deprecationAt, err := time.Parse(time.RFC3339, diff.Get(isImageDeprecationAt).(string))
+	if err != nil {
+		return nil
+	}
+	obsolescenceAt, err := time.Parse(time.RFC3339, diff.Get(isImageObsolescenceAt).(string))
+	if err != nil {
+		return nil
+	}
+	if !obsolescenceAt.After(deprecationAt) {
+		return fmt.Errorf("[ERROR] %s (%s) must be later than %s (%s)", isImageObsolescenceAt, diff.Get(isImageObsolescenceAt).(string), isImageDeprecationAt, diff.Get(isImageDeprecationAt).(string))
+	}
+	return nil
+}
+
 func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Image create")
@@ -306,6 +361,16 @@ func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	if d.Get(isImageDeprecate).(bool) || d.Get(isImageObsolete).(bool) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		err = imgLifecycleActions(d, sess, d.Id())
+		if err != nil {
+			return err
+		}
+	}
 
 	return resourceIBMISImageRead(d, meta)
 }
@@ -553,12 +618,9 @@ func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceIBMISImageRead(d, meta)
 }
 
-func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNameChanged bool) error {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return err
-	}
-	if d.HasChange(isImageDeprecate) && !d.IsNewResource() {
+// imgLifecycleActions deprecates or obsoletes the image right away when deprecate or obsolete is set.
+func imgLifecycleActions(d *schema.ResourceData, sess *vpcv1.VpcV1, id string) error {
+	if d.HasChange(isImageDeprecate) {
 		deprecateTrue := d.Get(isImageDeprecate).(bool)
 		if deprecateTrue {
 			deprecateImageOptions := &vpcv1.DeprecateImageOptions{
@@ -574,7 +636,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 		}
 	}
-	if d.HasChange(isImageObsolete) && !d.IsNewResource() {
+	if d.HasChange(isImageObsolete) {
 		obsoleteTrue := d.Get(isImageObsolete).(bool)
 		if obsoleteTrue {
 			obsoleteImageOptions := &vpcv1.ObsoleteImageOptions{
@@ -590,6 +652,18 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			}
 		}
 	}
+	return nil
+}
+
+func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNameChanged bool) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	err = imgLifecycleActions(d, sess, id)
+	if err != nil {
+		return err
+	}
 	if d.HasChange(isImageTags) {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
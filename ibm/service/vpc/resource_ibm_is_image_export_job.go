@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +29,11 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 		DeleteContext: ResourceIBMIsImageExportDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"image": {
 				Type:        schema.TypeString,
@@ -78,6 +84,12 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_is_image_export_job", "name"),
 				Description:  "The user-defined name for this image export job. Names must be unique within the image this export job resides in. If unspecified, the name will be a hyphenated list of randomly-selected words prefixed with the first 16 characters of the parent image name.The exported image object name in Cloud Object Storage (`storage_object.name` in the response) will be based on this name. The object name will be unique within the bucket.",
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the image export job to succeed on create, the create fails if the job fails.",
+			},
 			"completed_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -224,6 +236,13 @@ func ResourceIBMIsImageExportCreate(context context.Context, d *schema.ResourceD
 
 	d.SetId(fmt.Sprintf("%s/%s", *createImageExportJobOptions.ImageID, *imageExportJob.ID))
 
+	if d.Get("wait_for_completion").(bool) {
+		_, err = isWaitForImageExportJobCompleted(context, vpcClient, *createImageExportJobOptions.ImageID, *imageExportJob.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ResourceIBMIsImageExportRead(context, d, meta)
 }
 
@@ -459,6 +478,43 @@ func ResourceIBMIsImageExportCloudObjectStorageObjectReferenceToMap(model *vpcv1
 	return modelMap, nil
 }
 
+func isWaitForImageExportJobCompleted(context context.Context, vpcClient *vpcv1.VpcV1, imageID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to be completed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", vpcv1.ImageExportJobStatusQueuedConst, vpcv1.ImageExportJobStatusRunningConst},
+		Target:     []string{vpcv1.ImageExportJobStatusSucceededConst},
+		Refresh:    isImageExportJobRefreshFunc(context, vpcClient, imageID, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isImageExportJobRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, imageID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getImgExpJobOptions := &vpcv1.GetImageExportJobOptions{}
+
+		getImgExpJobOptions.SetImageID(imageID)
+		getImgExpJobOptions.SetID(id)
+
+		imageExportJob, response, err := vpcClient.GetImageExportJobWithContext(context, getImgExpJobOptions)
+		if err != nil {
+			return imageExportJob, "", fmt.Errorf("[ERROR] Error Getting Image export job: %s\n%s", err, response)
+		}
+		if *imageExportJob.Status == vpcv1.ImageExportJobStatusFailedConst {
+			reasons := []string{}
+			for _, statusReason := range imageExportJob.StatusReasons {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", *statusReason.Code, *statusReason.Message))
+			}
+			return imageExportJob, *imageExportJob.Status, fmt.Errorf("[ERROR] Image export job (%s) failed: %s", id, strings.Join(reasons, ", "))
+		}
+		return imageExportJob, *imageExportJob.Status, nil
+	}
+}
+
 func isWaitForImageExportJobDeleted(context context.Context, d *schema.ResourceData, meta interface{}, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image export job (%s) to be deleted.", id)
 
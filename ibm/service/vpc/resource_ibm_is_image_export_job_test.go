@@ -68,6 +68,28 @@ func TestAccIBMIsImageExportAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMIsImageExportWaitForCompletion(t *testing.T) {
+	var conf vpcv1.ImageExportJob
+	name := fmt.Sprintf("tf-imageexport-job%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsImageExportDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsImageExportConfigWaitForCompletion(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsImageExportExists("ibm_is_image_export_job.is_image_export", conf),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.is_image_export", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.is_image_export", "completed_at"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.is_image_export", "storage_object.0.name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIsImageExportConfigBasic() string {
 	return fmt.Sprintf(`
 
@@ -159,3 +181,18 @@ func testAccCheckIBMIsImageExportDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMIsImageExportConfigWaitForCompletion(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_is_image_export_job" "is_image_export" {
+			image = "%s"
+			storage_bucket {
+				name = "%s"
+			}
+			format = "vhd"
+			name = "%s"
+			wait_for_completion = true
+		}
+	`, acc.IsImage, acc.IsCosBucketName, name)
+}
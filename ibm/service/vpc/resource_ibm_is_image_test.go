@@ -166,6 +166,41 @@ func TestAccIBMISImage_lifecycle_test_deprecate_obsolete(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISImage_lifecycle_invalid_order(t *testing.T) {
+	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckImage(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISImageLifecycleConfig(name, "2023-11-28T15:10:00.000Z", "2023-09-28T15:10:00.000Z"),
+				ExpectError: regexp.MustCompile("must be later than deprecation_at"),
+			},
+		},
+	})
+}
+func TestAccIBMISImage_lifecycle_deprecate_on_create(t *testing.T) {
+	var image string
+	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckImage(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImageLifecycleDeprecateConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISImageExists("ibm_is_image.isExampleImage", image),
+					resource.TestCheckResourceAttr(
+						"ibm_is_image.isExampleImage", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_image.isExampleImage", "status", "deprecated"),
+				),
+			},
+		},
+	})
+}
 func TestAccIBMISImage_error(t *testing.T) {
 	name := fmt.Sprintf("tfimg-name-%d", acctest.RandIntRange(10, 100))
 
@@ -14,7 +14,9 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -86,6 +88,7 @@ const (
 	isInstanceStatusRunning              = "running"
 	isInstanceStatusFailed               = "failed"
 	isInstanceAvailablePolicyHostFailure = "availability_policy_host_failure"
+	isInstanceAllowStopForResize         = "allow_stop_for_resize"
 
 	isInstanceBootAttachmentName       = "name"
 	isInstanceBootVolumeId             = "volume_id"
@@ -129,11 +132,11 @@ const (
 
 func ResourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMisInstanceCreate,
-		Read:   resourceIBMisInstanceRead,
-		Update: resourceIBMisInstanceUpdate,
-		Delete: resourceIBMisInstanceDelete,
-		Exists: resourceIBMisInstanceExists,
+		CreateContext: resourceIBMisInstanceCreate,
+		ReadContext:   resourceIBMisInstanceRead,
+		UpdateContext: resourceIBMisInstanceUpdate,
+		DeleteContext: resourceIBMisInstanceDelete,
+		Exists:        resourceIBMisInstanceExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
 				log.Printf("[INFO] Instance (%s) importing", d.Id())
@@ -182,6 +185,18 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMisInstanceProfileCustomizeDiff(diff)
+				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMisInstancePrimaryNetworkCustomizeDiff(diff)
+				}),
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMisInstanceSecurityModesCustomizeDiff(context, diff, v, isInstanceProfile)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -235,6 +250,12 @@ func ResourceIBMISInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Profile info",
 			},
+			isInstanceAllowStopForResize: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Allow the instance to be stopped and started again to change its profile, confidential compute mode or secure boot, if set to false changing them on a running instance fails",
+			},
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
 				Optional:     true,
@@ -383,7 +404,7 @@ func ResourceIBMISInstance() *schema.Resource {
 							Optional:      true,
 							ForceNew:      true,
 							ConflictsWith: []string{"catalog_offering.0.version_crn"},
-							RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceKeys, isInstanceVPC, isInstanceProfile},
+							RequiredWith:  []string{isInstanceZone, isInstanceKeys, isInstanceVPC, isInstanceProfile},
 							Description:   "Identifies a catalog offering by a unique CRN property",
 						},
 						isInstanceCatalogOfferingVersionCrn: {
@@ -391,7 +412,7 @@ func ResourceIBMISInstance() *schema.Resource {
 							Optional:      true,
 							ForceNew:      true,
 							ConflictsWith: []string{"catalog_offering.0.offering_crn"},
-							RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceKeys, isInstanceVPC, isInstanceProfile},
+							RequiredWith:  []string{isInstanceZone, isInstanceKeys, isInstanceVPC, isInstanceProfile},
 							Description:   "Identifies a version of a catalog offering by a unique CRN property",
 						},
 					},
@@ -503,6 +524,55 @@ func ResourceIBMISInstance() *schema.Resource {
 				},
 			},
 
+			isInstanceConfidentialComputeMode: instanceConfidentialComputeModeSchema(false),
+
+			isInstanceEnableSecureBoot: instanceEnableSecureBootSchema(false),
+
+			isInstanceReservationAffinity: reservationAffinitySchema(false),
+
+			isInstanceReservation: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reservation used by this instance, if any",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reservation",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this reservation",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reservation",
+						},
+					},
+				},
+			},
+
+			isInstanceClusterNetworkAttachments: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The cluster network attachments of the instance, in the order of their interfaces, changing them requires a stopped instance",
+				Elem:        instanceClusterNetworkAttachmentSchema(),
+			},
+
+			isInstancePrimaryNetworkAttachment: {
+				Type:          schema.TypeList,
+				MinItems:      1,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isInstancePrimaryNetworkInterface, isInstanceNetworkInterfaces},
+				Description:   "The primary network attachment, binding a virtual network interface to the instance",
+				Elem:          instancePrimaryNetworkAttachmentSchema(),
+			},
+
 			isInstanceNetworkInterfaces: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -608,7 +678,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 				AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
-				RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceKeys, isInstanceVPC, isInstanceProfile},
+				RequiredWith:  []string{isInstanceZone, isInstanceKeys, isInstanceVPC, isInstanceProfile},
 				Description:   "image id",
 			},
 
@@ -624,7 +694,7 @@ func ResourceIBMISInstance() *schema.Resource {
 							Optional:      true,
 							ForceNew:      true,
 							Computed:      true,
-							RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceProfile, isInstanceKeys, isInstanceVPC},
+							RequiredWith:  []string{isInstanceZone, isInstanceProfile, isInstanceKeys, isInstanceVPC},
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.volume_id", "boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn"},
 							ConflictsWith: []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot", "boot_volume.0.name", "boot_volume.0.encryption", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn"},
 							Description:   "The unique identifier for this volume",
@@ -644,7 +714,7 @@ func ResourceIBMISInstance() *schema.Resource {
 
 						isInstanceVolumeSnapshot: {
 							Type:          schema.TypeString,
-							RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceProfile, isInstanceKeys, isInstanceVPC},
+							RequiredWith:  []string{isInstanceZone, isInstanceProfile, isInstanceKeys, isInstanceVPC},
 							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot", "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 							ConflictsWith: []string{isInstanceImage, isInstanceSourceTemplate, "catalog_offering.0.offering_crn", "catalog_offering.0.version_crn", "boot_volume.0.volume_id"},
 							Optional:      true,
@@ -664,12 +734,18 @@ func ResourceIBMISInstance() *schema.Resource {
 							ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceBootSize),
 						},
 						isInstanceBootIOPS: {
-							Type:     schema.TypeInt,
-							Computed: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "boot_volume_iops"),
+							Description:  "The maximum I/O operations per second of the boot volume, only used with the custom profile",
 						},
 						isInstanceBootProfile: {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "boot_volume_profile"),
+							Description:  "The profile of the boot volume, it can be changed in place",
 						},
 						isInstanceBootVolumeTags: {
 							Type:        schema.TypeSet,
@@ -1058,6 +1134,21 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			MinValue:                   "1",
 			MaxValue:                   "250"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "boot_volume_profile",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "general-purpose, 5iops-tier, 10iops-tier, custom",
+		})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "boot_volume_iops",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "100",
+			MaxValue:                   "48000"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isInstanceAction,
@@ -1098,7 +1189,7 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 	return &ibmISInstanceValidator
 }
 
-func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1189,10 +1280,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 			}
 		}
 
-		volprof := "general-purpose"
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
+		volTemplate.Profile, volTemplate.Iops = instanceBootVolumeProfile(bootvol)
 		var userTags *schema.Set
 		if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
 			userTags = v.(*schema.Set)
@@ -1434,7 +1522,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := instanceCreateWithOptions(context, d, sess, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1468,7 +1556,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	}
 	return nil
 }
-func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, offerringCrn, versionCrn string) error {
+func instanceCreateByCatalogOffering(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, offerringCrn, versionCrn string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1574,10 +1662,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 			}
 		}
 
-		volprof := "general-purpose"
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
+		volTemplate.Profile, volTemplate.Iops = instanceBootVolumeProfile(bootvol)
 		deleteboolIntf := bootvol[isInstanceVolAttVolAutoDelete]
 		deletebool := deleteboolIntf.(bool)
 		instanceproto.BootVolumeAttachment = &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
@@ -1807,7 +1892,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := instanceCreateWithOptions(context, d, sess, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -1834,7 +1919,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 	return nil
 }
 
-func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
+func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1932,11 +2017,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 			}
 		}
 
-		volprof := "general-purpose"
-
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
+		volTemplate.Profile, volTemplate.Iops = instanceBootVolumeProfile(bootvol)
 		var userTags *schema.Set
 		if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
 			userTags = v.(*schema.Set)
@@ -2177,7 +2258,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := instanceCreateWithOptions(context, d, sess, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -2212,7 +2293,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	return nil
 }
 
-func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
+func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2291,10 +2372,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 				CRN: &encstr,
 			}
 		}
-		volprof := "general-purpose"
-		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
-			Name: &volprof,
-		}
+		volTemplate.Profile, volTemplate.Iops = instanceBootVolumeProfile(bootvol)
 		var userTags *schema.Set
 		if v, ok := bootvol[isInstanceBootVolumeTags]; ok {
 			userTags = v.(*schema.Set)
@@ -2550,7 +2628,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := instanceCreateWithOptions(context, d, sess, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -2585,7 +2663,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 	return nil
 }
 
-func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
+func instanceCreateByVolume(context context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2888,7 +2966,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := instanceCreateWithOptions(context, d, sess, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
 		return err
@@ -2923,7 +3001,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	return nil
 }
 
-func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	profile := d.Get(isInstanceProfile).(string)
 	name := d.Get(isInstanceName).(string)
@@ -2937,34 +3015,34 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		catalogOffering := catalogOfferingOk.([]interface{})[0].(map[string]interface{})
 		offeringCrn, _ := catalogOffering[isInstanceCatalogOfferingOfferingCrn].(string)
 		versionCrn, _ := catalogOffering[isInstanceCatalogOfferingVersionCrn].(string)
-		err := instanceCreateByCatalogOffering(d, meta, profile, name, vpcID, zone, image, offeringCrn, versionCrn)
+		err := instanceCreateByCatalogOffering(context, d, meta, profile, name, vpcID, zone, image, offeringCrn, versionCrn)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if volume != "" {
-		err := instanceCreateByVolume(d, meta, profile, name, vpcID, zone)
+		err := instanceCreateByVolume(context, d, meta, profile, name, vpcID, zone)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if snapshot != "" {
-		err := instanceCreateBySnapshot(d, meta, profile, name, vpcID, zone)
+		err := instanceCreateBySnapshot(context, d, meta, profile, name, vpcID, zone)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if template != "" {
-		err := instanceCreateByTemplate(d, meta, profile, name, vpcID, zone, image, template)
+		err := instanceCreateByTemplate(context, d, meta, profile, name, vpcID, zone, image, template)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		err := instanceCreateByImage(d, meta, profile, name, vpcID, zone, image)
+		err := instanceCreateByImage(context, d, meta, profile, name, vpcID, zone, image)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(d, meta)
+	return resourceIBMisInstanceUpdate(context, d, meta)
 }
 
 func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
@@ -3082,29 +3160,29 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 		}
 	}
 }
-func resourceIBMisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 
-	err := instanceGet(d, meta, ID)
+	err := instanceGet(context, d, meta, ID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceGet(context context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
-	getinsOptions := &vpcv1.GetInstanceOptions{
-		ID: &id,
-	}
 	getinsIniOptions := &vpcv1.GetInstanceInitializationOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	// the instance is read raw, so that the settings the vpc-go-sdk version used by the provider doesn't model, like
+	// its primary network attachment, cluster network attachments and reservation, are read from the same response
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context, instanceC, core.GET, `/instances/{id}`, map[string]string{"id": id}, nil, &result)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -3112,6 +3190,10 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		return fmt.Errorf("[ERROR] Error getting Instance: %s\n%s", err, response)
 	}
+	var instance *vpcv1.Instance
+	if err = vpcv1.UnmarshalInstance(result, &instance); err != nil {
+		return fmt.Errorf("[ERROR] Error getting Instance: %s", err)
+	}
 	instanceInitialization, response, err := instanceC.GetInstanceInitialization(getinsIniOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting Instance initialization details: %s\n%s", err, response)
@@ -3237,7 +3319,29 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		d.Set(isInstancePrimaryNetworkInterface, primaryNicList)
 	}
 
-	if instance.NetworkInterfaces != nil {
+	if instance.PrimaryNetworkInterface == nil {
+		err = instanceGetPrimaryNetworkAttachment(context, d, instanceC, result["primary_network_attachment"])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = instanceGetClusterNetworkAttachments(context, d, instanceC, result[isInstanceClusterNetworkAttachments])
+	if err != nil {
+		return err
+	}
+
+	err = instanceGetReservation(d, result)
+	if err != nil {
+		return err
+	}
+
+	err = instanceGetSecurityModes(d, result)
+	if err != nil {
+		return err
+	}
+
+	if instance.NetworkInterfaces != nil && instance.PrimaryNetworkInterface != nil {
 		interfacesList := make([]map[string]interface{}, 0)
 		for _, intfc := range instance.NetworkInterfaces {
 			if *intfc.ID != *instance.PrimaryNetworkInterface.ID {
@@ -3454,8 +3558,13 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 
 	placementTarget := []map[string]interface{}{}
 	if instance.PlacementTarget != nil {
-		placementTargetMap := resourceIbmIsInstanceInstancePlacementToMap(*instance.PlacementTarget.(*vpcv1.InstancePlacementTarget))
+		instancePlacementTarget := instance.PlacementTarget.(*vpcv1.InstancePlacementTarget)
+		placementTargetMap := resourceIbmIsInstanceInstancePlacementToMap(*instancePlacementTarget)
 		placementTarget = append(placementTarget, placementTargetMap)
+		// placement groups can't be changed, so the argument can be read back, e.g. on import
+		if instancePlacementTarget.ResourceType != nil && *instancePlacementTarget.ResourceType == "placement_group" {
+			d.Set(isPlacementTargetPlacementGroup, instancePlacementTarget.ID)
+		}
 	}
 	if err = d.Set(isInstancePlacementTarget, placementTarget); err != nil {
 		return fmt.Errorf("[ERROR] Error setting placement_target: %s", err)
@@ -3503,46 +3612,73 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	bootVolProfile := "boot_volume.0.profile"
+	bootVolIops := "boot_volume.0.iops"
+	if (d.HasChange(bootVolProfile) || d.HasChange(bootVolIops)) && !d.IsNewResource() {
+		volId := d.Get("boot_volume.0.volume_id").(string)
+		bootvol := d.Get(isInstanceBootVolume).([]interface{})[0].(map[string]interface{})
+		volumePatchModel := &vpcv1.VolumePatch{}
+		volumePatchModel.Profile, volumePatchModel.Iops = instanceBootVolumeProfile(bootvol)
+		volumePatch, err := volumePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err)
+		}
+		optionsget := &vpcv1.GetVolumeOptions{
+			ID: &volId,
+		}
+		_, response, err := instanceC.GetVolume(optionsget)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+		}
+		eTag := response.Headers.Get("ETag")
+		updateVolumeOptions := &vpcv1.UpdateVolumeOptions{
+			ID:          &volId,
+			IfMatch:     &eTag,
+			VolumePatch: volumePatch,
+		}
+		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
+		if vol == nil || err != nil {
+			return (fmt.Errorf("[ERROR] Error encountered while updating profile of boot volume of instance %s/n%s", err, res))
+		}
+		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
 	bootVolTags := "boot_volume.0.tags"
 	if d.HasChange(bootVolTags) && !d.IsNewResource() {
-		var userTags *schema.Set
-		if v, ok := d.GetOk("boot_volume.0.tags"); ok {
-			volId := d.Get("boot_volume.0.volume_id").(string)
-			updateVolumeOptions := &vpcv1.UpdateVolumeOptions{
-				ID: &volId,
-			}
-			userTags = v.(*schema.Set)
-			if userTags != nil && userTags.Len() != 0 {
-				userTagsArray := make([]string, userTags.Len())
-				for i, userTag := range userTags.List() {
-					userTagStr := userTag.(string)
-					userTagsArray[i] = userTagStr
-				}
-				volumePatchModel := &vpcv1.VolumePatch{}
-				volumePatchModel.UserTags = userTagsArray
-				volumePatch, err := volumePatchModel.AsPatch()
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err)
-				}
-				optionsget := &vpcv1.GetVolumeOptions{
-					ID: &volId,
-				}
-				_, response, err := instanceC.GetVolume(optionsget)
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
-				}
-				eTag := response.Headers.Get("ETag")
-				updateVolumeOptions.IfMatch = &eTag
-				updateVolumeOptions.VolumePatch = volumePatch
-				vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
-				if vol == nil || err != nil {
-					return (fmt.Errorf("[ERROR] Error encountered while applying tags for boot volume of instance %s/n%s", err, res))
-				}
-				_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
-				if err != nil {
-					return err
-				}
-			}
+		volId := d.Get("boot_volume.0.volume_id").(string)
+		updateVolumeOptions := &vpcv1.UpdateVolumeOptions{
+			ID: &volId,
+		}
+		userTags := d.Get(bootVolTags).(*schema.Set)
+		volumePatchModel := &vpcv1.VolumePatch{}
+		volumePatchModel.UserTags = flex.ExpandStringList(userTags.List())
+		volumePatch, err := volumePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err)
+		}
+		// user_tags is omitted from the patch when empty, removing all the tags needs it explicitly
+		if userTags.Len() == 0 {
+			volumePatch["user_tags"] = []string{}
+		}
+		optionsget := &vpcv1.GetVolumeOptions{
+			ID: &volId,
+		}
+		_, response, err := instanceC.GetVolume(optionsget)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+		}
+		eTag := response.Headers.Get("ETag")
+		updateVolumeOptions.IfMatch = &eTag
+		updateVolumeOptions.VolumePatch = volumePatch
+		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
+		if vol == nil || err != nil {
+			return (fmt.Errorf("[ERROR] Error encountered while applying tags for boot volume of instance %s/n%s", err, res))
+		}
+		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
 		}
 	}
 	bootVolAutoDel := "boot_volume.0.auto_delete_volume"
@@ -4105,9 +4241,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		updatedoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updatedoptions)
+		_, response, err := instanceC.UpdateInstance(updatedoptions)
 		if err != nil {
-			return err
+			return fmt.Errorf("[ERROR] Error updating the availability policy of instance (%s): %s\n%s", id, err, response)
 		}
 	}
 
@@ -4125,7 +4261,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
 		}
 
-		if instance != nil && *instance.Status == "running" {
+		wasRunning := instance != nil && *instance.Status == isInstanceStatusRunning
+		if wasRunning {
+			if !d.Get(isInstanceAllowStopForResize).(bool) {
+				return fmt.Errorf("[ERROR] Error updating the profile of instance (%s): the instance is running and %s is false, stop the instance or set %s to true", id, isInstanceAllowStopForResize, isInstanceAllowStopForResize)
+			}
 			actiontype := "stop"
 			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -4166,21 +4306,24 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
 		}
 
-		actiontype := "start"
-		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
-		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
+		// only start the instance again if it was stopped for the resize
+		if wasRunning {
+			actiontype := "start"
+			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+				InstanceID: &id,
+				Type:       &actiontype,
+			}
+			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil
+				}
+				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			}
+			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
-		if err != nil {
-			return err
 		}
 
 	}
@@ -4211,14 +4354,44 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMisInstanceProfileCustomizeDiff fails the plan when the profile, confidential compute mode or secure
+// boot of a running instance changes but the instance isn't allowed to be stopped for the update.
+func resourceIBMisInstanceProfileCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || diff.Get(isInstanceAllowStopForResize).(bool) {
+		return nil
+	}
+	if !diff.HasChange(isInstanceProfile) && !diff.HasChange(isInstanceConfidentialComputeMode) && !diff.HasChange(isInstanceEnableSecureBoot) {
+		return nil
+	}
+	if status, ok := diff.GetOk(isInstanceStatus); ok && status.(string) == isInstanceStatusRunning {
+		return fmt.Errorf("[ERROR] Changing the profile, confidential compute mode or secure boot of a running instance requires stopping it, set %s to true or stop the instance first", isInstanceAllowStopForResize)
+	}
+	return nil
+}
+
+func resourceIBMisInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	err := instanceUpdate(d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	err = instanceUpdateClusterNetworkAttachments(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = instanceUpdateReservationAffinity(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = instanceUpdateSecurityModes(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIBMisInstanceRead(d, meta)
+	return resourceIBMisInstanceRead(context, d, meta)
 }
 
 func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
@@ -4311,12 +4484,12 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	err := instanceDelete(d, meta, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -4619,3 +4792,871 @@ func GetInstanceMetadataServiceOptions(d *schema.ResourceData) (metadataService
 	}
 	return nil
 }
+
+// instanceBootVolumeProfile returns the profile of the boot volume, general-purpose unless set, and
+// the iops, which are only sent with the custom profile as the other profiles derive them from the capacity.
+func instanceBootVolumeProfile(bootvol map[string]interface{}) (*vpcv1.VolumeProfileIdentity, *int64) {
+	profile := "general-purpose"
+	if v, ok := bootvol[isInstanceBootProfile].(string); ok && v != "" {
+		profile = v
+	}
+	var iops *int64
+	if v, ok := bootvol[isInstanceBootIOPS].(int); ok && v != 0 && profile == "custom" {
+		iopsInt64 := int64(v)
+		iops = &iopsInt64
+	}
+	return &vpcv1.VolumeProfileIdentity{
+		Name: &profile,
+	}, iops
+}
+
+// network attachments
+const isInstancePrimaryNetworkAttachment = "primary_network_attachment"
+
+func instancePrimaryNetworkAttachmentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this instance network attachment",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateISName,
+				Description:  "The name for the network attachment",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The primary IP address of the virtual network interface for the network attachment",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reserved IP",
+						},
+					},
+				},
+			},
+			"virtual_network_interface": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The virtual network interface of the network attachment, specify id to attach an existing virtual network interface or subnet to create a new one",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"primary_network_attachment.0.virtual_network_interface.0.subnet"},
+							Description:   "The unique identifier of an existing virtual network interface to attach",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The name for the new virtual network interface",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The subnet of the new virtual network interface",
+						},
+						"allow_ip_spoofing": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "Indicates whether source IP spoofing is allowed on the new virtual network interface",
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "Indicates whether the new virtual network interface is deleted when the instance is deleted",
+						},
+						"enable_infrastructure_nat": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "If true, the VPC infrastructure performs any needed NAT operations, if false the packet is passed unchanged to the instance",
+						},
+						"protocol_state_filtering_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_virtual_network_interface", "protocol_state_filtering_mode"),
+							Description:  "The protocol state filtering mode of the new virtual network interface, one of auto, enabled or disabled",
+						},
+						"security_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The security groups of the new virtual network interface, the VPC default security group if not set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMisInstancePrimaryNetworkCustomizeDiff fails the plan of an instance that isn't created from
+// a template when it has neither a primary network interface nor a primary network attachment.
+func resourceIBMisInstancePrimaryNetworkCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if template := config.GetAttr(isInstanceSourceTemplate); !template.IsKnown() || !template.IsNull() {
+		return nil
+	}
+	for _, key := range []string{isInstancePrimaryNetworkInterface, isInstancePrimaryNetworkAttachment} {
+		if v := config.GetAttr(key); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+			return nil
+		}
+	}
+	return fmt.Errorf("[ERROR] One of %s or %s must be set when the instance isn't created from a %s", isInstancePrimaryNetworkInterface, isInstancePrimaryNetworkAttachment, isInstanceSourceTemplate)
+}
+
+// instanceCreateWithOptions creates the instance, the instances with a primary network attachment, cluster network
+// attachments, a reservation affinity, a confidential compute mode or secure boot are created with a raw request as
+// the vpc-go-sdk version used by the provider doesn't model them.
+func instanceCreateWithOptions(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+	_, primaryNetworkAttachment := d.GetOk(isInstancePrimaryNetworkAttachment)
+	_, clusterNetworkAttachments := d.GetOk(isInstanceClusterNetworkAttachments)
+	_, reservationAffinity := d.GetOk(isInstanceReservationAffinity)
+	securityModes := instanceSecurityModesConfigured(d)
+	if !primaryNetworkAttachment && !clusterNetworkAttachments && !reservationAffinity && !securityModes {
+		return sess.CreateInstance(options)
+	}
+
+	prototype, err := json.Marshal(options.InstancePrototype)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(prototype, &body); err != nil {
+		return nil, nil, err
+	}
+	if primaryNetworkAttachment {
+		delete(body, isInstancePrimaryNetworkInterface)
+		delete(body, isInstanceNetworkInterfaces)
+		attachment, err := expandInstancePrimaryNetworkAttachment(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		body[isInstancePrimaryNetworkAttachment] = attachment
+	}
+	if clusterNetworkAttachments {
+		attachments, err := expandInstanceClusterNetworkAttachments(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		body[isInstanceClusterNetworkAttachments] = attachments
+	}
+	if reservationAffinity {
+		body[isInstanceReservationAffinity] = expandReservationAffinity(d, isInstanceReservationAffinity)
+	}
+	expandInstanceSecurityModes(d, body)
+
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context, sess, core.POST, `/instances`, nil, body, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	var instance *vpcv1.Instance
+	if err = vpcv1.UnmarshalInstance(result, &instance); err != nil {
+		return nil, response, err
+	}
+	return instance, response, nil
+}
+
+func expandInstancePrimaryNetworkAttachment(d *schema.ResourceData) (map[string]interface{}, error) {
+	prefix := isInstancePrimaryNetworkAttachment + ".0.virtual_network_interface.0."
+	attachment := map[string]interface{}{}
+	if name, ok := d.GetOk(isInstancePrimaryNetworkAttachment + ".0.name"); ok {
+		attachment["name"] = name.(string)
+	}
+
+	vni := map[string]interface{}{}
+	if id, ok := d.GetOk(prefix + "id"); ok {
+		vni["id"] = id.(string)
+	} else {
+		subnet, ok := d.GetOk(prefix + "subnet")
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] one of %sid or %ssubnet must be specified", prefix, prefix)
+		}
+		vni["subnet"] = map[string]string{"id": subnet.(string)}
+		if name, ok := d.GetOk(prefix + "name"); ok {
+			vni["name"] = name.(string)
+		}
+		for _, key := range []string{"allow_ip_spoofing", "auto_delete", "enable_infrastructure_nat"} {
+			if v, ok := d.GetOkExists(prefix + key); ok {
+				vni[key] = v.(bool)
+			}
+		}
+		if mode, ok := d.GetOk(prefix + "protocol_state_filtering_mode"); ok {
+			vni["protocol_state_filtering_mode"] = mode.(string)
+		}
+		if sgs, ok := d.GetOk(prefix + "security_groups"); ok && sgs.(*schema.Set).Len() > 0 {
+			securityGroups := []map[string]string{}
+			for _, sg := range sgs.(*schema.Set).List() {
+				securityGroups = append(securityGroups, map[string]string{"id": sg.(string)})
+			}
+			vni["security_groups"] = securityGroups
+		}
+	}
+	attachment["virtual_network_interface"] = vni
+	return attachment, nil
+}
+
+// instanceGetPrimaryNetworkAttachment sets the primary network attachment from its raw value in the instance, the
+// settings of its virtual network interface aren't part of the reference and are read from the interface.
+func instanceGetPrimaryNetworkAttachment(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, raw json.RawMessage) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	networkAttachment := &instanceNetworkAttachment{}
+	if err := json.Unmarshal(raw, networkAttachment); err != nil {
+		return fmt.Errorf("[ERROR] Error getting Instance primary network attachment: %s", err)
+	}
+	attachment, err := flattenPrimaryNetworkAttachment(context, sess, networkAttachment)
+	if err != nil {
+		return err
+	}
+	return d.Set(isInstancePrimaryNetworkAttachment, []map[string]interface{}{attachment})
+}
+
+// flattenPrimaryNetworkAttachment maps a primary network attachment, of an instance or a bare metal server, and the
+// settings of its virtual network interface to the primary_network_attachment block.
+func flattenPrimaryNetworkAttachment(context context.Context, sess *vpcv1.VpcV1, networkAttachment *instanceNetworkAttachment) (map[string]interface{}, error) {
+	attachment := map[string]interface{}{
+		"id":   core.StringNilMapper(networkAttachment.ID),
+		"name": core.StringNilMapper(networkAttachment.Name),
+	}
+	primaryIP := []map[string]interface{}{}
+	if networkAttachment.PrimaryIP != nil {
+		primaryIP = append(primaryIP, map[string]interface{}{
+			"address": core.StringNilMapper(networkAttachment.PrimaryIP.Address),
+			"id":      core.StringNilMapper(networkAttachment.PrimaryIP.ID),
+			"name":    core.StringNilMapper(networkAttachment.PrimaryIP.Name),
+		})
+	}
+	attachment["primary_ip"] = primaryIP
+
+	if networkAttachment.VirtualNetworkInterface != nil && networkAttachment.VirtualNetworkInterface.ID != nil {
+		vni, response, err := getVirtualNetworkInterface(context, sess, *networkAttachment.VirtualNetworkInterface.ID)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting virtual network interface (%s) of the primary network attachment: %s\n%s", *networkAttachment.VirtualNetworkInterface.ID, err, response)
+		}
+		securityGroups := make([]string, 0, len(vni.SecurityGroups))
+		for _, sg := range vni.SecurityGroups {
+			securityGroups = append(securityGroups, *sg.ID)
+		}
+		vniMap := map[string]interface{}{
+			"id":                            core.StringNilMapper(vni.ID),
+			"name":                          core.StringNilMapper(vni.Name),
+			"protocol_state_filtering_mode": core.StringNilMapper(vni.ProtocolStateFilteringMode),
+			"security_groups":               flex.NewStringSet(schema.HashString, securityGroups),
+		}
+		if vni.AllowIPSpoofing != nil {
+			vniMap["allow_ip_spoofing"] = *vni.AllowIPSpoofing
+		}
+		if vni.AutoDelete != nil {
+			vniMap["auto_delete"] = *vni.AutoDelete
+		}
+		if vni.EnableInfrastructureNat != nil {
+			vniMap["enable_infrastructure_nat"] = *vni.EnableInfrastructureNat
+		}
+		if vni.Subnet != nil {
+			vniMap["subnet"] = core.StringNilMapper(vni.Subnet.ID)
+		}
+		attachment["virtual_network_interface"] = []map[string]interface{}{vniMap}
+	}
+	return attachment, nil
+}
+
+// cluster network attachments
+const isInstanceClusterNetworkAttachments = "cluster_network_attachments"
+
+// instanceClusterNetworkAttachment is a cluster network attachment of an instance as returned by the VPC API.
+type instanceClusterNetworkAttachment struct {
+	ClusterNetworkInterface *instanceClusterNetworkInterfaceReference `json:"cluster_network_interface,omitempty"`
+	Href                    *string                                   `json:"href,omitempty"`
+	ID                      *string                                   `json:"id,omitempty"`
+	LifecycleState          *string                                   `json:"lifecycle_state,omitempty"`
+	Name                    *string                                   `json:"name,omitempty"`
+}
+
+type instanceClusterNetworkInterfaceReference struct {
+	ID        *string                         `json:"id,omitempty"`
+	Name      *string                         `json:"name,omitempty"`
+	PrimaryIP *clusterNetworkSubnetReservedIP `json:"primary_ip,omitempty"`
+	Subnet    *clusterNetworkSubnetReference  `json:"subnet,omitempty"`
+}
+
+func instanceClusterNetworkAttachmentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this instance cluster network attachment",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateISName,
+				Description:  "The name for this instance cluster network attachment",
+			},
+			"cluster_network_interface": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Required:    true,
+				Description: "The cluster network interface of the attachment, specify id to attach an existing cluster network interface or subnet to create a new one",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The unique identifier of an existing cluster network interface to attach",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name for the new cluster network interface",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The cluster network subnet of the new cluster network interface",
+						},
+						"primary_ip": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The primary IP address of the cluster network interface",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address",
+									},
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this cluster network subnet reserved IP",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name for this cluster network subnet reserved IP",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandInstanceClusterNetworkAttachment builds the prototype of the cluster network attachment at index i, an
+// existing interface is attached when its id is configured, the id in the state of a new interface is ignored.
+func expandInstanceClusterNetworkAttachment(d *schema.ResourceData, i int) (map[string]interface{}, error) {
+	prefix := fmt.Sprintf("%s.%d.", isInstanceClusterNetworkAttachments, i)
+	attachment := map[string]interface{}{}
+	if name, ok := d.GetOk(prefix + "name"); ok {
+		attachment["name"] = name.(string)
+	}
+
+	configuredID := false
+	if attachments := d.GetRawConfig().GetAttr(isInstanceClusterNetworkAttachments); attachments.IsKnown() && !attachments.IsNull() && i < attachments.LengthInt() {
+		interfaces := attachments.AsValueSlice()[i].GetAttr("cluster_network_interface")
+		if interfaces.IsKnown() && !interfaces.IsNull() && interfaces.LengthInt() > 0 {
+			id := interfaces.AsValueSlice()[0].GetAttr("id")
+			configuredID = !id.IsKnown() || !id.IsNull()
+		}
+	}
+
+	prefix += "cluster_network_interface.0."
+	clusterNetworkInterface := map[string]interface{}{}
+	if configuredID {
+		clusterNetworkInterface["id"] = d.Get(prefix + "id").(string)
+	} else {
+		subnet, ok := d.GetOk(prefix + "subnet")
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] one of %sid or %ssubnet must be specified", prefix, prefix)
+		}
+		clusterNetworkInterface["subnet"] = map[string]string{"id": subnet.(string)}
+		if name, ok := d.GetOk(prefix + "name"); ok {
+			clusterNetworkInterface["name"] = name.(string)
+		}
+	}
+	attachment["cluster_network_interface"] = clusterNetworkInterface
+	return attachment, nil
+}
+
+func expandInstanceClusterNetworkAttachments(d *schema.ResourceData) ([]map[string]interface{}, error) {
+	attachments := []map[string]interface{}{}
+	for i := range d.Get(isInstanceClusterNetworkAttachments).([]interface{}) {
+		attachment, err := expandInstanceClusterNetworkAttachment(d, i)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
+
+// instanceGetClusterNetworkAttachments sets the cluster network attachments of an instance, they are only listed
+// when the raw instance has any so that instances without cluster networks don't make an extra request.
+func instanceGetClusterNetworkAttachments(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, raw json.RawMessage) error {
+	references := []json.RawMessage{}
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &references); err != nil {
+			return fmt.Errorf("[ERROR] Error getting Instance cluster network attachments: %s", err)
+		}
+	}
+	if len(references) == 0 {
+		return d.Set(isInstanceClusterNetworkAttachments, []map[string]interface{}{})
+	}
+
+	result := struct {
+		ClusterNetworkAttachments []instanceClusterNetworkAttachment `json:"cluster_network_attachments"`
+	}{}
+	response, err := vpcRequest(context, sess, core.GET, `/instances/{instance_id}/cluster_network_attachments`,
+		map[string]string{"instance_id": d.Id()}, nil, &result)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing Instance cluster network attachments: %s\n%s", err, response)
+	}
+
+	attachments := []map[string]interface{}{}
+	for _, clusterNetworkAttachment := range result.ClusterNetworkAttachments {
+		attachment := map[string]interface{}{
+			"id":   core.StringNilMapper(clusterNetworkAttachment.ID),
+			"name": core.StringNilMapper(clusterNetworkAttachment.Name),
+		}
+		if clusterNetworkInterface := clusterNetworkAttachment.ClusterNetworkInterface; clusterNetworkInterface != nil {
+			interfaceMap := map[string]interface{}{
+				"id":   core.StringNilMapper(clusterNetworkInterface.ID),
+				"name": core.StringNilMapper(clusterNetworkInterface.Name),
+			}
+			if clusterNetworkInterface.Subnet != nil {
+				interfaceMap["subnet"] = core.StringNilMapper(clusterNetworkInterface.Subnet.ID)
+			}
+			primaryIP := []map[string]interface{}{}
+			if clusterNetworkInterface.PrimaryIP != nil {
+				primaryIP = append(primaryIP, map[string]interface{}{
+					"address": core.StringNilMapper(clusterNetworkInterface.PrimaryIP.Address),
+					"id":      core.StringNilMapper(clusterNetworkInterface.PrimaryIP.ID),
+					"name":    core.StringNilMapper(clusterNetworkInterface.PrimaryIP.Name),
+				})
+			}
+			interfaceMap["primary_ip"] = primaryIP
+			attachment["cluster_network_interface"] = []map[string]interface{}{interfaceMap}
+		}
+		attachments = append(attachments, attachment)
+	}
+	return d.Set(isInstanceClusterNetworkAttachments, attachments)
+}
+
+// instanceUpdateClusterNetworkAttachments updates the cluster network attachments in place, the attachments are
+// ordered so the ones from the first changed interface on are detached and the new ones attached in order. The
+// API only changes the cluster network attachments of a stopped instance.
+func instanceUpdateClusterNetworkAttachments(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange(isInstanceClusterNetworkAttachments) || d.IsNewResource() {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	oldAttachments, newAttachments := d.GetChange(isInstanceClusterNetworkAttachments)
+	oldList := oldAttachments.([]interface{})
+	newList := newAttachments.([]interface{})
+
+	changed := 0
+	for changed < len(oldList) && changed < len(newList) &&
+		!d.HasChange(fmt.Sprintf("%s.%d.cluster_network_interface", isInstanceClusterNetworkAttachments, changed)) {
+		changed++
+	}
+	if changed < len(oldList) || changed < len(newList) {
+		if err = instanceCheckStopped(context, sess, id, "cluster network attachments"); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < changed; i++ {
+		namePath := fmt.Sprintf("%s.%d.name", isInstanceClusterNetworkAttachments, i)
+		if !d.HasChange(namePath) {
+			continue
+		}
+		attachmentID := oldList[i].(map[string]interface{})["id"].(string)
+		body := map[string]interface{}{"name": d.Get(namePath).(string)}
+		response, err := vpcRequest(context, sess, core.PATCH, `/instances/{instance_id}/cluster_network_attachments/{id}`,
+			map[string]string{"instance_id": id, "id": attachmentID}, body, &instanceClusterNetworkAttachment{})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the cluster network attachment (%s) of instance (%s): %s\n%s", attachmentID, id, err, response)
+		}
+	}
+
+	for i := len(oldList) - 1; i >= changed; i-- {
+		attachmentID := oldList[i].(map[string]interface{})["id"].(string)
+		response, err := vpcRequest(context, sess, core.DELETE, `/instances/{instance_id}/cluster_network_attachments/{id}`,
+			map[string]string{"instance_id": id, "id": attachmentID}, nil, nil)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting the cluster network attachment (%s) of instance (%s): %s\n%s", attachmentID, id, err, response)
+		}
+		if _, err = isWaitForInstanceClusterNetworkAttachment(context, sess, id, attachmentID, true, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	for i := changed; i < len(newList); i++ {
+		body, err := expandInstanceClusterNetworkAttachment(d, i)
+		if err != nil {
+			return err
+		}
+		attachment := &instanceClusterNetworkAttachment{}
+		response, err := vpcRequest(context, sess, core.POST, `/instances/{instance_id}/cluster_network_attachments`,
+			map[string]string{"instance_id": id}, body, attachment)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating a cluster network attachment of instance (%s): %s\n%s", id, err, response)
+		}
+		if _, err = isWaitForInstanceClusterNetworkAttachment(context, sess, id, *attachment.ID, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isWaitForInstanceClusterNetworkAttachment(context context.Context, sess *vpcv1.VpcV1, instanceID, id string, deleted bool, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for cluster network attachment (%s) of instance (%s).", id, instanceID)
+
+	pending := []string{isClusterNetworkPending, isClusterNetworkUpdating, isClusterNetworkWaiting}
+	target := []string{isClusterNetworkStable, isClusterNetworkFailed}
+	if deleted {
+		pending = []string{isClusterNetworkDeleting, isClusterNetworkStable, isClusterNetworkUpdating}
+		target = []string{isClusterNetworkDeleted, isClusterNetworkFailed}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			attachment := &instanceClusterNetworkAttachment{}
+			response, err := vpcRequest(context, sess, core.GET, `/instances/{instance_id}/cluster_network_attachments/{id}`,
+				map[string]string{"instance_id": instanceID, "id": id}, nil, attachment)
+			if err != nil {
+				if deleted && response != nil && response.StatusCode == 404 {
+					return attachment, isClusterNetworkDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting cluster network attachment (%s) of instance (%s): %s\n%s", id, instanceID, err, response)
+			}
+			if *attachment.LifecycleState == isClusterNetworkFailed {
+				return attachment, *attachment.LifecycleState, fmt.Errorf("[ERROR] Cluster network attachment (%s) of instance (%s) went into failed state", id, instanceID)
+			}
+			return attachment, *attachment.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// reservations
+const (
+	isInstanceReservationAffinity = "reservation_affinity"
+	isInstanceReservation         = "reservation"
+)
+
+// instanceGetReservation sets the reservation affinity and the reservation in use from the raw instance.
+func instanceGetReservation(d *schema.ResourceData, result map[string]json.RawMessage) error {
+	var affinity *reservationAffinity
+	if raw, ok := result[isInstanceReservationAffinity]; ok {
+		if err := json.Unmarshal(raw, &affinity); err != nil {
+			return fmt.Errorf("[ERROR] Error getting Instance reservation affinity: %s", err)
+		}
+	}
+	if err := d.Set(isInstanceReservationAffinity, flattenReservationAffinity(affinity)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting %s: %s", isInstanceReservationAffinity, err)
+	}
+
+	var reservation *reservationReference
+	if raw, ok := result[isInstanceReservation]; ok {
+		if err := json.Unmarshal(raw, &reservation); err != nil {
+			return fmt.Errorf("[ERROR] Error getting Instance reservation: %s", err)
+		}
+	}
+	reservations := []map[string]interface{}{}
+	if reservation != nil {
+		reservations = append(reservations, map[string]interface{}{
+			"id":   core.StringNilMapper(reservation.ID),
+			"crn":  core.StringNilMapper(reservation.CRN),
+			"name": core.StringNilMapper(reservation.Name),
+		})
+	}
+	if err := d.Set(isInstanceReservation, reservations); err != nil {
+		return fmt.Errorf("[ERROR] Error setting %s: %s", isInstanceReservation, err)
+	}
+	return nil
+}
+
+func instanceUpdateReservationAffinity(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange(isInstanceReservationAffinity) || d.IsNewResource() {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	affinity := expandReservationAffinity(d, isInstanceReservationAffinity)
+	if _, ok := affinity["pool"]; !ok {
+		// an empty pool clears the reservations of a policy that isn't manual anymore
+		affinity["pool"] = []map[string]string{}
+	}
+	body := map[string]interface{}{isInstanceReservationAffinity: affinity}
+	response, err := vpcRequest(context, sess, core.PATCH, `/instances/{id}`, map[string]string{"id": id}, body, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the reservation affinity of instance (%s): %s\n%s", id, err, response)
+	}
+	return nil
+}
+
+// confidential compute and secure boot
+const (
+	isInstanceConfidentialComputeMode = "confidential_compute_mode"
+	isInstanceEnableSecureBoot        = "enable_secure_boot"
+)
+
+func instanceConfidentialComputeModeSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{"disabled", "sgx", "tdx"}),
+		Description:  "The confidential compute mode to use for the instance, one of disabled, sgx or tdx, it must be supported by the profile",
+	}
+}
+
+func instanceEnableSecureBootSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    forceNew,
+		Description: "Indicates whether secure boot is enabled for the instance, it must be supported by the profile",
+	}
+}
+
+// instanceProfileSecurityModes are the confidential compute and secure boot modes of an instance profile.
+type instanceProfileSecurityModes struct {
+	ConfidentialComputeModes *struct {
+		Values []string `json:"values,omitempty"`
+	} `json:"confidential_compute_modes,omitempty"`
+	SecureBootModes *struct {
+		Values []bool `json:"values,omitempty"`
+	} `json:"secure_boot_modes,omitempty"`
+}
+
+// expandInstanceSecurityModes adds the configured confidential compute mode and secure boot to a raw prototype.
+func expandInstanceSecurityModes(d *schema.ResourceData, body map[string]interface{}) {
+	config := d.GetRawConfig()
+	if mode := config.GetAttr(isInstanceConfidentialComputeMode); !mode.IsNull() {
+		body[isInstanceConfidentialComputeMode] = d.Get(isInstanceConfidentialComputeMode).(string)
+	}
+	if secureBoot := config.GetAttr(isInstanceEnableSecureBoot); !secureBoot.IsNull() {
+		body[isInstanceEnableSecureBoot] = d.Get(isInstanceEnableSecureBoot).(bool)
+	}
+}
+
+func instanceSecurityModesConfigured(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	return !config.GetAttr(isInstanceConfidentialComputeMode).IsNull() || !config.GetAttr(isInstanceEnableSecureBoot).IsNull()
+}
+
+// instanceGetSecurityModes sets the confidential compute mode and secure boot from the raw instance or template.
+func instanceGetSecurityModes(d *schema.ResourceData, result map[string]json.RawMessage) error {
+	if raw, ok := result[isInstanceConfidentialComputeMode]; ok {
+		var mode string
+		if err := json.Unmarshal(raw, &mode); err != nil {
+			return fmt.Errorf("[ERROR] Error getting %s: %s", isInstanceConfidentialComputeMode, err)
+		}
+		d.Set(isInstanceConfidentialComputeMode, mode)
+	}
+	if raw, ok := result[isInstanceEnableSecureBoot]; ok {
+		var secureBoot bool
+		if err := json.Unmarshal(raw, &secureBoot); err != nil {
+			return fmt.Errorf("[ERROR] Error getting %s: %s", isInstanceEnableSecureBoot, err)
+		}
+		d.Set(isInstanceEnableSecureBoot, secureBoot)
+	}
+	return nil
+}
+
+// instanceUpdateSecurityModes changes the confidential compute mode and secure boot of the instance. Like a
+// profile change, a running instance is stopped for the update and started again when allow_stop_for_resize
+// is set.
+func instanceUpdateSecurityModes(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.IsNewResource() || !d.HasChanges(isInstanceConfidentialComputeMode, isInstanceEnableSecureBoot) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	instance, response, err := sess.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &id})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Instance (%s): %s\n%s", id, err, response)
+	}
+	wasRunning := instance.Status != nil && *instance.Status == isInstanceStatusRunning
+	if wasRunning {
+		if !d.Get(isInstanceAllowStopForResize).(bool) {
+			return fmt.Errorf("[ERROR] Error updating the confidential compute mode or secure boot of instance (%s): the instance is running and %s is false, stop the instance or set %s to true", id, isInstanceAllowStopForResize, isInstanceAllowStopForResize)
+		}
+		if err = instanceRunAction(context, sess, d, id, "stop"); err != nil {
+			return err
+		}
+	}
+
+	body := map[string]interface{}{}
+	if d.HasChange(isInstanceConfidentialComputeMode) {
+		body[isInstanceConfidentialComputeMode] = d.Get(isInstanceConfidentialComputeMode).(string)
+	}
+	if d.HasChange(isInstanceEnableSecureBoot) {
+		body[isInstanceEnableSecureBoot] = d.Get(isInstanceEnableSecureBoot).(bool)
+	}
+	response, err = vpcRequest(context, sess, core.PATCH, `/instances/{id}`, map[string]string{"id": id}, body, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the confidential compute mode or secure boot of instance (%s): %s\n%s", id, err, response)
+	}
+
+	// only start the instance again if it was stopped for the update
+	if wasRunning {
+		return instanceRunAction(context, sess, d, id, "start")
+	}
+	return nil
+}
+
+// instanceRunAction stops or starts the instance and waits for it to be stopped or running.
+func instanceRunAction(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, id, actiontype string) error {
+	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       &actiontype,
+	}
+	_, response, err := sess.CreateInstanceActionWithContext(context, createinsactoptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+	}
+	if actiontype == "stop" {
+		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), id, d)
+	} else {
+		_, err = isWaitForInstanceAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+	}
+	return err
+}
+
+// instanceCheckStopped fails the update of settings the API only changes on a stopped instance.
+func instanceCheckStopped(context context.Context, sess *vpcv1.VpcV1, id, settings string) error {
+	instance, response, err := sess.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &id})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Instance (%s): %s\n%s", id, err, response)
+	}
+	if instance.Status != nil && *instance.Status == isInstanceStatusRunning {
+		return fmt.Errorf("[ERROR] Error updating the %s of instance (%s): the instance must be stopped, set %s to stop", settings, id, isInstanceAction)
+	}
+	return nil
+}
+
+// resourceIBMisInstanceSecurityModesCustomizeDiff fails the plan when the configured confidential compute mode or
+// secure boot isn't supported by the profile. Only the selected profile is read, and only when one of them is set
+// and it or the profile changes.
+func resourceIBMisInstanceSecurityModesCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}, profileKey string) error {
+	config := diff.GetRawConfig()
+	mode := config.GetAttr(isInstanceConfidentialComputeMode)
+	secureBoot := config.GetAttr(isInstanceEnableSecureBoot)
+	if (mode.IsNull() || !mode.IsKnown()) && (secureBoot.IsNull() || !secureBoot.IsKnown()) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(profileKey) && !diff.HasChange(isInstanceConfidentialComputeMode) && !diff.HasChange(isInstanceEnableSecureBoot) {
+		return nil
+	}
+	profile := config.GetAttr(profileKey)
+	if !profile.IsKnown() || profile.IsNull() {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	profileName := profile.AsString()
+	modes := &instanceProfileSecurityModes{}
+	response, err := vpcRequest(context, sess, core.GET, `/instance/profiles/{name}`, map[string]string{"name": profileName}, nil, modes)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting instance profile (%s): %s\n%s", profileName, err, response)
+	}
+
+	if !mode.IsNull() && mode.IsKnown() && modes.ConfidentialComputeModes != nil {
+		supported := false
+		for _, value := range modes.ConfidentialComputeModes.Values {
+			supported = supported || value == mode.AsString()
+		}
+		if !supported {
+			return fmt.Errorf("[ERROR] %s %s isn't supported by the instance profile %s, supported modes are %v", isInstanceConfidentialComputeMode, mode.AsString(), profileName, modes.ConfidentialComputeModes.Values)
+		}
+	}
+	if !secureBoot.IsNull() && secureBoot.IsKnown() && secureBoot.True() && modes.SecureBootModes != nil {
+		supported := false
+		for _, value := range modes.SecureBootModes.Values {
+			supported = supported || value
+		}
+		if !supported {
+			return fmt.Errorf("[ERROR] %s isn't supported by the instance profile %s", isInstanceEnableSecureBoot, profileName)
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ const (
 	isInstanceStopType        = "stop_type"
 	isInstanceID              = "instance"
 	isInstanceActionForce     = "force_action"
+	isInstanceActionKeepers   = "keepers"
 )
 
 func ResourceIBMISInstanceAction() *schema.Resource {
@@ -34,6 +35,7 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -57,6 +59,12 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 				Default:     false,
 				Description: "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action.",
 			},
+			isInstanceActionKeepers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary key-value pairs that run the action again on the instance when changed.",
+			},
 			isInstanceStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -129,10 +137,8 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", instanceId, err, response))
 	}
 	if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
-		d.Set(isInstanceAction, nil)
 		return diag.FromErr(fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state"))
 	} else if actiontype == "start" && *instance.Status != isInstanceActionStatusStopped {
-		d.Set(isInstanceAction, nil)
 		return diag.FromErr(fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state"))
 	}
 	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
@@ -151,12 +157,12 @@ func resourceIBMISInstanceActionCreate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
 	}
 	if actiontype == "stop" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), instanceId, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	} else if actiontype == "start" || actiontype == "reboot" {
-		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutUpdate), instanceId, d)
+		_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutCreate), instanceId, d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -221,16 +227,18 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response))
 	}
 	if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
-		d.Set(isInstanceAction, nil)
 		return diag.FromErr(fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state"))
 	} else if actiontype == "start" && *instance.Status != isInstanceActionStatusStopped {
-		d.Set(isInstanceAction, nil)
 		return diag.FromErr(fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state"))
 	}
 	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 		InstanceID: &id,
 		Type:       &actiontype,
 	}
+	if instanceActionForceIntf, ok := d.GetOk(isInstanceActionForce); ok {
+		force := instanceActionForceIntf.(bool)
+		createinsactoptions.Force = &force
+	}
 	_, response, err = sess.CreateInstanceAction(createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -250,7 +258,7 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	return nil
+	return resourceIBMISInstanceActionRead(context, d, meta)
 }
 
 func resourceIBMISInstanceActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
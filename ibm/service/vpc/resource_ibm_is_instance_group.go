@@ -33,12 +33,14 @@ const (
 
 func ResourceIBMISInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupCreate,
-		Read:     resourceIBMISInstanceGroupRead,
-		Update:   resourceIBMISInstanceGroupUpdate,
-		Delete:   resourceIBMISInstanceGroupDelete,
-		Exists:   resourceIBMISInstanceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMISInstanceGroupCreate,
+		Read:   resourceIBMISInstanceGroupRead,
+		Update: resourceIBMISInstanceGroupUpdate,
+		Delete: resourceIBMISInstanceGroupDelete,
+		Exists: resourceIBMISInstanceGroupExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMISInstanceGroupImport,
+		},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
@@ -362,10 +364,23 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		changed = true
 	}
 
-	if d.HasChange("application_port") || d.HasChange("load_balancer") || d.HasChange("load_balancer_pool") {
+	lbChanged := d.HasChange("application_port") || d.HasChange("load_balancer") || d.HasChange("load_balancer_pool")
+	lbIDs := []string{}
+	if lbChanged {
 		applicationPort := int64(d.Get("application_port").(int))
 		lbID := d.Get("load_balancer").(string)
 		lbPoolID := d.Get("load_balancer_pool").(string)
+
+		// the pool members of the memberships are moved by the update, which needs the load balancers active
+		if oldLBID, _ := d.GetChange("load_balancer"); oldLBID.(string) != "" && oldLBID.(string) != lbID {
+			lbIDs = append(lbIDs, oldLBID.(string))
+		}
+		lbIDs = append(lbIDs, lbID)
+		for _, id := range lbIDs {
+			if _, err = isWaitForLBAvailable(sess, id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to be active: %s", id, err)
+			}
+		}
 		instanceGroupPatchModel.ApplicationPort = &applicationPort
 		instanceGroupPatchModel.LoadBalancer = &vpcv1.LoadBalancerIdentity{ID: &lbID}
 		instanceGroupPatchModel.LoadBalancerPool = &vpcv1.LoadBalancerPoolIdentity{ID: &lbPoolID}
@@ -390,10 +405,65 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		if healthError != nil {
 			return healthError
 		}
+
+		if lbChanged {
+			_, err = waitForInstanceGroupMembershipsPool(sess, instanceGroupID, d.Get("load_balancer_pool").(string), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			for _, id := range lbIDs {
+				if _, err = isWaitForLBAvailable(sess, id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("[ERROR] Error waiting for load balancer (%s) to be active: %s", id, err)
+				}
+			}
+		}
 	}
 	return resourceIBMISInstanceGroupRead(d, meta)
 }
 
+// isInstanceGroupManaged returns whether any of the managers of the instance group is enabled,
+// in which case the membership count is changed by the manager rather than by the user.
+func isInstanceGroupManaged(sess *vpcv1.VpcV1, instanceGroup *vpcv1.InstanceGroup) (bool, error) {
+	for _, manager := range instanceGroup.Managers {
+		getInstanceGroupManagerOptions := vpcv1.GetInstanceGroupManagerOptions{
+			ID:              manager.ID,
+			InstanceGroupID: instanceGroup.ID,
+		}
+		instanceGroupManagerIntf, response, err := sess.GetInstanceGroupManager(&getInstanceGroupManagerOptions)
+		if err != nil || instanceGroupManagerIntf == nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return false, fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager: %s\n%s", err, response)
+		}
+		instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
+		if instanceGroupManager.ManagementEnabled != nil && *instanceGroupManager.ManagementEnabled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resourceIBMISInstanceGroupImport starts instance_count from the current membership count, Read keeps
+// the value in the state rather than reading it while a manager of the group is enabled.
+func resourceIBMISInstanceGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceGroupID := d.Id()
+	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
+	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	if err != nil || instanceGroup == nil {
+		return nil, fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+	}
+	if instanceGroup.MembershipCount != nil {
+		d.Set("instance_count", *instanceGroup.MembershipCount)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -413,7 +483,14 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", *instanceGroup.Name)
 	d.Set("instance_template", *instanceGroup.InstanceTemplate.ID)
 	d.Set("instances", *instanceGroup.MembershipCount)
-	d.Set("instance_count", *instanceGroup.MembershipCount)
+	managed, err := isInstanceGroupManaged(sess, instanceGroup)
+	if err != nil {
+		return err
+	}
+	// an enabled manager scales the group, keep the configured count so it doesn't show as drift
+	if !managed {
+		d.Set("instance_count", *instanceGroup.MembershipCount)
+	}
 	d.Set("resource_group", *instanceGroup.ResourceGroup.ID)
 	if instanceGroup.ApplicationPort != nil {
 		d.Set("application_port", *instanceGroup.ApplicationPort)
@@ -426,6 +503,10 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	if instanceGroup.LoadBalancerPool != nil {
 		d.Set("load_balancer_pool", *instanceGroup.LoadBalancerPool.ID)
+		// The sixth component is the Load Balancer ID
+		if hrefParts := strings.Split(*instanceGroup.LoadBalancerPool.Href, "/"); len(hrefParts) > 5 {
+			d.Set("load_balancer", hrefParts[5])
+		}
 	}
 	d.Set("subnets", subnets)
 	managers := make([]string, 0)
@@ -613,3 +694,49 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 	return healthStateConf.WaitForState()
 
 }
+
+// waitForInstanceGroupMembershipsPool waits for the pool members of the healthy memberships of the
+// instance group to be registered in the load balancer pool of the instance group.
+func waitForInstanceGroupMembershipsPool(sess *vpcv1.VpcV1, instanceGroupID, lbPoolID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the memberships of instance group (%s) to be members of load balancer pool (%s).", instanceGroupID, lbPoolID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"updating"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			start := ""
+			allrecs := []vpcv1.InstanceGroupMembership{}
+			for {
+				listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+					InstanceGroupID: &instanceGroupID,
+				}
+				if start != "" {
+					listInstanceGroupMembershipsOptions.Start = &start
+				}
+				instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+				}
+				start = flex.GetNext(instanceGroupMembershipCollection.Next)
+				allrecs = append(allrecs, instanceGroupMembershipCollection.Memberships...)
+				if start == "" {
+					break
+				}
+			}
+			for _, membership := range allrecs {
+				if *membership.Status != vpcv1.InstanceGroupMembershipStatusHealthyConst {
+					continue
+				}
+				if membership.PoolMember == nil || !strings.Contains(*membership.PoolMember.Href, "/pools/"+lbPoolID+"/") {
+					return allrecs, "updating", nil
+				}
+			}
+			return allrecs, "done", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -45,6 +45,22 @@ func TestAccIBMISInstanceGroupManager_basic(t *testing.T) {
 						"ibm_is_instance_group_manager.instance_group_manager", "aggregation_window", "120"),
 				),
 			},
+			{
+				// the autoscaler changing the membership count must not show as drift of instance_count
+				Config:   testAccCheckIBMISInstanceGroupManagerConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager),
+				PlanOnly: true,
+			},
+			{
+				// an imported group starts instance_count from its current membership count
+				ResourceName: "ibm_is_instance_group.instance_group",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["instance_count"] != states[0].Attributes["instances"] {
+						return fmt.Errorf("instance_count not set from the membership count on import")
+					}
+					return nil
+				},
+			},
 		},
 	})
 }
@@ -109,6 +109,57 @@ func TestAccIBMISInstanceGroup_basic_loadbalancer(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceGroup_loadbalancerPortUpdate(t *testing.T) {
+	var instanceGroupID string
+	randInt := acctest.RandIntRange(10, 100)
+	instanceGroupName := fmt.Sprintf("testinstancegroup%d", randInt)
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("testvpc%d", randInt)
+	subnetName := fmt.Sprintf("testsubnet%d", randInt)
+	templateName := fmt.Sprintf("testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("testsshkey%d", randInt)
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceGrouplbPortConfig(vpcName, subnetName, acc.ISZoneName, acc.ISCIDR, name, poolName, "round_robin", "http", "45", "5", "15", "http", sshKeyName, publicKey, templateName, instanceGroupName, "2364"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						instanceGroupID = s.RootModule().Resources["ibm_is_instance_group.instance_group"].Primary.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "application_port", "2364"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "load_balancer", "ibm_is_lb.testacc_LB", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGrouplbPortConfig(vpcName, subnetName, acc.ISZoneName, acc.ISCIDR, name, poolName, "round_robin", "http", "45", "5", "15", "http", sshKeyName, publicKey, templateName, instanceGroupName, "2365"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["ibm_is_instance_group.instance_group"].Primary.ID; id != instanceGroupID {
+							return fmt.Errorf("instance group was replaced: %s is now %s", instanceGroupID, id)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "application_port", "2365"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "instance_count", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceGroupDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -129,6 +180,10 @@ func testAccCheckIBMISInstanceGroupDestroy(s *terraform.State) error {
 }
 
 func testAccCheckIBMISInstanceGrouplbConfig(vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, delay, retries, timeout, healthType, sshKeyName, publicKey, templateName, instanceGroupName string) string {
+	return testAccCheckIBMISInstanceGrouplbPortConfig(vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, delay, retries, timeout, healthType, sshKeyName, publicKey, templateName, instanceGroupName, "2364")
+}
+
+func testAccCheckIBMISInstanceGrouplbPortConfig(vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, delay, retries, timeout, healthType, sshKeyName, publicKey, templateName, instanceGroupName, applicationPort string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
 		name = "%s"
@@ -180,9 +235,9 @@ func testAccCheckIBMISInstanceGrouplbConfig(vpcname, subnetname, zone, cidr, nam
 		subnets = [ibm_is_subnet.testacc_subnet.id]
 		load_balancer = ibm_is_lb.testacc_LB.id
         load_balancer_pool = ibm_is_lb_pool.testacc_lb_pool.pool_id
-        application_port = "2364"
+        application_port = "%s"
 	}
-	`, vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, delay, retries, timeout, healthType, sshKeyName, publicKey, templateName, acc.IsImage, zone, instanceGroupName)
+	`, vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, delay, retries, timeout, healthType, sshKeyName, publicKey, templateName, acc.IsImage, zone, instanceGroupName, applicationPort)
 
 }
 
@@ -0,0 +1,504 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isInstanceNetworkAttachmentPending  = "pending"
+	isInstanceNetworkAttachmentStable   = "stable"
+	isInstanceNetworkAttachmentFailed   = "failed"
+	isInstanceNetworkAttachmentUpdating = "updating"
+	isInstanceNetworkAttachmentWaiting  = "waiting"
+	isInstanceNetworkAttachmentDeleting = "deleting"
+	isInstanceNetworkAttachmentDeleted  = "deleted"
+)
+
+// instanceNetworkAttachment is the instance network attachment returned by the VPC API. The
+// vpc-go-sdk version used by the provider does not model network attachments yet.
+type instanceNetworkAttachment struct {
+	CreatedAt               *string                                                  `json:"created_at,omitempty"`
+	Href                    *string                                                  `json:"href,omitempty"`
+	ID                      *string                                                  `json:"id,omitempty"`
+	LifecycleState          *string                                                  `json:"lifecycle_state,omitempty"`
+	Name                    *string                                                  `json:"name,omitempty"`
+	PortSpeed               *int64                                                   `json:"port_speed,omitempty"`
+	PrimaryIP               *vpcv1.ReservedIPReference                               `json:"primary_ip,omitempty"`
+	ResourceType            *string                                                  `json:"resource_type,omitempty"`
+	Subnet                  *vpcv1.SubnetReference                                   `json:"subnet,omitempty"`
+	Type                    *string                                                  `json:"type,omitempty"`
+	VirtualNetworkInterface *vpcv1.VirtualNetworkInterfaceReferenceAttachmentContext `json:"virtual_network_interface,omitempty"`
+}
+
+func ResourceIBMIsInstanceNetworkAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsInstanceNetworkAttachmentCreate,
+		ReadContext:   resourceIBMIsInstanceNetworkAttachmentRead,
+		UpdateContext: resourceIBMIsInstanceNetworkAttachmentUpdate,
+		DeleteContext: resourceIBMIsInstanceNetworkAttachmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the instance.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_network_attachment", "name"),
+				Description:  "The name for this instance network attachment. The name is unique across all network attachments for the instance.",
+			},
+			"virtual_network_interface": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The virtual network interface for this instance network attachment. Specify `id` to attach an existing virtual network interface, or `subnet` to create a new one.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"virtual_network_interface.0.subnet"},
+							Description:   "The unique identifier of an existing virtual network interface to attach.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The name for the new virtual network interface.",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The subnet of the new virtual network interface.",
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "Indicates whether the new virtual network interface is automatically deleted when the network attachment is deleted.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this virtual network interface.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this virtual network interface.",
+						},
+					},
+				},
+			},
+			"network_attachment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this instance network attachment.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the instance network attachment was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this instance network attachment.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the instance network attachment.",
+			},
+			"port_speed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port speed for this instance network attachment in Mbps.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The primary IP address of the virtual network interface for the instance network attachment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reserved IP.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subnet of the virtual network interface for the instance network attachment.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The instance network attachment type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsInstanceNetworkAttachmentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_network_attachment", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsInstanceNetworkAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance").(string)
+
+	vniPrototype := map[string]interface{}{}
+	vniModel := d.Get("virtual_network_interface.0").(map[string]interface{})
+	if id, ok := vniModel["id"].(string); ok && id != "" {
+		vniPrototype["id"] = id
+	} else {
+		subnet, _ := vniModel["subnet"].(string)
+		if subnet == "" {
+			return diag.FromErr(fmt.Errorf("[ERROR] one of virtual_network_interface.0.id or virtual_network_interface.0.subnet must be specified"))
+		}
+		vniPrototype["subnet"] = map[string]interface{}{"id": subnet}
+		if name, ok := vniModel["name"].(string); ok && name != "" {
+			vniPrototype["name"] = name
+		}
+		if _, ok := d.GetOkExists("virtual_network_interface.0.auto_delete"); ok {
+			vniPrototype["auto_delete"] = vniModel["auto_delete"].(bool)
+		}
+	}
+
+	body := map[string]interface{}{
+		"virtual_network_interface": vniPrototype,
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+
+	isNICKey := "instance_key_" + instanceID
+	conns.IbmMutexKV.Lock(isNICKey)
+	defer conns.IbmMutexKV.Unlock(isNICKey)
+
+	networkAttachment := &instanceNetworkAttachment{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/instances/{instance_id}/network_attachments`,
+		map[string]string{"instance_id": instanceID}, body, networkAttachment)
+	if err != nil {
+		log.Printf("[DEBUG] CreateInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateInstanceNetworkAttachmentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *networkAttachment.ID))
+
+	_, err = isWaitForInstanceNetworkAttachmentStable(context, vpcClient, instanceID, *networkAttachment.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsInstanceNetworkAttachmentRead(context, d, meta)
+}
+
+func resourceIBMIsInstanceNetworkAttachmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	networkAttachment, response, err := getInstanceNetworkAttachment(context, vpcClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetInstanceNetworkAttachmentWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance: %s", err))
+	}
+	if err = d.Set("network_attachment", networkAttachment.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting network_attachment: %s", err))
+	}
+	if err = d.Set("name", networkAttachment.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("created_at", networkAttachment.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", networkAttachment.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", networkAttachment.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("port_speed", flex.IntValue(networkAttachment.PortSpeed)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting port_speed: %s", err))
+	}
+	if err = d.Set("resource_type", networkAttachment.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+	if err = d.Set("type", networkAttachment.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+
+	primaryIP := []map[string]interface{}{}
+	if networkAttachment.PrimaryIP != nil {
+		primaryIP = append(primaryIP, map[string]interface{}{
+			"address": core.StringNilMapper(networkAttachment.PrimaryIP.Address),
+			"href":    core.StringNilMapper(networkAttachment.PrimaryIP.Href),
+			"id":      core.StringNilMapper(networkAttachment.PrimaryIP.ID),
+			"name":    core.StringNilMapper(networkAttachment.PrimaryIP.Name),
+		})
+	}
+	if err = d.Set("primary_ip", primaryIP); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting primary_ip: %s", err))
+	}
+
+	if networkAttachment.Subnet != nil {
+		if err = d.Set("subnet", networkAttachment.Subnet.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting subnet: %s", err))
+		}
+	}
+
+	if networkAttachment.VirtualNetworkInterface != nil {
+		vni := map[string]interface{}{
+			"id":   core.StringNilMapper(networkAttachment.VirtualNetworkInterface.ID),
+			"name": core.StringNilMapper(networkAttachment.VirtualNetworkInterface.Name),
+			"crn":  core.StringNilMapper(networkAttachment.VirtualNetworkInterface.CRN),
+			"href": core.StringNilMapper(networkAttachment.VirtualNetworkInterface.Href),
+		}
+		getVirtualNetworkInterfaceOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
+			ID: networkAttachment.VirtualNetworkInterface.ID,
+		}
+		virtualNetworkInterface, response, err := vpcClient.GetVirtualNetworkInterfaceWithContext(context, getVirtualNetworkInterfaceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+		}
+		if virtualNetworkInterface.AutoDelete != nil {
+			vni["auto_delete"] = *virtualNetworkInterface.AutoDelete
+		}
+		if virtualNetworkInterface.Subnet != nil {
+			vni["subnet"] = core.StringNilMapper(virtualNetworkInterface.Subnet.ID)
+		}
+		if err = d.Set("virtual_network_interface", []map[string]interface{}{vni}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting virtual_network_interface: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIsInstanceNetworkAttachmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		body := map[string]interface{}{
+			"name": d.Get("name").(string),
+		}
+		networkAttachment := &instanceNetworkAttachment{}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/instances/{instance_id}/network_attachments/{id}`,
+			map[string]string{"instance_id": parts[0], "id": parts[1]}, body, networkAttachment)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateInstanceNetworkAttachmentWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIsInstanceNetworkAttachmentRead(context, d, meta)
+}
+
+func resourceIBMIsInstanceNetworkAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := parts[0]
+	networkAttachmentID := parts[1]
+
+	isNICKey := "instance_key_" + instanceID
+	conns.IbmMutexKV.Lock(isNICKey)
+	defer conns.IbmMutexKV.Unlock(isNICKey)
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/instances/{instance_id}/network_attachments/{id}`,
+		map[string]string{"instance_id": instanceID, "id": networkAttachmentID}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteInstanceNetworkAttachmentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteInstanceNetworkAttachmentWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForInstanceNetworkAttachmentDeleted(context, vpcClient, instanceID, networkAttachmentID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getInstanceNetworkAttachment(context context.Context, vpcClient *vpcv1.VpcV1, instanceID, id string) (*instanceNetworkAttachment, *core.DetailedResponse, error) {
+	networkAttachment := &instanceNetworkAttachment{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/instances/{instance_id}/network_attachments/{id}`,
+		map[string]string{"instance_id": instanceID, "id": id}, nil, networkAttachment)
+	if err != nil {
+		return nil, response, err
+	}
+	return networkAttachment, response, nil
+}
+
+func isWaitForInstanceNetworkAttachmentStable(context context.Context, vpcClient *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for instance network attachment (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isInstanceNetworkAttachmentPending, isInstanceNetworkAttachmentUpdating, isInstanceNetworkAttachmentWaiting},
+		Target:     []string{isInstanceNetworkAttachmentStable, isInstanceNetworkAttachmentFailed},
+		Refresh:    isInstanceNetworkAttachmentRefreshFunc(context, vpcClient, instanceID, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isInstanceNetworkAttachmentRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, instanceID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		networkAttachment, response, err := getInstanceNetworkAttachment(context, vpcClient, instanceID, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting instance network attachment: %s\n%s", err, response)
+		}
+
+		if *networkAttachment.LifecycleState == isInstanceNetworkAttachmentFailed {
+			return networkAttachment, *networkAttachment.LifecycleState, fmt.Errorf("[ERROR] Instance network attachment (%s) went into failed state", id)
+		}
+		return networkAttachment, *networkAttachment.LifecycleState, nil
+	}
+}
+
+func isWaitForInstanceNetworkAttachmentDeleted(context context.Context, vpcClient *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for instance network attachment (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isInstanceNetworkAttachmentDeleting, isInstanceNetworkAttachmentStable, isInstanceNetworkAttachmentUpdating},
+		Target:     []string{isInstanceNetworkAttachmentDeleted, isInstanceNetworkAttachmentFailed},
+		Refresh:    isInstanceNetworkAttachmentDeleteRefreshFunc(context, vpcClient, instanceID, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isInstanceNetworkAttachmentDeleteRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, instanceID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		networkAttachment, response, err := getInstanceNetworkAttachment(context, vpcClient, instanceID, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return networkAttachment, isInstanceNetworkAttachmentDeleted, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error getting instance network attachment: %s\n%s", err, response)
+		}
+
+		if *networkAttachment.LifecycleState == isInstanceNetworkAttachmentFailed {
+			return networkAttachment, *networkAttachment.LifecycleState, fmt.Errorf("[ERROR] Instance network attachment (%s) failed to delete", id)
+		}
+		return networkAttachment, *networkAttachment.LifecycleState, nil
+	}
+}
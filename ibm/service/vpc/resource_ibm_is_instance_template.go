@@ -5,6 +5,7 @@ package vpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,6 +63,13 @@ const (
 	isInstanceTemplateNicReservedIpName            = "name"
 	isInstanceTemplateNicReservedIpId              = "reserved_ip"
 
+	// network attachments
+	isInstanceTemplatePrimaryNetworkAttachment = "primary_network_attachment"
+	isInstanceTemplateNetworkAttachments       = "network_attachments"
+
+	// reservations
+	isInstanceTemplateReservationAffinity = "reservation_affinity"
+
 	// catalog offering
 	isInstanceTemplateCatalogOffering            = "catalog_offering"
 	isInstanceTemplateCatalogOfferingOfferingCrn = "offering_crn"
@@ -87,6 +96,11 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeAttachmentValidate(diff)
 				}),
+
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMisInstanceSecurityModesCustomizeDiff(context, diff, v, isInstanceTemplateProfile)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -328,11 +342,12 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 			},
 
 			isInstanceTemplatePrimaryNetworkInterface: {
-				Type:        schema.TypeList,
-				MinItems:    1,
-				MaxItems:    1,
-				Required:    true,
-				Description: "Primary Network interface info",
+				Type:         schema.TypeList,
+				MinItems:     1,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{isInstanceTemplatePrimaryNetworkInterface, isInstanceTemplatePrimaryNetworkAttachment},
+				Description:  "Primary Network interface info",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						isInstanceTemplateNicAllowIPSpoofing: {
@@ -409,6 +424,33 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				},
 			},
 
+			isInstanceConfidentialComputeMode: instanceConfidentialComputeModeSchema(true),
+
+			isInstanceEnableSecureBoot: instanceEnableSecureBootSchema(true),
+
+			isInstanceTemplateReservationAffinity: reservationAffinitySchema(true),
+
+			isInstanceTemplatePrimaryNetworkAttachment: {
+				Type:         schema.TypeList,
+				MinItems:     1,
+				MaxItems:     1,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isInstanceTemplatePrimaryNetworkInterface, isInstanceTemplatePrimaryNetworkAttachment},
+				Description:  "The primary network attachment, with a new virtual network interface for each instance",
+				Elem:         instanceTemplateNetworkAttachmentSchema(),
+			},
+
+			isInstanceTemplateNetworkAttachments: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isInstanceTemplateNetworkInterfaces},
+				RequiredWith:  []string{isInstanceTemplatePrimaryNetworkAttachment},
+				Description:   "The additional network attachments, with a new virtual network interface for each instance",
+				Elem:          instanceTemplateNetworkAttachmentSchema(),
+			},
+
 			isInstanceTemplateNetworkInterfaces: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1116,13 +1158,7 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 		InstanceTemplatePrototype: instanceproto,
 	}
 
-	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
-	}
-	instance := instanceIntf.(*vpcv1.InstanceTemplate)
-	d.SetId(*instance.ID)
-	return nil
+	return instanceTemplateCreateWithOptions(d, sess, options)
 }
 
 func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
@@ -1539,13 +1575,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 		InstanceTemplatePrototype: instanceproto,
 	}
 
-	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
-	}
-	instance := instanceIntf.(*vpcv1.InstanceTemplate)
-	d.SetId(*instance.ID)
-	return nil
+	return instanceTemplateCreateWithOptions(d, sess, options)
 }
 
 func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) error {
@@ -1553,14 +1583,36 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	if err != nil {
 		return err
 	}
-	getinsOptions := &vpcv1.GetInstanceTemplateOptions{
-		ID: &ID,
-	}
-	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
+	// the template is read raw, so that the settings the vpc-go-sdk version used by the provider doesn't model, like
+	// its network attachments and reservation affinity, are read from the same response
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context.Background(), instanceC, core.GET, `/instance_templates/{id}`, map[string]string{"id": ID}, nil, &result)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Getting Instance template: %s\n%s", err, response)
 	}
+	var instanceIntf vpcv1.InstanceTemplateIntf
+	if err = vpcv1.UnmarshalInstanceTemplate(result, &instanceIntf); err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Instance template: %s", err)
+	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
+	if instance.PrimaryNetworkInterface == nil {
+		err = instanceTemplateGetNetworkAttachments(d, result)
+		if err != nil {
+			return err
+		}
+	}
+	var affinity *reservationAffinity
+	if raw, ok := result[isInstanceTemplateReservationAffinity]; ok {
+		if err = json.Unmarshal(raw, &affinity); err != nil {
+			return fmt.Errorf("[ERROR] Error Getting Instance template reservation affinity: %s", err)
+		}
+	}
+	if err = d.Set(isInstanceTemplateReservationAffinity, flattenReservationAffinity(affinity)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting %s: %s", isInstanceTemplateReservationAffinity, err)
+	}
+	if err = instanceGetSecurityModes(d, result); err != nil {
+		return err
+	}
 	d.Set(isInstanceTemplateName, *instance.Name)
 	d.Set(isInstanceTemplateCRN, *instance.CRN)
 	if instance.AvailabilityPolicy != nil && instance.AvailabilityPolicy.HostFailure != nil {
@@ -1848,6 +1900,213 @@ func resourceIbmIsInstanceTemplateInstancePlacementTargetPrototypeToMap(instance
 	return instancePlacementTargetPrototypeMap
 }
 
+func instanceTemplateNetworkAttachmentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateISName,
+				Description:  "The name for the network attachment",
+			},
+			"virtual_network_interface": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The virtual network interface to create for each instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The subnet of the virtual network interface",
+						},
+						"allow_ip_spoofing": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "Indicates whether source IP spoofing is allowed on the virtual network interface",
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+							Description: "Indicates whether the virtual network interface is deleted when the instance is deleted",
+						},
+						"enable_infrastructure_nat": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+							Description: "If true, the VPC infrastructure performs any needed NAT operations, if false the packet is passed unchanged to the instance",
+						},
+						"security_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The security groups of the virtual network interface, the VPC default security group if not set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// instanceTemplateNetworkAttachment is a network attachment prototype of an instance template.
+// The vpc-go-sdk version used by the provider does not model network attachments yet.
+type instanceTemplateNetworkAttachment struct {
+	Name                    *string `json:"name,omitempty"`
+	VirtualNetworkInterface *struct {
+		AllowIPSpoofing         *bool                             `json:"allow_ip_spoofing,omitempty"`
+		AutoDelete              *bool                             `json:"auto_delete,omitempty"`
+		EnableInfrastructureNat *bool                             `json:"enable_infrastructure_nat,omitempty"`
+		SecurityGroups          []vpcv1.SecurityGroupIdentityByID `json:"security_groups,omitempty"`
+		Subnet                  *vpcv1.SubnetIdentityByID         `json:"subnet,omitempty"`
+	} `json:"virtual_network_interface,omitempty"`
+}
+
+type instanceTemplateNetworkAttachments struct {
+	ID                       *string                             `json:"id,omitempty"`
+	PrimaryNetworkAttachment *instanceTemplateNetworkAttachment  `json:"primary_network_attachment,omitempty"`
+	NetworkAttachments       []instanceTemplateNetworkAttachment `json:"network_attachments,omitempty"`
+}
+
+func expandInstanceTemplateNetworkAttachment(modelMap map[string]interface{}) map[string]interface{} {
+	attachment := map[string]interface{}{}
+	if name, ok := modelMap["name"].(string); ok && name != "" {
+		attachment["name"] = name
+	}
+	vniList := modelMap["virtual_network_interface"].([]interface{})
+	if len(vniList) > 0 && vniList[0] != nil {
+		vniMap := vniList[0].(map[string]interface{})
+		vni := map[string]interface{}{
+			"subnet":                    map[string]string{"id": vniMap["subnet"].(string)},
+			"allow_ip_spoofing":         vniMap["allow_ip_spoofing"].(bool),
+			"auto_delete":               vniMap["auto_delete"].(bool),
+			"enable_infrastructure_nat": vniMap["enable_infrastructure_nat"].(bool),
+		}
+		if sgs := vniMap["security_groups"].(*schema.Set); sgs.Len() > 0 {
+			securityGroups := []map[string]string{}
+			for _, sg := range sgs.List() {
+				securityGroups = append(securityGroups, map[string]string{"id": sg.(string)})
+			}
+			vni["security_groups"] = securityGroups
+		}
+		attachment["virtual_network_interface"] = vni
+	}
+	return attachment
+}
+
+func flattenInstanceTemplateNetworkAttachment(attachment instanceTemplateNetworkAttachment) map[string]interface{} {
+	modelMap := map[string]interface{}{}
+	if attachment.Name != nil {
+		modelMap["name"] = *attachment.Name
+	}
+	if vni := attachment.VirtualNetworkInterface; vni != nil {
+		vniMap := map[string]interface{}{}
+		if vni.Subnet != nil {
+			vniMap["subnet"] = *vni.Subnet.ID
+		}
+		if vni.AllowIPSpoofing != nil {
+			vniMap["allow_ip_spoofing"] = *vni.AllowIPSpoofing
+		}
+		if vni.AutoDelete != nil {
+			vniMap["auto_delete"] = *vni.AutoDelete
+		}
+		if vni.EnableInfrastructureNat != nil {
+			vniMap["enable_infrastructure_nat"] = *vni.EnableInfrastructureNat
+		}
+		securityGroups := []string{}
+		for _, sg := range vni.SecurityGroups {
+			securityGroups = append(securityGroups, *sg.ID)
+		}
+		vniMap["security_groups"] = securityGroups
+		modelMap["virtual_network_interface"] = []map[string]interface{}{vniMap}
+	}
+	return modelMap
+}
+
+// instanceTemplateCreateWithOptions creates the instance template. Templates with network attachments, a
+// reservation affinity, a confidential compute mode or secure boot are sent directly to the API, as the SDK
+// can't express them.
+func instanceTemplateCreateWithOptions(d *schema.ResourceData, sess *vpcv1.VpcV1, options *vpcv1.CreateInstanceTemplateOptions) error {
+	primaryAttachment, primaryAttachmentOk := d.GetOk(isInstanceTemplatePrimaryNetworkAttachment)
+	_, reservationAffinityOk := d.GetOk(isInstanceTemplateReservationAffinity)
+	if !primaryAttachmentOk && !reservationAffinityOk && !instanceSecurityModesConfigured(d) {
+		instanceIntf, response, err := sess.CreateInstanceTemplate(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
+		}
+		instance := instanceIntf.(*vpcv1.InstanceTemplate)
+		d.SetId(*instance.ID)
+		return nil
+	}
+
+	prototype, err := json.Marshal(options.InstanceTemplatePrototype)
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(prototype, &body); err != nil {
+		return err
+	}
+	if primaryAttachmentOk {
+		body[isInstanceTemplatePrimaryNetworkAttachment] = expandInstanceTemplateNetworkAttachment(primaryAttachment.([]interface{})[0].(map[string]interface{}))
+		if attachments, ok := d.GetOk(isInstanceTemplateNetworkAttachments); ok {
+			networkAttachments := []map[string]interface{}{}
+			for _, attachment := range attachments.([]interface{}) {
+				networkAttachments = append(networkAttachments, expandInstanceTemplateNetworkAttachment(attachment.(map[string]interface{})))
+			}
+			body[isInstanceTemplateNetworkAttachments] = networkAttachments
+		}
+	}
+	if reservationAffinityOk {
+		body[isInstanceTemplateReservationAffinity] = expandReservationAffinity(d, isInstanceTemplateReservationAffinity)
+	}
+	expandInstanceSecurityModes(d, body)
+
+	result := &instanceTemplateNetworkAttachments{}
+	response, err := vpcRequest(context.Background(), sess, core.POST, `/instance_templates`, nil, body, result)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating InstanceTemplate: %s\n%s", err, response)
+	}
+	d.SetId(*result.ID)
+	return nil
+}
+
+func instanceTemplateGetNetworkAttachments(d *schema.ResourceData, result map[string]json.RawMessage) error {
+	if raw, ok := result[isInstanceTemplatePrimaryNetworkAttachment]; ok {
+		var primaryNetworkAttachment *instanceTemplateNetworkAttachment
+		if err := json.Unmarshal(raw, &primaryNetworkAttachment); err != nil {
+			return fmt.Errorf("[ERROR] Error Getting Instance template primary network attachment: %s", err)
+		}
+		if primaryNetworkAttachment != nil {
+			d.Set(isInstanceTemplatePrimaryNetworkAttachment, []map[string]interface{}{flattenInstanceTemplateNetworkAttachment(*primaryNetworkAttachment)})
+		}
+	}
+	var attachments []instanceTemplateNetworkAttachment
+	if raw, ok := result[isInstanceTemplateNetworkAttachments]; ok {
+		if err := json.Unmarshal(raw, &attachments); err != nil {
+			return fmt.Errorf("[ERROR] Error Getting Instance template network attachments: %s", err)
+		}
+	}
+	networkAttachments := []map[string]interface{}{}
+	for _, attachment := range attachments {
+		networkAttachments = append(networkAttachments, flattenInstanceTemplateNetworkAttachment(attachment))
+	}
+	d.Set(isInstanceTemplateNetworkAttachments, networkAttachments)
+	return nil
+}
+
 func instanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
@@ -233,6 +233,44 @@ func TestAccIBMISInstanceTemplate_WithVolumeAttachmentUserTag(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceTemplate_networkAttachments(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("tf-testvpc%d", randInt)
+	subnetName := fmt.Sprintf("tf-testsubnet%d", randInt)
+	templateName := fmt.Sprintf("tf-testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("tf-testsshkey%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceTemplateNetworkAttachmentsConfig(vpcName, subnetName, sshKeyName, publicKey, templateName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "name", templateName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "primary_network_attachment.0.name", "primary"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.instancetemplate1", "primary_network_attachment.0.virtual_network_interface.0.subnet", "ibm_is_subnet.subnet2", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "primary_network_attachment.0.virtual_network_interface.0.auto_delete", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "network_attachments.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "network_attachments.0.virtual_network_interface.0.allow_ip_spoofing", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_template.instancetemplate1", "primary_network_interface.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceTemplateDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -579,3 +617,51 @@ func testAccCheckIBMISInstanceTemplateConfigAvailablePolicyHostFailure_Updated(v
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName)
 
 }
+
+func testAccCheckIBMISInstanceTemplateNetworkAttachmentsConfig(vpcName, subnetName, sshKeyName, publicKey, templateName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	data "ibm_is_images" "is_images" {
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name    = "%s"
+	   image   = data.ibm_is_images.is_images.images.0.id
+	   profile = "bx2-8x32"
+
+	   primary_network_attachment {
+		 name = "primary"
+		 virtual_network_interface {
+		   subnet = ibm_is_subnet.subnet2.id
+		 }
+	   }
+
+	   network_attachments {
+		 name = "secondary"
+		 virtual_network_interface {
+		   subnet            = ibm_is_subnet.subnet2.id
+		   allow_ip_spoofing = true
+		 }
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	 }
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName)
+}
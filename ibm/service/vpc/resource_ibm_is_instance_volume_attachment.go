@@ -577,18 +577,16 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			ID: &volId,
 		}
 		volumeProfilePatchModel := &vpcv1.VolumePatch{}
-		if d.HasChange(isInstanceVolProfile) {
+		if d.HasChange(isInstanceVolProfile) || d.HasChange(isVolumeIops) {
 			profile := d.Get(isInstanceVolProfile).(string)
 			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
 				Name: &profile,
 			}
-		} else if d.HasChange(isVolumeIops) {
-			profile := d.Get(isInstanceVolProfile).(string)
-			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
-				Name: &profile,
+			// iops is required when converting to the custom profile, and is set by the tier otherwise
+			if profile == "custom" {
+				iops := int64(d.Get(isVolumeIops).(int))
+				volumeProfilePatchModel.Iops = &iops
 			}
-			iops := int64(d.Get(isVolumeIops).(int))
-			volumeProfilePatchModel.Iops = &iops
 		}
 		if d.HasChange(isInstanceVolAttTags) && !d.IsNewResource() {
 			if v, ok := d.GetOk(isInstanceVolAttTags); ok {
@@ -86,6 +86,48 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISInstanceVolumeAttachment_existingVolume(t *testing.T) {
+	var instanceVolAtt string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	attName := fmt.Sprintf("tf-volatt-%d", acctest.RandIntRange(10, 100))
+	volName := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceVolumeAttachmentExistingVolumeConfig(vpcname, subnetname, sshname, publicKey, name, attName, volName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISInstanceVolumeAttachmentExists("ibm_is_instance_volume_attachment.testacc_att", instanceVolAtt),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_volume_attachment.testacc_att", "volume", "ibm_is_volume.testacc_vol", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_volume_attachment.testacc_att", "delete_volume_on_attachment_delete", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_volume_attachment.testacc_att", "delete_volume_on_instance_delete", "false"),
+				),
+			},
+			{
+				// the attachment is removed, the volume is detached but not deleted
+				Config: testAccCheckIBMISInstanceVolumeAttachmentExistingVolumeConfig(vpcname, subnetname, sshname, publicKey, name, attName, volName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVolumeExists("ibm_is_volume.testacc_vol", ""),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.testacc_vol", "status", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstanceVolumeAttachment_userTag(t *testing.T) {
 	var instanceVolAtt string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -290,3 +332,53 @@ func testAccCheckIBMISInstanceVolumeAttachmentUsertagConfig(vpcname, subnetname,
 	 
 	  `, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, attName, capacity, iops, usertag, autoDelete, volName)
 }
+
+func testAccCheckIBMISInstanceVolumeAttachmentExistingVolumeConfig(vpcname, subnetname, sshname, publicKey, name, attName, volName string, attached bool) string {
+	attachment := ""
+	if attached {
+		attachment = fmt.Sprintf(`
+	  resource "ibm_is_instance_volume_attachment" "testacc_att" {
+		instance = ibm_is_instance.testacc_instance.id
+		name     = "%s"
+		volume   = ibm_is_volume.testacc_vol.id
+
+		delete_volume_on_attachment_delete = false
+	  }`, attName)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+	  
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            			= "%s"
+		vpc             			= ibm_is_vpc.testacc_vpc.id
+		zone            			= "%s"
+		total_ipv4_address_count 	= 16
+	  }
+	  
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+	  
+	  resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet     = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	  }
+
+	  resource "ibm_is_volume" "testacc_vol" {
+		name    = "%s"
+		profile = "10iops-tier"
+		zone    = "%s"
+	  }
+	  %s
+	  `, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, volName, acc.ISZoneName, attachment)
+}
@@ -5,6 +5,7 @@ package vpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -44,6 +46,10 @@ const (
 	isLBSecurityGroupsSupported = "security_group_supported"
 
 	isLBAccessTags = "access_tags"
+
+	// private path load balancers
+	isLBTypePrivatePath    = "private_path"
+	isLBProfilePrivatePath = "network-private-path"
 )
 
 func ResourceIBMISLB() *schema.Resource {
@@ -75,6 +81,10 @@ func ResourceIBMISLB() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISLBValidatePrivatePath(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -93,7 +103,7 @@ func ResourceIBMISLB() *schema.Resource {
 				Optional:     true,
 				Default:      "public",
 				ValidateFunc: validate.InvokeValidator("ibm_is_lb", isLBType),
-				Description:  "Load Balancer type",
+				Description:  "Load Balancer type, one of public, private or private_path",
 			},
 			"dns": {
 				Type:        schema.TypeList,
@@ -293,8 +303,8 @@ func ResourceIBMISLB() *schema.Resource {
 func ResourceIBMISLBValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
-	lbtype := "public, private"
-	isLBProfileAllowedValues := "network-fixed"
+	lbtype := "public, private, private_path"
+	isLBProfileAllowedValues := "network-fixed, network-private-path"
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -343,6 +353,23 @@ func ResourceIBMISLBValidator() *validate.ResourceValidator {
 	return &ibmISLBResourceValidator
 }
 
+// resourceIBMISLBValidatePrivatePath checks that private path load balancers use the private path
+// profile, and that the private path profile is only used by them.
+func resourceIBMISLBValidatePrivatePath(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown(isLBType) || !diff.NewValueKnown(isLBProfile) {
+		return nil
+	}
+	lbType := diff.Get(isLBType).(string)
+	profile := diff.Get(isLBProfile).(string)
+	if lbType == isLBTypePrivatePath && profile != "" && profile != isLBProfilePrivatePath {
+		return fmt.Errorf("[ERROR] %s must be %s when %s is %s", isLBProfile, isLBProfilePrivatePath, isLBType, isLBTypePrivatePath)
+	}
+	if lbType != isLBTypePrivatePath && profile == isLBProfilePrivatePath {
+		return fmt.Errorf("[ERROR] %s %s is only supported when %s is %s", isLBProfile, isLBProfilePrivatePath, isLBType, isLBTypePrivatePath)
+	}
+	return nil
+}
+
 func resourceIBMISLBCreate(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get(isLBName).(string)
@@ -365,7 +392,7 @@ func resourceIBMISLBCreate(d *schema.ResourceData, meta interface{}) error {
 		lbType = types.(string)
 	}
 
-	if lbType == "private" {
+	if lbType == "private" || lbType == isLBTypePrivatePath {
 		isPublic = false
 	}
 
@@ -440,8 +467,11 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		}
 	}
 
-	if _, ok := d.GetOk(isLBProfile); ok {
-		profile := d.Get(isLBProfile).(string)
+	profile := d.Get(isLBProfile).(string)
+	if profile == "" && lbType == isLBTypePrivatePath {
+		profile = isLBProfilePrivatePath
+	}
+	if profile != "" {
 		// Construct an instance of the LoadBalancerPoolIdentityByName model
 		loadBalancerProfileIdentityModel := new(vpcv1.LoadBalancerProfileIdentityByName)
 		loadBalancerProfileIdentityModel.Name = &profile
@@ -457,7 +487,13 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		options.Logging = loadBalancerLogging
 	}
 
-	lb, response, err := sess.CreateLoadBalancer(options)
+	var lb *vpcv1.LoadBalancer
+	var response *core.DetailedResponse
+	if lbType == isLBTypePrivatePath {
+		lb, response, err = lbCreatePrivatePath(sess, options)
+	} else {
+		lb, response, err = sess.CreateLoadBalancer(options)
+	}
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating Load Balancer err %s\n%s", err, response)
 	}
@@ -488,6 +524,52 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 	return nil
 }
 
+// lbCreatePrivatePath creates a private path load balancer from the create options, the vpc-go-sdk
+// version used by the provider doesn't model is_private_path.
+func lbCreatePrivatePath(sess *vpcv1.VpcV1, options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+	body := map[string]interface{}{
+		"is_public":       false,
+		"is_private_path": true,
+		"subnets":         options.Subnets,
+		"profile":         options.Profile,
+	}
+	if options.Name != nil {
+		body["name"] = options.Name
+	}
+	if options.Dns != nil {
+		body["dns"] = options.Dns
+	}
+	if options.ResourceGroup != nil {
+		body["resource_group"] = options.ResourceGroup
+	}
+	if options.SecurityGroups != nil {
+		body["security_groups"] = options.SecurityGroups
+	}
+
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context.Background(), sess, core.POST, `/load_balancers`, nil, body, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	var lb *vpcv1.LoadBalancer
+	if err = vpcv1.UnmarshalLoadBalancer(result, &lb); err != nil {
+		return nil, response, err
+	}
+	return lb, response, nil
+}
+
+// lbTypeOf returns the type of the load balancer, private path load balancers are the ones with the
+// private path profile.
+func lbTypeOf(lb *vpcv1.LoadBalancer) string {
+	if lb.Profile != nil && lb.Profile.Name != nil && *lb.Profile.Name == isLBProfilePrivatePath {
+		return isLBTypePrivatePath
+	}
+	if lb.IsPublic != nil && *lb.IsPublic {
+		return "public"
+	}
+	return "private"
+}
+
 func resourceIBMISLBRead(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
@@ -526,11 +608,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 		d.Set("dns", nil)
 	}
 	d.Set(isLBName, *lb.Name)
-	if *lb.IsPublic {
-		d.Set(isLBType, "public")
-	} else {
-		d.Set(isLBType, "private")
-	}
+	d.Set(isLBType, lbTypeOf(lb))
 	if lb.RouteMode != nil {
 		d.Set(isLBRouteMode, *lb.RouteMode)
 	}
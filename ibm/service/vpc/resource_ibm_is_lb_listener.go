@@ -15,6 +15,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -33,10 +34,12 @@ const (
 	isLBListenerAcceptProxyProtocol     = "accept_proxy_protocol"
 	isLBListenerProvisioningDone        = "done"
 	isLBListenerID                      = "listener_id"
+	isLBListenerHTTPSRedirect           = "https_redirect"
 	isLBListenerHTTPSRedirectListener   = "https_redirect_listener"
 	isLBListenerHTTPSRedirectStatusCode = "https_redirect_status_code"
 	isLBListenerHTTPSRedirectURI        = "https_redirect_uri"
 	isLBListenerIdleConnectionTimeout   = "idle_connection_timeout"
+	isLBListenerCertificateKeepers      = "certificate_instance_keepers"
 )
 
 func ResourceIBMISLBListener() *schema.Resource {
@@ -107,6 +110,14 @@ func ResourceIBMISLBListener() *schema.Resource {
 				Description: "certificate instance for the Loadbalancer",
 			},
 
+			isLBListenerCertificateKeepers: {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{isLBListenerCertificateInstance},
+				Description:  "Arbitrary values, a change of which sends the certificate instance again so that the listener picks up a renewed certificate, for example the version of a Secrets Manager certificate",
+			},
+
 			isLBListenerAcceptProxyProtocol: {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -114,25 +125,74 @@ func ResourceIBMISLBListener() *schema.Resource {
 				Description: "Listener will forward proxy protocol",
 			},
 
+			isLBListenerHTTPSRedirect: {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{isLBListenerHTTPSRedirectStatusCode, isLBListenerHTTPSRedirectURI, isLBListenerHTTPSRedirectListener},
+				Description:   "If present, the target listener that requests are redirected to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_status_code": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{301, 302, 303, 307, 308}),
+							Description:  "The HTTP status code for this redirect.",
+						},
+						"listener": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Required:    true,
+							Description: "Identity of the listener that will be set as http redirect target.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The unique identifier for this load balancer listener.",
+									},
+									"href": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The listener's canonical URL.",
+									},
+								},
+							},
+						},
+						"uri": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The redirect relative target URI.",
+						},
+					},
+				},
+			},
+
 			isLBListenerHTTPSRedirectStatusCode: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				RequiredWith: []string{isLBListenerHTTPSRedirectListener},
-				Description:  "The HTTP status code to be returned in the redirect response",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				RequiredWith:  []string{isLBListenerHTTPSRedirectListener},
+				ConflictsWith: []string{isLBListenerHTTPSRedirect},
+				Deprecated:    "Please use the argument 'https_redirect'",
+				Description:   "The HTTP status code to be returned in the redirect response",
 			},
 
 			isLBListenerHTTPSRedirectURI: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				RequiredWith: []string{isLBListenerHTTPSRedirectStatusCode, isLBListenerHTTPSRedirectListener},
-				Description:  "Target URI where traffic will be redirected",
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{isLBListenerHTTPSRedirectStatusCode, isLBListenerHTTPSRedirectListener},
+				ConflictsWith: []string{isLBListenerHTTPSRedirect},
+				Deprecated:    "Please use the argument 'https_redirect'",
+				Description:   "Target URI where traffic will be redirected",
 			},
 
 			isLBListenerHTTPSRedirectListener: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				RequiredWith: []string{isLBListenerHTTPSRedirectStatusCode},
-				Description:  "ID of the listener that will be set as http redirect target",
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{isLBListenerHTTPSRedirectStatusCode},
+				ConflictsWith: []string{isLBListenerHTTPSRedirect},
+				Deprecated:    "Please use the argument 'https_redirect'",
+				Description:   "ID of the listener that will be set as http redirect target",
 			},
 
 			isLBListenerConnectionLimit: {
@@ -236,23 +296,7 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 		connLimit = int64(limit.(int))
 	}
 
-	var httpStatusCode int64
-
-	if statusCode, ok := d.GetOk(isLBListenerHTTPSRedirectStatusCode); ok {
-		httpStatusCode = int64(statusCode.(int))
-	}
-
-	var uri string
-
-	if redirecturi, ok := d.GetOk(isLBListenerHTTPSRedirectURI); ok {
-		uri = redirecturi.(string)
-	}
-
-	var listener string
-
-	if redirectListener, ok := d.GetOk(isLBListenerHTTPSRedirectListener); ok {
-		listener = redirectListener.(string)
-	}
+	listener, httpStatusCode, uri := lbListenerHTTPSRedirect(d)
 
 	isLBKey := "load_balancer_key_" + lbID
 	conns.IbmMutexKV.Lock(isLBKey)
@@ -462,7 +506,24 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	if lbListener.DefaultPool != nil {
 		d.Set(isLBListenerDefaultPool, *lbListener.DefaultPool.ID)
 	}
-	if lbListener.HTTPSRedirect != nil {
+	// the redirect is kept in whichever form is configured
+	if _, ok := d.GetOk(isLBListenerHTTPSRedirect); ok {
+		httpsRedirectList := []map[string]interface{}{}
+		if lbListener.HTTPSRedirect != nil {
+			httpsRedirect := map[string]interface{}{
+				"http_status_code": *lbListener.HTTPSRedirect.HTTPStatusCode,
+				"listener": []map[string]interface{}{{
+					"id":   *lbListener.HTTPSRedirect.Listener.ID,
+					"href": *lbListener.HTTPSRedirect.Listener.Href,
+				}},
+			}
+			if lbListener.HTTPSRedirect.URI != nil {
+				httpsRedirect["uri"] = *lbListener.HTTPSRedirect.URI
+			}
+			httpsRedirectList = append(httpsRedirectList, httpsRedirect)
+		}
+		d.Set(isLBListenerHTTPSRedirect, httpsRedirectList)
+	} else if lbListener.HTTPSRedirect != nil {
 		d.Set(isLBListenerHTTPSRedirectStatusCode, *lbListener.HTTPSRedirect.HTTPStatusCode)
 		d.Set(isLBListenerHTTPSRedirectListener, *lbListener.HTTPSRedirect.Listener.ID)
 		if lbListener.HTTPSRedirect.URI != nil {
@@ -524,7 +585,8 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 
 	loadBalancerListenerPatchModel := &vpcv1.LoadBalancerListenerPatch{}
 
-	if d.HasChange(isLBListenerCertificateInstance) {
+	// the listener keeps the certificate it was given, a renewed certificate is only used once it is sent again
+	if d.HasChange(isLBListenerCertificateInstance) || (d.HasChange(isLBListenerCertificateKeepers) && d.Get(isLBListenerCertificateInstance).(string) != "") {
 		certificateInstance = d.Get(isLBListenerCertificateInstance).(string)
 		loadBalancerListenerPatchModel.CertificateInstance = &vpcv1.CertificateInstanceIdentity{
 			CRN: &certificateInstance,
@@ -545,11 +607,9 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	httpsRedirectRemoved := false
 	httpsURIRemoved := false
-	if d.HasChange(isLBListenerHTTPSRedirectListener) || d.HasChange(isLBListenerHTTPSRedirectURI) || d.HasChange(isLBListenerHTTPSRedirectStatusCode) {
+	if d.HasChange(isLBListenerHTTPSRedirect) || d.HasChange(isLBListenerHTTPSRedirectListener) || d.HasChange(isLBListenerHTTPSRedirectURI) || d.HasChange(isLBListenerHTTPSRedirectStatusCode) {
 		hasChanged = true
-		listener = d.Get(isLBListenerHTTPSRedirectListener).(string)
-		httpStatusCode = int64(d.Get(isLBListenerHTTPSRedirectStatusCode).(int))
-		uri = d.Get(isLBListenerHTTPSRedirectURI).(string)
+		listener, httpStatusCode, uri = lbListenerHTTPSRedirect(d)
 		if listener == "" {
 			httpsRedirectRemoved = true
 		} else {
@@ -557,7 +617,7 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 				HTTPStatusCode: &httpStatusCode,
 				Listener:       &vpcv1.LoadBalancerListenerIdentityByID{ID: &listener},
 			}
-			if d.HasChange(isLBListenerHTTPSRedirectURI) {
+			if d.HasChange(isLBListenerHTTPSRedirectURI) || d.HasChange(isLBListenerHTTPSRedirect+".0.uri") {
 				if uri == "" {
 					HTTPSRedirect.URI = nil
 					httpsURIRemoved = true
@@ -782,3 +842,18 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	return true, nil
 }
+
+// lbListenerHTTPSRedirect returns the redirect target listener, status code and URI from the
+// https_redirect block, or from the deprecated https_redirect_* arguments.
+func lbListenerHTTPSRedirect(d *schema.ResourceData) (listener string, httpStatusCode int64, uri string) {
+	if _, ok := d.GetOk(isLBListenerHTTPSRedirect); ok {
+		listener = d.Get(isLBListenerHTTPSRedirect + ".0.listener.0.id").(string)
+		httpStatusCode = int64(d.Get(isLBListenerHTTPSRedirect + ".0.http_status_code").(int))
+		uri = d.Get(isLBListenerHTTPSRedirect + ".0.uri").(string)
+		return
+	}
+	listener = d.Get(isLBListenerHTTPSRedirectListener).(string)
+	httpStatusCode = int64(d.Get(isLBListenerHTTPSRedirectStatusCode).(int))
+	uri = d.Get(isLBListenerHTTPSRedirectURI).(string)
+	return
+}
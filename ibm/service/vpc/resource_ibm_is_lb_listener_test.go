@@ -438,6 +438,104 @@ func testAccCheckIBMISLBUdpListenerConfig(vpcname, subnetname, zone, cidr, lbnam
 
 }
 
+func TestAccIBMISLBListenerHttpRedirectBlock_basic(t *testing.T) {
+	var lb string
+	vpcname := fmt.Sprintf("tflblis-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflblis-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tflblis%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBListenerHttpsRedirectBlockConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, 301, "/example?doc=geta"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBListenerExists("ibm_is_lb_listener.lb_listener2", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener2", "https_redirect.0.http_status_code", "301"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener2", "https_redirect.0.uri", "/example?doc=geta"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_lb_listener.lb_listener2", "https_redirect.0.listener.0.id", "ibm_is_lb_listener.lb_listener1", "listener_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBListenerHttpsRedirectBlockConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, 308, "/example?doc=updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBListenerExists("ibm_is_lb_listener.lb_listener2", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener2", "https_redirect.0.http_status_code", "308"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener2", "https_redirect.0.uri", "/example?doc=updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISLBListener_certificateKeepers(t *testing.T) {
+	var lb string
+	vpcname := fmt.Sprintf("tflblis-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflblis-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tflblis%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBListenerCertificateKeepersConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBListenerExists("ibm_is_lb_listener.lb_listener", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener", "certificate_instance_keepers.version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBListenerCertificateKeepersConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBListenerExists("ibm_is_lb_listener.lb_listener", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener", "certificate_instance_keepers.version", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_listener.lb_listener", "certificate_instance", acc.LbListerenerCertificateInstance),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBListenerCertificateKeepersConfig(vpcname, subnetname, zone, cidr, lbname, version string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+	}
+	resource "ibm_is_lb_listener" "lb_listener"{
+		lb       = ibm_is_lb.testacc_LB.id
+		port     = "9086"
+		protocol = "https"
+		certificate_instance="%s"
+		certificate_instance_keepers = {
+			version = "%s"
+		}
+	  }`, vpcname, subnetname, zone, cidr, lbname, acc.LbListerenerCertificateInstance, version)
+
+}
+
 func testAccCheckIBMISLBListenerHttpsRedirectConfig(vpcname, subnetname, zone, cidr, lbname, port, protocol string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -614,3 +712,41 @@ func testAccCheckIBMISLBListenerConfigUpdate(vpcname, subnetname, zone, cidr, lb
 }`, vpcname, subnetname, zone, cidr, lbname, port, protocol, connLimit)
 
 }
+
+func testAccCheckIBMISLBListenerHttpsRedirectBlockConfig(vpcname, subnetname, zone, cidr, lbname string, statusCode int, uri string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+	}
+	resource "ibm_is_lb_listener" "lb_listener1"{
+		lb       = ibm_is_lb.testacc_LB.id
+		port     = "9086"
+		protocol = "https"
+		certificate_instance="%s"
+	}
+
+	resource "ibm_is_lb_listener" "lb_listener2"{
+		lb       = ibm_is_lb.testacc_LB.id
+		port     = "9087"
+		protocol = "http"
+		https_redirect {
+			http_status_code = %d
+			listener {
+				id = ibm_is_lb_listener.lb_listener1.listener_id
+			}
+			uri = "%s"
+		}
+	}`, vpcname, subnetname, zone, cidr, lbname, acc.LbListerenerCertificateInstance, statusCode, uri)
+
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -63,6 +65,7 @@ func ResourceIBMISLBPool() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceIBMISLBPoolCookieValidate(diff)
 			},
+			resourceIBMISLBPoolFamilyValidate,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -127,10 +130,11 @@ func ResourceIBMISLBPool() *schema.Resource {
 			},
 
 			isLBPoolHealthMonitorPort: {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Health monitor Port the LB Pool",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Health monitor Port the LB Pool, overrides the member port for health checks",
 			},
 
 			isLBPoolSessPersistenceType: {
@@ -247,6 +251,64 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 	return &ibmISLBPoolResourceValidator
 }
 
+// resourceIBMISLBPoolFamilyValidate rejects pool settings the family of the load balancer doesn't
+// support, so they fail at plan time instead of after the load balancer is updated.
+func resourceIBMISLBPoolFamilyValidate(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges(isLBID, isLBPoolProtocol, isLBPoolProxyProtocol, isLBPoolSessPersistenceType) {
+		return nil
+	}
+	if !diff.NewValueKnown(isLBID) || !diff.NewValueKnown(isLBPoolProtocol) ||
+		!diff.NewValueKnown(isLBPoolProxyProtocol) || !diff.NewValueKnown(isLBPoolSessPersistenceType) {
+		return nil
+	}
+	lbID := diff.Get(isLBID).(string)
+	if lbID == "" {
+		return nil
+	}
+	protocol := diff.Get(isLBPoolProtocol).(string)
+	proxyProtocol := diff.Get(isLBPoolProxyProtocol).(string)
+	sessionPersistenceType := diff.Get(isLBPoolSessPersistenceType).(string)
+	// every family supports these settings, the load balancer only needs to be looked up for the others
+	if protocol == "tcp" && (proxyProtocol == "" || proxyProtocol == "disabled") &&
+		(sessionPersistenceType == "" || sessionPersistenceType == "source_ip") {
+		return nil
+	}
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	lb, response, err := sess.GetLoadBalancerWithContext(context, &vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			// the load balancer is being replaced, it is validated on create
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+	}
+	if lb.Profile == nil || lb.Profile.Family == nil {
+		return nil
+	}
+	family := *lb.Profile.Family
+
+	if strings.EqualFold(family, "network") {
+		if protocol != "tcp" && protocol != "udp" {
+			return fmt.Errorf("[ERROR] Load Balancer Pool: %s %q isn't supported by network load balancers, use tcp or udp", isLBPoolProtocol, protocol)
+		}
+		if proxyProtocol != "" && proxyProtocol != "disabled" {
+			return fmt.Errorf("[ERROR] Load Balancer Pool: %s %q isn't supported by network load balancers, only disabled is", isLBPoolProxyProtocol, proxyProtocol)
+		}
+		if sessionPersistenceType != "" && sessionPersistenceType != "source_ip" {
+			return fmt.Errorf("[ERROR] Load Balancer Pool: %s %q isn't supported by network load balancers, only source_ip is", isLBPoolSessPersistenceType, sessionPersistenceType)
+		}
+	} else if protocol == "udp" {
+		return fmt.Errorf("[ERROR] Load Balancer Pool: %s udp is only supported by network load balancers", isLBPoolProtocol)
+	}
+	return nil
+}
+
 func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] LB Pool create")
@@ -214,6 +214,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 		options.Target = target
 	}
 	if w, ok := d.GetOkExists(isLBPoolMemberWeight); ok {
+		lbPoolMemberWarnWeight(d, sess, lbID, lbPoolID)
 		weight = int64(w.(int))
 		options.Weight = &weight
 	}
@@ -377,6 +378,9 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 
 		port := int64(d.Get(isLBPoolMemberPort).(int))
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
+		if d.HasChange(isLBPoolMemberWeight) {
+			lbPoolMemberWarnWeight(d, sess, lbID, lbPoolID)
+		}
 
 		isLBKey := "load_balancer_key_" + lbID
 		conns.IbmMutexKV.Lock(isLBKey)
@@ -626,3 +630,24 @@ func getPoolId(id string) (string, error) {
 		return id, nil
 	}
 }
+
+// lbPoolMemberWarnWeight logs a warning when a weight is configured for a member of a pool that
+// doesn't use the weighted_round_robin algorithm, as the weight is ignored by the load balancer.
+// It runs on apply, once changes of the pool's algorithm have been made.
+func lbPoolMemberWarnWeight(d *schema.ResourceData, sess *vpcv1.VpcV1, lbID, lbPoolID string) {
+	if d.GetRawConfig().GetAttr(isLBPoolMemberWeight).IsNull() {
+		return
+	}
+	getLoadBalancerPoolOptions := &vpcv1.GetLoadBalancerPoolOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbPoolID,
+	}
+	lbPool, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
+	if err != nil {
+		log.Printf("[WARN] Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return
+	}
+	if lbPool.Algorithm != nil && *lbPool.Algorithm != "weighted_round_robin" {
+		log.Printf("[WARN] Load balancer pool member %s only takes effect in pools using the weighted_round_robin algorithm, pool %s uses %s", isLBPoolMemberWeight, lbPoolID, *lbPool.Algorithm)
+	}
+}
@@ -174,6 +174,31 @@ func TestAccIBMISLBPoolMember_basic_opt_weight_check(t *testing.T) {
 	})
 }
 
+func TestAccIBMISLBPoolMember_weightRoundRobin(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpm-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpmc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+	port := "8080"
+	address := "127.0.0.1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the weight is ignored by the round_robin pool, it only logs a warning
+				Config: testAccCheckIBMISLBPoolMemberWeightRoundRobinConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, port, address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool_member.testacc_lb_mem_wgt", "weight", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISLBPoolMemberDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -417,3 +442,38 @@ func testAccCheckIBMISLBPoolMemberWeightConfig(vpcname, subnetname, zone, cidr,
 		target_address = "%s"
 }`, vpcname, subnetname, zone, cidr, name, poolName, port, address)
 }
+
+func testAccCheckIBMISLBPoolMemberWeightRoundRobinConfig(vpcname, subnetname, zone, cidr, name, poolName, port, address string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+	}
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name = "%s"
+		lb = "${ibm_is_lb.testacc_LB.id}"
+		algorithm = "round_robin"
+		protocol = "http"
+		health_delay= 45
+		health_retries = 5
+		health_timeout = 30
+		health_type = "tcp"
+	}
+	resource "ibm_is_lb_pool_member" "testacc_lb_mem_wgt" {
+		lb = "${ibm_is_lb.testacc_LB.id}"
+		pool = "${element(split("/",ibm_is_lb_pool.testacc_lb_pool.id),1)}"
+		port 	=	"%s"
+		target_address = "%s"
+		weight = 0
+}`, vpcname, subnetname, zone, cidr, name, poolName, port, address)
+}
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -401,6 +402,43 @@ func TestAccIBMISLBPool_SessionPersistence(t *testing.T) {
 	})
 }
 
+func TestAccIBMISLBPool_networkFamily(t *testing.T) {
+	var lb string
+	vpcname := fmt.Sprintf("tflbp-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+	alg1 := "round_robin"
+	delay1 := "5"
+	retries1 := "2"
+	timeout1 := "2"
+	healthType1 := "tcp"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolNetworkConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, alg1, "tcp", "disabled", delay1, retries1, timeout1, healthType1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolExists("ibm_is_lb_pool.testacc_lb_pool", lb),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool.testacc_lb_pool", "proxy_protocol", "disabled"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISLBPoolNetworkConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, alg1, "tcp", "v2", delay1, retries1, timeout1, healthType1),
+				ExpectError: regexp.MustCompile(`proxy_protocol "v2" isn't supported by network load balancers`),
+			},
+			{
+				Config:      testAccCheckIBMISLBPoolNetworkConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, alg1, "http", "disabled", delay1, retries1, timeout1, healthType1),
+				ExpectError: regexp.MustCompile(`protocol "http" isn't supported by network load balancers`),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISLBPoolDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -676,3 +714,35 @@ func testAccCheckIBMISLBPoolConfigWithProxy(vpcname, subnetname, zone, cidr, nam
 }`, vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, proxyProtocol, delay, retries, timeout, healthType)
 
 }
+
+func testAccCheckIBMISLBPoolNetworkConfig(vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, proxyProtocol, delay, retries, timeout, healthType string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+		profile = "network-fixed"
+		type = "public"
+	}
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name = "%s"
+		lb = "${ibm_is_lb.testacc_LB.id}"
+		algorithm = "%s"
+		protocol = "%s"
+		proxy_protocol = "%s"
+		health_delay= %s
+		health_retries = %s
+		health_timeout = %s
+		health_type = "%s"
+}`, vpcname, subnetname, zone, cidr, name, poolName, algorithm, protocol, proxyProtocol, delay, retries, timeout, healthType)
+
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -60,7 +61,7 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The rule that this rule is immediately before. If absent, this is the last rule.",
+				Description: "The rule that this rule is immediately before, null to make it the last rule. If absent, the rule is created as the last rule.",
 			},
 			isNetworkACLRuleProtocol: {
 				Type:        schema.TypeString,
@@ -331,6 +332,11 @@ func ResourceIBMISNetworkACLRuleValidator() *validate.ResourceValidator {
 func resourceIBMISNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	nwACLID := d.Get(isNwACLID).(string)
 
+	// rules are inserted relative to the other rules of the network ACL, don't let them race
+	isNwACLRuleKey := "network_acl_rule_key_" + nwACLID
+	conns.IbmMutexKV.Lock(isNwACLRuleKey)
+	defer conns.IbmMutexKV.Unlock(isNwACLRuleKey)
+
 	err := nwaclRuleCreate(d, meta, nwACLID)
 	if err != nil {
 		return err
@@ -371,7 +377,8 @@ func nwaclRuleCreate(d *schema.ResourceData, meta interface{}, nwACLID string) e
 		Name:        &name,
 	}
 
-	if before, ok := d.GetOk(isNwACLRuleBefore); ok {
+	// "null" places the rule last, as when before is absent
+	if before, ok := d.GetOk(isNwACLRuleBefore); ok && before.(string) != "null" {
 		beforeStr := before.(string)
 		ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
 			ID: &beforeStr,
@@ -490,9 +497,7 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 			rulex := nwaclRule.(*vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp)
 			d.SetId(makeTerraformACLRuleID(nwACLID, *rulex.ID))
 			d.Set(isNwACLRuleId, *rulex.ID)
-			if rulex.Before != nil {
-				d.Set(isNwACLRuleBefore, *rulex.Before.ID)
-			}
+			nwaclRuleSetBefore(d, rulex.Before)
 			d.Set(isNetworkACLRuleName, *rulex.Name)
 			d.Set(isNetworkACLRuleHref, *rulex.Href)
 			d.Set(isNetworkACLRuleProtocol, *rulex.Protocol)
@@ -517,9 +522,7 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 			rulex := nwaclRule.(*vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp)
 			d.SetId(makeTerraformACLRuleID(nwACLID, *rulex.ID))
 			d.Set(isNwACLRuleId, *rulex.ID)
-			if rulex.Before != nil {
-				d.Set(isNwACLRuleBefore, *rulex.Before.ID)
-			}
+			nwaclRuleSetBefore(d, rulex.Before)
 			d.Set(isNetworkACLRuleHref, *rulex.Href)
 			d.Set(isNetworkACLRuleProtocol, *rulex.Protocol)
 			d.Set(isNetworkACLRuleName, *rulex.Name)
@@ -557,9 +560,7 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 			rulex := nwaclRule.(*vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll)
 			d.SetId(makeTerraformACLRuleID(nwACLID, *rulex.ID))
 			d.Set(isNwACLRuleId, *rulex.ID)
-			if rulex.Before != nil {
-				d.Set(isNwACLRuleBefore, *rulex.Before.ID)
-			}
+			nwaclRuleSetBefore(d, rulex.Before)
 			d.Set(isNetworkACLRuleHref, *rulex.Href)
 			d.Set(isNetworkACLRuleProtocol, *rulex.Protocol)
 			d.Set(isNetworkACLRuleName, *rulex.Name)
@@ -579,6 +580,13 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 func resourceIBMISNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 	nwACLId, ruleId, err := parseNwACLTerraformID(id)
+	if err != nil {
+		return err
+	}
+
+	isNwACLRuleKey := "network_acl_rule_key_" + nwACLId
+	conns.IbmMutexKV.Lock(isNwACLRuleKey)
+	defer conns.IbmMutexKV.Unlock(isNwACLRuleKey)
 
 	err = nwaclRuleUpdate(d, meta, ruleId, nwACLId)
 	if err != nil {
@@ -764,6 +772,10 @@ func resourceIBMISNetworkACLRuleDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	isNwACLRuleKey := "network_acl_rule_key_" + nwACLID
+	conns.IbmMutexKV.Lock(isNwACLRuleKey)
+	defer conns.IbmMutexKV.Unlock(isNwACLRuleKey)
+
 	err = nwaclRuleDelete(d, meta, ruleId, nwACLID)
 	if err != nil {
 		return err
@@ -823,6 +835,16 @@ func nwaclRuleExists(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	return true, nil
 }
 
+// nwaclRuleSetBefore sets the rule the rule is immediately before. A rule that became the last rule
+// is set to "null" once before is tracked, so that a configured before is put back on the next apply.
+func nwaclRuleSetBefore(d *schema.ResourceData, before *vpcv1.NetworkACLRuleReference) {
+	if before != nil && before.ID != nil {
+		d.Set(isNwACLRuleBefore, *before.ID)
+	} else if d.Get(isNwACLRuleBefore).(string) != "" {
+		d.Set(isNwACLRuleBefore, "null")
+	}
+}
+
 func makeTerraformACLRuleID(id1, id2 string) string {
 	// Include both network acl id and rule id to create a unique Terraform id.  As a bonus,
 	// we can extract the network acl id as needed for API calls such as READ.
@@ -205,6 +205,37 @@ func TestNetworkACLRule_basicBeforeRule(t *testing.T) {
 	})
 }
 
+func TestNetworkACLRule_beforeNullOnCreate(t *testing.T) {
+	var nwACLRule string
+	vpcName := fmt.Sprintf("tf-nacl-vpc-%d", acctest.RandIntRange(10, 100))
+	ruleName := fmt.Sprintf("tf-outbound-udp-%d", acctest.RandIntRange(10, 100))
+	ruleName1 := fmt.Sprintf("tf-outbound-udp1-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkNetworkACLRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLRuleBeforeUpdateConfig(vpcName, ruleName, ruleName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLRuleExists("ibm_is_network_acl_rule.testacc_nacl", nwACLRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rule.testacc_nacl", "name", ruleName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rule.testacc_nacl", "before", "null"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISNetworkACLRuleBeforeConfig(vpcName, ruleName, ruleName1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_network_acl_rule.testacc_nacl", "before", "ibm_is_network_acl_rule.testacc_nacl_1", "rule_id"),
+				),
+			},
+		},
+	})
+}
+
 func checkNetworkACLRuleDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
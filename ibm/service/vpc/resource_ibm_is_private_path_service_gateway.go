@@ -0,0 +1,514 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isPrivatePathServiceGatewayStable   = "stable"
+	isPrivatePathServiceGatewayFailed   = "failed"
+	isPrivatePathServiceGatewayPending  = "pending"
+	isPrivatePathServiceGatewayUpdating = "updating"
+	isPrivatePathServiceGatewayWaiting  = "waiting"
+	isPrivatePathServiceGatewayDeleting = "deleting"
+	isPrivatePathServiceGatewayDeleted  = "deleted"
+)
+
+// privatePathServiceGateway is the private path service gateway returned by the VPC API, the
+// vpc-go-sdk version used by the provider doesn't model private path service gateways.
+type privatePathServiceGateway struct {
+	CreatedAt                               *string                       `json:"created_at,omitempty"`
+	CRN                                     *string                       `json:"crn,omitempty"`
+	DefaultAccessPolicy                     *string                       `json:"default_access_policy,omitempty"`
+	EndpointGatewayBindingAutoDelete        *bool                         `json:"endpoint_gateway_binding_auto_delete,omitempty"`
+	EndpointGatewayBindingAutoDeleteTimeout *int64                        `json:"endpoint_gateway_binding_auto_delete_timeout,omitempty"`
+	EndpointGatewayCount                    *int64                        `json:"endpoint_gateway_count,omitempty"`
+	Href                                    *string                       `json:"href,omitempty"`
+	ID                                      *string                       `json:"id,omitempty"`
+	LifecycleState                          *string                       `json:"lifecycle_state,omitempty"`
+	LoadBalancer                            *vpcv1.LoadBalancerIdentity   `json:"load_balancer,omitempty"`
+	Name                                    *string                       `json:"name,omitempty"`
+	Published                               *bool                         `json:"published,omitempty"`
+	ResourceGroup                           *vpcv1.ResourceGroupReference `json:"resource_group,omitempty"`
+	ResourceType                            *string                       `json:"resource_type,omitempty"`
+	ServiceEndpoints                        []string                      `json:"service_endpoints,omitempty"`
+	VPC                                     *vpcv1.VPCReference           `json:"vpc,omitempty"`
+	ZonalAffinity                           *bool                         `json:"zonal_affinity,omitempty"`
+}
+
+func ResourceIBMIsPrivatePathServiceGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayRead,
+		UpdateContext: resourceIBMIsPrivatePathServiceGatewayUpdate,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway", "name"),
+				Description:  "The name for this private path service gateway. The name must not be used by another private path service gateway in the VPC.",
+			},
+			"load_balancer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the private path load balancer for this private path service gateway.",
+			},
+			"service_endpoints": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The fully qualified domain names for this private path service gateway.",
+			},
+			"default_access_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway", "default_access_policy"),
+				Description:  "The access policy for accounts without an account policy: deny, permit or review.",
+			},
+			"zonal_affinity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether this private path service gateway has zonal affinity.",
+			},
+			"endpoint_gateway_binding_auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether endpoint gateway bindings are deleted after they are abandoned for endpoint_gateway_binding_auto_delete_timeout hours.",
+			},
+			"endpoint_gateway_binding_auto_delete_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway", "endpoint_gateway_binding_auto_delete_timeout"),
+				Description:  "The number of hours after which abandoned endpoint gateway bindings are deleted.",
+			},
+			"published": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether this private path service gateway is published, so that any account can request access to it.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group for this private path service gateway.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the private path service gateway was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this private path service gateway.",
+			},
+			"endpoint_gateway_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of endpoint gateways using this private path service gateway.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this private path service gateway.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the private path service gateway.",
+			},
+			"private_path_service_gateway": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this private path service gateway.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPC this private path service gateway resides in.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "default_access_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "deny, permit, review",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_gateway_binding_auto_delete_timeout",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "720",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPrivatePathServiceGatewayCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{
+		"load_balancer":     map[string]string{"id": d.Get("load_balancer").(string)},
+		"service_endpoints": flex.ExpandStringList(d.Get("service_endpoints").(*schema.Set).List()),
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	if defaultAccessPolicy, ok := d.GetOk("default_access_policy"); ok {
+		body["default_access_policy"] = defaultAccessPolicy.(string)
+	}
+	if zonalAffinity, ok := d.GetOkExists("zonal_affinity"); ok {
+		body["zonal_affinity"] = zonalAffinity.(bool)
+	}
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		body["resource_group"] = map[string]string{"id": resourceGroup.(string)}
+	}
+
+	privatePathServiceGateway := &privatePathServiceGateway{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/private_path_service_gateways`, nil, body, privatePathServiceGateway)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*privatePathServiceGateway.ID)
+
+	_, err = isWaitForPrivatePathServiceGatewayStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the binding auto delete settings can't be set at create.
+	patch := map[string]interface{}{}
+	if autoDelete, ok := d.GetOkExists("endpoint_gateway_binding_auto_delete"); ok {
+		patch["endpoint_gateway_binding_auto_delete"] = autoDelete.(bool)
+	}
+	if timeout, ok := d.GetOk("endpoint_gateway_binding_auto_delete_timeout"); ok {
+		patch["endpoint_gateway_binding_auto_delete_timeout"] = timeout.(int)
+	}
+	if len(patch) > 0 {
+		if err = updatePrivatePathServiceGateway(context, vpcClient, d.Id(), patch, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("published").(bool) {
+		if err = publishPrivatePathServiceGateway(context, vpcClient, d.Id(), true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGateway, response, err := getPrivatePathServiceGateway(context, vpcClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", privatePathServiceGateway.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if privatePathServiceGateway.LoadBalancer != nil {
+		if err = d.Set("load_balancer", privatePathServiceGateway.LoadBalancer.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting load_balancer: %s", err))
+		}
+	}
+	if err = d.Set("service_endpoints", privatePathServiceGateway.ServiceEndpoints); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting service_endpoints: %s", err))
+	}
+	if err = d.Set("default_access_policy", privatePathServiceGateway.DefaultAccessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting default_access_policy: %s", err))
+	}
+	if err = d.Set("zonal_affinity", privatePathServiceGateway.ZonalAffinity); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting zonal_affinity: %s", err))
+	}
+	if err = d.Set("endpoint_gateway_binding_auto_delete", privatePathServiceGateway.EndpointGatewayBindingAutoDelete); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_gateway_binding_auto_delete: %s", err))
+	}
+	if err = d.Set("endpoint_gateway_binding_auto_delete_timeout", flex.IntValue(privatePathServiceGateway.EndpointGatewayBindingAutoDeleteTimeout)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_gateway_binding_auto_delete_timeout: %s", err))
+	}
+	if err = d.Set("published", privatePathServiceGateway.Published); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting published: %s", err))
+	}
+	if privatePathServiceGateway.ResourceGroup != nil {
+		if err = d.Set("resource_group", privatePathServiceGateway.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting resource_group: %s", err))
+		}
+	}
+	if privatePathServiceGateway.VPC != nil {
+		if err = d.Set("vpc", privatePathServiceGateway.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting vpc: %s", err))
+		}
+	}
+	if err = d.Set("created_at", privatePathServiceGateway.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", privatePathServiceGateway.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("endpoint_gateway_count", flex.IntValue(privatePathServiceGateway.EndpointGatewayCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_gateway_count: %s", err))
+	}
+	if err = d.Set("href", privatePathServiceGateway.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", privatePathServiceGateway.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("private_path_service_gateway", privatePathServiceGateway.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_path_service_gateway: %s", err))
+	}
+	if err = d.Set("resource_type", privatePathServiceGateway.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	if d.HasChange("name") {
+		patch["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("load_balancer") {
+		patch["load_balancer"] = map[string]string{"id": d.Get("load_balancer").(string)}
+	}
+	if d.HasChange("default_access_policy") {
+		patch["default_access_policy"] = d.Get("default_access_policy").(string)
+	}
+	if d.HasChange("zonal_affinity") {
+		patch["zonal_affinity"] = d.Get("zonal_affinity").(bool)
+	}
+	if d.HasChange("endpoint_gateway_binding_auto_delete") {
+		patch["endpoint_gateway_binding_auto_delete"] = d.Get("endpoint_gateway_binding_auto_delete").(bool)
+	}
+	if d.HasChange("endpoint_gateway_binding_auto_delete_timeout") {
+		patch["endpoint_gateway_binding_auto_delete_timeout"] = d.Get("endpoint_gateway_binding_auto_delete_timeout").(int)
+	}
+	if len(patch) > 0 {
+		if err = updatePrivatePathServiceGateway(context, vpcClient, d.Id(), patch, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("published") {
+		if err = publishPrivatePathServiceGateway(context, vpcClient, d.Id(), d.Get("published").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/private_path_service_gateways/{id}`,
+		map[string]string{"id": d.Id()}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeletePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeletePrivatePathServiceGatewayWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForPrivatePathServiceGatewayDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getPrivatePathServiceGateway(context context.Context, vpcClient *vpcv1.VpcV1, id string) (*privatePathServiceGateway, *core.DetailedResponse, error) {
+	privatePathServiceGateway := &privatePathServiceGateway{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/private_path_service_gateways/{id}`,
+		map[string]string{"id": id}, nil, privatePathServiceGateway)
+	if err != nil {
+		return nil, response, err
+	}
+	return privatePathServiceGateway, response, nil
+}
+
+func updatePrivatePathServiceGateway(context context.Context, vpcClient *vpcv1.VpcV1, id string, patch map[string]interface{}, timeout time.Duration) error {
+	response, err := vpcRequest(context, vpcClient, core.PATCH, `/private_path_service_gateways/{id}`,
+		map[string]string{"id": id}, patch, &privatePathServiceGateway{})
+	if err != nil {
+		log.Printf("[DEBUG] UpdatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdatePrivatePathServiceGatewayWithContext failed %s\n%s", err, response)
+	}
+	_, err = isWaitForPrivatePathServiceGatewayStable(context, vpcClient, id, timeout)
+	return err
+}
+
+// publishPrivatePathServiceGateway publishes the private path service gateway, or unpublishes it so
+// that only accounts with an account policy can request access to it.
+func publishPrivatePathServiceGateway(context context.Context, vpcClient *vpcv1.VpcV1, id string, publish bool) error {
+	path, operation := `/private_path_service_gateways/{id}/unpublish`, "UnpublishPrivatePathServiceGatewayWithContext"
+	if publish {
+		path, operation = `/private_path_service_gateways/{id}/publish`, "PublishPrivatePathServiceGatewayWithContext"
+	}
+	response, err := vpcRequest(context, vpcClient, core.POST, path, map[string]string{"id": id}, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] %s failed %s\n%s", operation, err, response)
+		return fmt.Errorf("%s failed %s\n%s", operation, err, response)
+	}
+	return nil
+}
+
+func isWaitForPrivatePathServiceGatewayStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for private path service gateway (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isPrivatePathServiceGatewayPending, isPrivatePathServiceGatewayUpdating, isPrivatePathServiceGatewayWaiting},
+		Target:     []string{isPrivatePathServiceGatewayStable, isPrivatePathServiceGatewayFailed},
+		Refresh:    isPrivatePathServiceGatewayRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isPrivatePathServiceGatewayRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		privatePathServiceGateway, response, err := getPrivatePathServiceGateway(context, vpcClient, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting private path service gateway: %s\n%s", err, response)
+		}
+
+		if *privatePathServiceGateway.LifecycleState == isPrivatePathServiceGatewayFailed {
+			return privatePathServiceGateway, *privatePathServiceGateway.LifecycleState, fmt.Errorf("[ERROR] Private path service gateway (%s) went into failed state", id)
+		}
+		return privatePathServiceGateway, *privatePathServiceGateway.LifecycleState, nil
+	}
+}
+
+func isWaitForPrivatePathServiceGatewayDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for private path service gateway (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isPrivatePathServiceGatewayDeleting, isPrivatePathServiceGatewayStable, isPrivatePathServiceGatewayUpdating},
+		Target:     []string{isPrivatePathServiceGatewayDeleted, isPrivatePathServiceGatewayFailed},
+		Refresh:    isPrivatePathServiceGatewayDeleteRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isPrivatePathServiceGatewayDeleteRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		privatePathServiceGateway, response, err := getPrivatePathServiceGateway(context, vpcClient, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return privatePathServiceGateway, isPrivatePathServiceGatewayDeleted, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error getting private path service gateway: %s\n%s", err, response)
+		}
+
+		if *privatePathServiceGateway.LifecycleState == isPrivatePathServiceGatewayFailed {
+			return privatePathServiceGateway, *privatePathServiceGateway.LifecycleState, fmt.Errorf("[ERROR] Private path service gateway (%s) failed to delete", id)
+		}
+		return privatePathServiceGateway, *privatePathServiceGateway.LifecycleState, nil
+	}
+}
+
+func privatePathServiceGatewayChildID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of privatePathServiceGatewayID/ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// privatePathServiceGatewayAccountPolicy is an account policy of a private path service gateway as
+// returned by the VPC API.
+type privatePathServiceGatewayAccountPolicy struct {
+	AccessPolicy *string                           `json:"access_policy,omitempty"`
+	Account      *privatePathServiceGatewayAccount `json:"account,omitempty"`
+	CreatedAt    *string                           `json:"created_at,omitempty"`
+	Href         *string                           `json:"href,omitempty"`
+	ID           *string                           `json:"id,omitempty"`
+	ResourceType *string                           `json:"resource_type,omitempty"`
+	UpdatedAt    *string                           `json:"updated_at,omitempty"`
+}
+
+type privatePathServiceGatewayAccount struct {
+	ID           *string `json:"id,omitempty"`
+	ResourceType *string `json:"resource_type,omitempty"`
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayAccountPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead,
+		UpdateContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyUpdate,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the account this policy applies to.",
+			},
+			"access_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway_account_policy", "access_policy"),
+				Description:  "The access policy for the account: deny, permit or review. It applies to the endpoint gateway bindings the account requests from now on.",
+			},
+			"account_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this account policy.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the account policy was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this account policy.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the account policy was updated.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayAccountPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "deny, permit, review",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway_account_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID := d.Get("private_path_service_gateway").(string)
+	body := map[string]interface{}{
+		"access_policy": d.Get("access_policy").(string),
+		"account":       map[string]string{"id": d.Get("account").(string)},
+	}
+
+	accountPolicy := &privatePathServiceGatewayAccountPolicy{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/private_path_service_gateways/{private_path_service_gateway_id}/account_policies`,
+		map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID}, body, accountPolicy)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", privatePathServiceGatewayID, *accountPolicy.ID))
+
+	return resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID, id, err := privatePathServiceGatewayChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountPolicy := &privatePathServiceGatewayAccountPolicy{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/private_path_service_gateways/{private_path_service_gateway_id}/account_policies/{id}`,
+		map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID, "id": id}, nil, accountPolicy)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("private_path_service_gateway", privatePathServiceGatewayID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_path_service_gateway: %s", err))
+	}
+	if accountPolicy.Account != nil {
+		if err = d.Set("account", accountPolicy.Account.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting account: %s", err))
+		}
+	}
+	if err = d.Set("access_policy", accountPolicy.AccessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting access_policy: %s", err))
+	}
+	if err = d.Set("account_policy", accountPolicy.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_policy: %s", err))
+	}
+	if err = d.Set("created_at", accountPolicy.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("href", accountPolicy.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("resource_type", accountPolicy.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+	if err = d.Set("updated_at", accountPolicy.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID, id, err := privatePathServiceGatewayChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("access_policy") {
+		body := map[string]interface{}{
+			"access_policy": d.Get("access_policy").(string),
+		}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/private_path_service_gateways/{private_path_service_gateway_id}/account_policies/{id}`,
+			map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID, "id": id}, body, &privatePathServiceGatewayAccountPolicy{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdatePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID, id, err := privatePathServiceGatewayChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/private_path_service_gateways/{private_path_service_gateway_id}/account_policies/{id}`,
+		map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID, "id": id}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeletePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeletePrivatePathServiceGatewayAccountPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isPrivatePathServiceGatewayEndpointGatewayBindingPermit = "permit"
+	isPrivatePathServiceGatewayEndpointGatewayBindingDeny   = "deny"
+)
+
+// privatePathServiceGatewayEndpointGatewayBinding is an endpoint gateway binding of a private path
+// service gateway as returned by the VPC API. A binding is created when an endpoint gateway in any
+// account targets the private path service gateway.
+type privatePathServiceGatewayEndpointGatewayBinding struct {
+	Account        *privatePathServiceGatewayAccount `json:"account,omitempty"`
+	CreatedAt      *string                           `json:"created_at,omitempty"`
+	ExpirationAt   *string                           `json:"expiration_at,omitempty"`
+	Href           *string                           `json:"href,omitempty"`
+	ID             *string                           `json:"id,omitempty"`
+	LifecycleState *string                           `json:"lifecycle_state,omitempty"`
+	ResourceType   *string                           `json:"resource_type,omitempty"`
+	Status         *string                           `json:"status,omitempty"`
+	UpdatedAt      *string                           `json:"updated_at,omitempty"`
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingCreate,
+		ReadContext:   resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingRead,
+		UpdateContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingUpdate,
+		DeleteContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"private_path_service_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The private path service gateway identifier.",
+			},
+			"endpoint_gateway_binding": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The endpoint gateway binding identifier.",
+			},
+			"access_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_private_path_service_gateway_endpoint_gateway_binding", "access_policy"),
+				Description:  "Whether to permit or deny the endpoint gateway binding: permit or deny.",
+			},
+			"set_account_policy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to also set an account policy with the access policy for the account of the endpoint gateway binding, so that its future requests are handled the same way.",
+			},
+			"account": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account that created the endpoint gateway binding.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the endpoint gateway binding was created.",
+			},
+			"expiration_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration date and time for the endpoint gateway binding while it's pending.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this endpoint gateway binding.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the endpoint gateway binding.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the endpoint gateway binding: abandoned, denied, expired, pending or permitted.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the endpoint gateway binding was updated.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "deny, permit",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_private_path_service_gateway_endpoint_gateway_binding", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID := d.Get("private_path_service_gateway").(string)
+	id := d.Get("endpoint_gateway_binding").(string)
+	err = reviewPrivatePathServiceGatewayEndpointGatewayBinding(context, vpcClient, privatePathServiceGatewayID, id, d.Get("access_policy").(string), d.Get("set_account_policy").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", privatePathServiceGatewayID, id))
+
+	return resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingRead(context, d, meta)
+}
+
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID, id, err := privatePathServiceGatewayChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	binding := &privatePathServiceGatewayEndpointGatewayBinding{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/private_path_service_gateways/{private_path_service_gateway_id}/endpoint_gateway_bindings/{id}`,
+		map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID, "id": id}, nil, binding)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPrivatePathServiceGatewayEndpointGatewayBindingWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPrivatePathServiceGatewayEndpointGatewayBindingWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("private_path_service_gateway", privatePathServiceGatewayID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_path_service_gateway: %s", err))
+	}
+	if err = d.Set("endpoint_gateway_binding", binding.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_gateway_binding: %s", err))
+	}
+	// only a permitted or denied binding reflects a review, the other statuses keep the configured
+	// access policy.
+	switch core.StringNilMapper(binding.Status) {
+	case "permitted":
+		if err = d.Set("access_policy", isPrivatePathServiceGatewayEndpointGatewayBindingPermit); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting access_policy: %s", err))
+		}
+	case "denied":
+		if err = d.Set("access_policy", isPrivatePathServiceGatewayEndpointGatewayBindingDeny); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting access_policy: %s", err))
+		}
+	}
+	if binding.Account != nil {
+		if err = d.Set("account", binding.Account.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting account: %s", err))
+		}
+	}
+	if err = d.Set("created_at", binding.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("expiration_at", binding.ExpirationAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting expiration_at: %s", err))
+	}
+	if err = d.Set("href", binding.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", binding.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", binding.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+	if err = d.Set("status", binding.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("updated_at", binding.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privatePathServiceGatewayID, id, err := privatePathServiceGatewayChildID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("access_policy", "set_account_policy") {
+		err = reviewPrivatePathServiceGatewayEndpointGatewayBinding(context, vpcClient, privatePathServiceGatewayID, id, d.Get("access_policy").(string), d.Get("set_account_policy").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingRead(context, d, meta)
+}
+
+// resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingDelete only removes the review from the
+// state. The binding belongs to the endpoint gateway of the consumer and is deleted with it, the
+// provider can only permit or deny it.
+func resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// reviewPrivatePathServiceGatewayEndpointGatewayBinding permits or denies the endpoint gateway
+// binding, and also sets an account policy for its account when setAccountPolicy is true.
+func reviewPrivatePathServiceGatewayEndpointGatewayBinding(context context.Context, vpcClient *vpcv1.VpcV1, privatePathServiceGatewayID, id, accessPolicy string, setAccountPolicy bool) error {
+	path, operation := `/private_path_service_gateways/{private_path_service_gateway_id}/endpoint_gateway_bindings/{id}/deny`, "DenyPrivatePathServiceGatewayEndpointGatewayBindingWithContext"
+	if accessPolicy == isPrivatePathServiceGatewayEndpointGatewayBindingPermit {
+		path, operation = `/private_path_service_gateways/{private_path_service_gateway_id}/endpoint_gateway_bindings/{id}/permit`, "PermitPrivatePathServiceGatewayEndpointGatewayBindingWithContext"
+	}
+	body := map[string]interface{}{
+		"set_account_policy": setAccountPolicy,
+	}
+	response, err := vpcRequest(context, vpcClient, core.POST, path,
+		map[string]string{"private_path_service_gateway_id": privatePathServiceGatewayID, "id": id}, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] %s failed %s\n%s", operation, err, response)
+		return fmt.Errorf("%s failed %s\n%s", operation, err, response)
+	}
+	return nil
+}
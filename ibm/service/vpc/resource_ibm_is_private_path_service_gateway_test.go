@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsPrivatePathServiceGatewayBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-ppsg-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-ppsg-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, "deny", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_lb.testacc_lb", "type", "private_path"),
+					resource.TestCheckResourceAttr("ibm_is_lb.testacc_lb", "profile", "network-private-path"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "default_access_policy", "deny"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "published", "false"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrPair("ibm_is_private_path_service_gateway.is_ppsg", "load_balancer", "ibm_is_lb.testacc_lb", "id"),
+					resource.TestCheckResourceAttrPair("ibm_is_private_path_service_gateway.is_ppsg", "vpc", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttrSet("ibm_is_private_path_service_gateway.is_ppsg", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, nameUpdate, "review", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "default_access_policy", "review"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway.is_ppsg", "published", "true"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_private_path_service_gateway.is_ppsg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIsPrivatePathServiceGatewayLBValidation(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "ibm_is_vpc" "testacc_vpc" {
+					name = "%s"
+				}
+
+				resource "ibm_is_subnet" "testacc_subnet" {
+					name            = "%s"
+					vpc             = ibm_is_vpc.testacc_vpc.id
+					zone            = "%s"
+					ipv4_cidr_block = "%s"
+				}
+
+				resource "ibm_is_lb" "testacc_lb" {
+					name    = "%s"
+					subnets = [ibm_is_subnet.testacc_subnet.id]
+					type    = "private"
+					profile = "network-private-path"
+				}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname),
+				ExpectError: regexp.MustCompile(`profile network-private-path is only supported when type is private_path`),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsPrivatePathServiceGatewayAccountPolicyBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-ppsg-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayAccountPolicyConfig(vpcname, subnetname, lbname, name, "permit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_account_policy.is_ppsg_account_policy", "account", acc.ISPrivatePathAccountID),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_account_policy.is_ppsg_account_policy", "access_policy", "permit"),
+					resource.TestCheckResourceAttrPair("ibm_is_private_path_service_gateway_account_policy.is_ppsg_account_policy", "private_path_service_gateway", "ibm_is_private_path_service_gateway.is_ppsg", "id"),
+					resource.TestCheckResourceAttrSet("ibm_is_private_path_service_gateway_account_policy.is_ppsg_account_policy", "account_policy"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayAccountPolicyConfig(vpcname, subnetname, lbname, name, "review"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_account_policy.is_ppsg_account_policy", "access_policy", "review"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_private_path_service_gateway_account_policy.is_ppsg_account_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIsPrivatePathServiceGatewayEndpointGatewayBindingBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tf-lb-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-ppsg-%d", acctest.RandIntRange(10, 100))
+	consumervpcname := fmt.Sprintf("tf-vpc-consumer-%d", acctest.RandIntRange(10, 100))
+	egwname := fmt.Sprintf("tf-egw-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayEndpointGatewayBindingConfig(vpcname, subnetname, lbname, name, consumervpcname, egwname, "permit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_private_path_service_gateway_endpoint_gateway_bindings.is_bindings", "endpoint_gateway_bindings.#", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_private_path_service_gateway_endpoint_gateway_bindings.is_bindings", "endpoint_gateway_bindings.0.account"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_endpoint_gateway_binding.is_binding", "access_policy", "permit"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_endpoint_gateway_binding.is_binding", "status", "permitted"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_endpoint_gateway.testacc_egw", "target.0.resource_type", "private_path_service_gateway"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsPrivatePathServiceGatewayEndpointGatewayBindingConfig(vpcname, subnetname, lbname, name, consumervpcname, egwname, "deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_endpoint_gateway_binding.is_binding", "access_policy", "deny"),
+					resource.TestCheckResourceAttr("ibm_is_private_path_service_gateway_endpoint_gateway_binding.is_binding", "status", "denied"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, defaultAccessPolicy string, published bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_lb" "testacc_lb" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+		type    = "private_path"
+	}
+
+	resource "ibm_is_private_path_service_gateway" "is_ppsg" {
+		name                  = "%s"
+		load_balancer         = ibm_is_lb.testacc_lb.id
+		service_endpoints     = ["%s.example.com"]
+		default_access_policy = "%s"
+		published             = %t
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, name, name, defaultAccessPolicy, published)
+}
+
+func testAccCheckIBMIsPrivatePathServiceGatewayAccountPolicyConfig(vpcname, subnetname, lbname, name, accessPolicy string) string {
+	return testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, "deny", false) + fmt.Sprintf(`
+	resource "ibm_is_private_path_service_gateway_account_policy" "is_ppsg_account_policy" {
+		private_path_service_gateway = ibm_is_private_path_service_gateway.is_ppsg.id
+		account                      = "%s"
+		access_policy                = "%s"
+	}
+	`, acc.ISPrivatePathAccountID, accessPolicy)
+}
+
+func testAccCheckIBMIsPrivatePathServiceGatewayEndpointGatewayBindingConfig(vpcname, subnetname, lbname, name, consumervpcname, egwname, accessPolicy string) string {
+	return testAccCheckIBMIsPrivatePathServiceGatewayConfig(vpcname, subnetname, lbname, name, "review", true) + fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_consumer_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_virtual_endpoint_gateway" "testacc_egw" {
+		name = "%s"
+		target {
+			crn           = ibm_is_private_path_service_gateway.is_ppsg.crn
+			resource_type = "private_path_service_gateway"
+		}
+		vpc = ibm_is_vpc.testacc_consumer_vpc.id
+	}
+
+	data "ibm_is_private_path_service_gateway_endpoint_gateway_bindings" "is_bindings" {
+		private_path_service_gateway = ibm_is_private_path_service_gateway.is_ppsg.id
+		depends_on                   = [ibm_is_virtual_endpoint_gateway.testacc_egw]
+	}
+
+	resource "ibm_is_private_path_service_gateway_endpoint_gateway_binding" "is_binding" {
+		private_path_service_gateway = ibm_is_private_path_service_gateway.is_ppsg.id
+		endpoint_gateway_binding     = data.ibm_is_private_path_service_gateway_endpoint_gateway_bindings.is_bindings.endpoint_gateway_bindings.0.id
+		access_policy                = "%s"
+	}
+	`, consumervpcname, egwname, accessPolicy)
+}
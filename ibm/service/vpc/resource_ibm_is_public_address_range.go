@@ -0,0 +1,384 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isPublicAddressRangeStable   = "stable"
+	isPublicAddressRangeFailed   = "failed"
+	isPublicAddressRangePending  = "pending"
+	isPublicAddressRangeUpdating = "updating"
+	isPublicAddressRangeWaiting  = "waiting"
+	isPublicAddressRangeDeleting = "deleting"
+	isPublicAddressRangeDeleted  = "deleted"
+)
+
+// publicAddressRange is a public address range as returned by the VPC API, the vpc-go-sdk version used by the
+// provider doesn't model public address ranges.
+type publicAddressRange struct {
+	CIDR             *string                       `json:"cidr,omitempty"`
+	CreatedAt        *string                       `json:"created_at,omitempty"`
+	CRN              *string                       `json:"crn,omitempty"`
+	Href             *string                       `json:"href,omitempty"`
+	ID               *string                       `json:"id,omitempty"`
+	Ipv4AddressCount *int64                        `json:"ipv4_address_count,omitempty"`
+	LifecycleState   *string                       `json:"lifecycle_state,omitempty"`
+	Name             *string                       `json:"name,omitempty"`
+	ResourceGroup    *vpcv1.ResourceGroupReference `json:"resource_group,omitempty"`
+	ResourceType     *string                       `json:"resource_type,omitempty"`
+	Target           *publicAddressRangeTarget     `json:"target,omitempty"`
+}
+
+type publicAddressRangeTarget struct {
+	VPC  *vpcv1.VPCReference  `json:"vpc,omitempty"`
+	Zone *vpcv1.ZoneReference `json:"zone,omitempty"`
+}
+
+func ResourceIBMIsPublicAddressRange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsPublicAddressRangeCreate,
+		ReadContext:   resourceIBMIsPublicAddressRangeRead,
+		UpdateContext: resourceIBMIsPublicAddressRangeUpdate,
+		DeleteContext: resourceIBMIsPublicAddressRangeDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_public_address_range", "name"),
+				Description:  "The name for this public address range. The name must not be used by another public address range in the region.",
+			},
+			"ipv4_address_count": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The number of IPv4 addresses in this public address range, a power of two.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group for this public address range.",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The VPC and zone the public address range is bound to, the range is unbound if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The VPC the public address range is bound to.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone the public address range resides in.",
+						},
+					},
+				},
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public IPv4 range, expressed in CIDR format.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the public address range was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this public address range.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this public address range.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the public address range.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsPublicAddressRangeValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_public_address_range", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsPublicAddressRangeCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{
+		"ipv4_address_count": d.Get("ipv4_address_count").(int),
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		body["resource_group"] = map[string]string{"id": resourceGroup.(string)}
+	}
+	if _, ok := d.GetOk("target"); ok {
+		body["target"] = expandPublicAddressRangeTarget(d)
+	}
+
+	publicAddressRange := &publicAddressRange{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/public_address_ranges`, nil, body, publicAddressRange)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePublicAddressRangeWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreatePublicAddressRangeWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*publicAddressRange.ID)
+
+	_, err = isWaitForPublicAddressRangeStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsPublicAddressRangeRead(context, d, meta)
+}
+
+func resourceIBMIsPublicAddressRangeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	publicAddressRange, response, err := getPublicAddressRange(context, vpcClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPublicAddressRangeWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPublicAddressRangeWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", publicAddressRange.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("ipv4_address_count", flex.IntValue(publicAddressRange.Ipv4AddressCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ipv4_address_count: %s", err))
+	}
+	if publicAddressRange.ResourceGroup != nil {
+		if err = d.Set("resource_group", publicAddressRange.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting resource_group: %s", err))
+		}
+	}
+	target := []map[string]interface{}{}
+	if publicAddressRange.Target != nil && publicAddressRange.Target.VPC != nil && publicAddressRange.Target.Zone != nil {
+		target = append(target, map[string]interface{}{
+			"vpc":  core.StringNilMapper(publicAddressRange.Target.VPC.ID),
+			"zone": core.StringNilMapper(publicAddressRange.Target.Zone.Name),
+		})
+	}
+	if err = d.Set("target", target); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target: %s", err))
+	}
+	if err = d.Set("cidr", publicAddressRange.CIDR); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cidr: %s", err))
+	}
+	if err = d.Set("created_at", publicAddressRange.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", publicAddressRange.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("href", publicAddressRange.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", publicAddressRange.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", publicAddressRange.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsPublicAddressRangeUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{}
+	if d.HasChange("name") {
+		body["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("target") {
+		// a null target unbinds the range from its VPC
+		if _, ok := d.GetOk("target"); ok {
+			body["target"] = expandPublicAddressRangeTarget(d)
+		} else {
+			body["target"] = nil
+		}
+	}
+
+	if len(body) > 0 {
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/public_address_ranges/{id}`,
+			map[string]string{"id": d.Id()}, body, &publicAddressRange{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePublicAddressRangeWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdatePublicAddressRangeWithContext failed %s\n%s", err, response))
+		}
+		_, err = isWaitForPublicAddressRangeStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsPublicAddressRangeRead(context, d, meta)
+}
+
+func resourceIBMIsPublicAddressRangeDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/public_address_ranges/{id}`,
+		map[string]string{"id": d.Id()}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeletePublicAddressRangeWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeletePublicAddressRangeWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForPublicAddressRangeDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandPublicAddressRangeTarget(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"vpc":  map[string]string{"id": d.Get("target.0.vpc").(string)},
+		"zone": map[string]string{"name": d.Get("target.0.zone").(string)},
+	}
+}
+
+func getPublicAddressRange(context context.Context, vpcClient *vpcv1.VpcV1, id string) (*publicAddressRange, *core.DetailedResponse, error) {
+	publicAddressRange := &publicAddressRange{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/public_address_ranges/{id}`,
+		map[string]string{"id": id}, nil, publicAddressRange)
+	if err != nil {
+		return nil, response, err
+	}
+	return publicAddressRange, response, nil
+}
+
+func isWaitForPublicAddressRangeStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for public address range (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isPublicAddressRangePending, isPublicAddressRangeUpdating, isPublicAddressRangeWaiting},
+		Target:  []string{isPublicAddressRangeStable, isPublicAddressRangeFailed},
+		Refresh: func() (interface{}, string, error) {
+			publicAddressRange, response, err := getPublicAddressRange(context, vpcClient, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting public address range: %s\n%s", err, response)
+			}
+			if *publicAddressRange.LifecycleState == isPublicAddressRangeFailed {
+				return publicAddressRange, *publicAddressRange.LifecycleState, fmt.Errorf("[ERROR] Public address range (%s) went into failed state", id)
+			}
+			return publicAddressRange, *publicAddressRange.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForPublicAddressRangeDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for public address range (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isPublicAddressRangeDeleting, isPublicAddressRangeStable, isPublicAddressRangeUpdating},
+		Target:  []string{isPublicAddressRangeDeleted, isPublicAddressRangeFailed},
+		Refresh: func() (interface{}, string, error) {
+			publicAddressRange, response, err := getPublicAddressRange(context, vpcClient, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return publicAddressRange, isPublicAddressRangeDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting public address range: %s\n%s", err, response)
+			}
+			if *publicAddressRange.LifecycleState == isPublicAddressRangeFailed {
+				return publicAddressRange, *publicAddressRange.LifecycleState, fmt.Errorf("[ERROR] Public address range (%s) failed to delete", id)
+			}
+			return publicAddressRange, *publicAddressRange.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
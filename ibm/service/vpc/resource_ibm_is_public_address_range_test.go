@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsPublicAddressRangeBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-par-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-par-update-%d", acctest.RandIntRange(10, 100))
+	target := fmt.Sprintf(`
+		target {
+			vpc  = ibm_is_vpc.testacc_vpc.id
+			zone = "%s"
+		}`, acc.ISZoneName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsPublicAddressRangeConfig(vpcname, name, target),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_public_address_range.is_public_address_range", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_public_address_range.is_public_address_range", "ipv4_address_count", "8"),
+					resource.TestCheckResourceAttr("ibm_is_public_address_range.is_public_address_range", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrPair("ibm_is_public_address_range.is_public_address_range", "target.0.vpc", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttr("ibm_is_public_address_range.is_public_address_range", "target.0.zone", acc.ISZoneName),
+					resource.TestCheckResourceAttrSet("ibm_is_public_address_range.is_public_address_range", "cidr"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsPublicAddressRangeConfig(vpcname, nameUpdate, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_public_address_range.is_public_address_range", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_public_address_range.is_public_address_range", "target.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_public_address_range.is_public_address_range",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsPublicAddressRangeConfig(vpcname, name, target string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_public_address_range" "is_public_address_range" {
+		name               = "%s"
+		ipv4_address_count = 8
+		%s
+	}
+	`, vpcname, name, target)
+}
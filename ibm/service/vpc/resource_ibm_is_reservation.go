@@ -0,0 +1,697 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isReservationStable   = "stable"
+	isReservationFailed   = "failed"
+	isReservationPending  = "pending"
+	isReservationUpdating = "updating"
+	isReservationWaiting  = "waiting"
+	isReservationDeleting = "deleting"
+	isReservationDeleted  = "deleted"
+
+	isReservationStatusActive     = "active"
+	isReservationStatusActivating = "activating"
+	isReservationStatusFailed     = "failed"
+)
+
+// reservation is a capacity reservation as returned by the VPC API, the vpc-go-sdk version used by the
+// provider doesn't model reservations.
+type reservation struct {
+	AffinityPolicy *string                       `json:"affinity_policy,omitempty"`
+	Capacity       *reservationCapacity          `json:"capacity,omitempty"`
+	CommittedUse   *reservationCommittedUse      `json:"committed_use,omitempty"`
+	CreatedAt      *string                       `json:"created_at,omitempty"`
+	CRN            *string                       `json:"crn,omitempty"`
+	Href           *string                       `json:"href,omitempty"`
+	ID             *string                       `json:"id,omitempty"`
+	LifecycleState *string                       `json:"lifecycle_state,omitempty"`
+	Name           *string                       `json:"name,omitempty"`
+	Profile        *reservationProfile           `json:"profile,omitempty"`
+	ResourceGroup  *vpcv1.ResourceGroupReference `json:"resource_group,omitempty"`
+	ResourceType   *string                       `json:"resource_type,omitempty"`
+	Status         *string                       `json:"status,omitempty"`
+	StatusReasons  []reservationStatusReason     `json:"status_reasons,omitempty"`
+	Zone           *vpcv1.ZoneReference          `json:"zone,omitempty"`
+}
+
+type reservationCapacity struct {
+	Allocated *int64  `json:"allocated,omitempty"`
+	Available *int64  `json:"available,omitempty"`
+	Status    *string `json:"status,omitempty"`
+	Total     *int64  `json:"total,omitempty"`
+	Used      *int64  `json:"used,omitempty"`
+}
+
+type reservationCommittedUse struct {
+	ExpirationAt     *string `json:"expiration_at,omitempty"`
+	ExpirationPolicy *string `json:"expiration_policy,omitempty"`
+	Term             *string `json:"term,omitempty"`
+}
+
+type reservationProfile struct {
+	Href         *string `json:"href,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	ResourceType *string `json:"resource_type,omitempty"`
+}
+
+type reservationStatusReason struct {
+	Code     *string `json:"code,omitempty"`
+	Message  *string `json:"message,omitempty"`
+	MoreInfo *string `json:"more_info,omitempty"`
+}
+
+func ResourceIBMIsReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsReservationCreate,
+		ReadContext:   resourceIBMIsReservationRead,
+		UpdateContext: resourceIBMIsReservationUpdate,
+		DeleteContext: resourceIBMIsReservationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_reservation", "name"),
+				Description:  "The name for this reservation. The name must not be used by another reservation in the region.",
+			},
+			"affinity_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_reservation", "affinity_policy"),
+				Description:  "The affinity policy to use for this reservation, automatic lets any instance with a matching profile use the reservation, restricted only the instances that select it.",
+			},
+			"capacity": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The capacity reservation configuration to use.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"total": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The total amount to use for this capacity reservation.",
+						},
+						"allocated": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount allocated to this capacity reservation.",
+						},
+						"available": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of this capacity reservation available for new attachments.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the capacity reservation.",
+						},
+						"used": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of this capacity reservation used by existing attachments.",
+						},
+					},
+				},
+			},
+			"committed_use": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The committed use configuration to use for this reservation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"term": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_reservation", "term"),
+							Description:  "The term for this committed use reservation, one_year or three_year.",
+						},
+						"expiration_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_reservation", "expiration_policy"),
+							Description:  "The policy to apply when the committed use term expires, release or renew.",
+						},
+						"expiration_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expiration date and time for this committed use reservation.",
+						},
+					},
+				},
+			},
+			"profile": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The profile to use for this reservation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The globally unique name of the profile.",
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_reservation", "profile_resource_type"),
+							Description:  "The resource type of the profile, instance_profile or bare_metal_server_profile.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this profile.",
+						},
+					},
+				},
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone to use for this reservation.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group for this reservation.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the reservation was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this reservation.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this reservation.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of this reservation.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the reservation, a new reservation is inactive until it is activated with ibm_is_reservation_activate.",
+			},
+			"status_reasons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons for the current status, if any.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string succinctly identifying the status reason.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the status reason.",
+						},
+						"more_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Link to documentation about this status reason.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIsReservationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "affinity_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "automatic, restricted",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "term",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "one_year, three_year",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "expiration_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "release, renew",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "profile_resource_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "bare_metal_server_profile, instance_profile",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_reservation", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsReservationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	committedUse := map[string]interface{}{
+		"term": d.Get("committed_use.0.term").(string),
+	}
+	if policy, ok := d.GetOk("committed_use.0.expiration_policy"); ok {
+		committedUse["expiration_policy"] = policy.(string)
+	}
+	body := map[string]interface{}{
+		"capacity":      map[string]interface{}{"total": d.Get("capacity.0.total").(int)},
+		"committed_use": committedUse,
+		"profile": map[string]string{
+			"name":          d.Get("profile.0.name").(string),
+			"resource_type": d.Get("profile.0.resource_type").(string),
+		},
+		"zone": map[string]string{"name": d.Get("zone").(string)},
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	if policy, ok := d.GetOk("affinity_policy"); ok {
+		body["affinity_policy"] = policy.(string)
+	}
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		body["resource_group"] = map[string]string{"id": resourceGroup.(string)}
+	}
+
+	reservation := &reservation{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/reservations`, nil, body, reservation)
+	if err != nil {
+		log.Printf("[DEBUG] CreateReservationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateReservationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*reservation.ID)
+
+	_, err = isWaitForReservationStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsReservationRead(context, d, meta)
+}
+
+func resourceIBMIsReservationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reservation, response, err := getReservation(context, vpcClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReservationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetReservationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", reservation.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("affinity_policy", reservation.AffinityPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting affinity_policy: %s", err))
+	}
+	capacity := []map[string]interface{}{}
+	if reservation.Capacity != nil {
+		capacity = append(capacity, map[string]interface{}{
+			"total":     flex.IntValue(reservation.Capacity.Total),
+			"allocated": flex.IntValue(reservation.Capacity.Allocated),
+			"available": flex.IntValue(reservation.Capacity.Available),
+			"status":    core.StringNilMapper(reservation.Capacity.Status),
+			"used":      flex.IntValue(reservation.Capacity.Used),
+		})
+	}
+	if err = d.Set("capacity", capacity); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting capacity: %s", err))
+	}
+	committedUse := []map[string]interface{}{}
+	if reservation.CommittedUse != nil {
+		committedUse = append(committedUse, map[string]interface{}{
+			"term":              core.StringNilMapper(reservation.CommittedUse.Term),
+			"expiration_policy": core.StringNilMapper(reservation.CommittedUse.ExpirationPolicy),
+			"expiration_at":     core.StringNilMapper(reservation.CommittedUse.ExpirationAt),
+		})
+	}
+	if err = d.Set("committed_use", committedUse); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting committed_use: %s", err))
+	}
+	profile := []map[string]interface{}{}
+	if reservation.Profile != nil {
+		profile = append(profile, map[string]interface{}{
+			"name":          core.StringNilMapper(reservation.Profile.Name),
+			"resource_type": core.StringNilMapper(reservation.Profile.ResourceType),
+			"href":          core.StringNilMapper(reservation.Profile.Href),
+		})
+	}
+	if err = d.Set("profile", profile); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting profile: %s", err))
+	}
+	if reservation.Zone != nil {
+		if err = d.Set("zone", reservation.Zone.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting zone: %s", err))
+		}
+	}
+	if reservation.ResourceGroup != nil {
+		if err = d.Set("resource_group", reservation.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting resource_group: %s", err))
+		}
+	}
+	statusReasons := []map[string]interface{}{}
+	for _, reason := range reservation.StatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			"code":      core.StringNilMapper(reason.Code),
+			"message":   core.StringNilMapper(reason.Message),
+			"more_info": core.StringNilMapper(reason.MoreInfo),
+		})
+	}
+	if err = d.Set("status_reasons", statusReasons); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status_reasons: %s", err))
+	}
+	if err = d.Set("created_at", reservation.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", reservation.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("href", reservation.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", reservation.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", reservation.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+	if err = d.Set("status", reservation.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsReservationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{}
+	if d.HasChange("name") {
+		body["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("affinity_policy") {
+		body["affinity_policy"] = d.Get("affinity_policy").(string)
+	}
+	if d.HasChange("capacity.0.total") {
+		body["capacity"] = map[string]interface{}{"total": d.Get("capacity.0.total").(int)}
+	}
+	if d.HasChange("committed_use.0.term") || d.HasChange("committed_use.0.expiration_policy") {
+		committedUse := map[string]interface{}{}
+		if d.HasChange("committed_use.0.term") {
+			committedUse["term"] = d.Get("committed_use.0.term").(string)
+		}
+		if d.HasChange("committed_use.0.expiration_policy") {
+			committedUse["expiration_policy"] = d.Get("committed_use.0.expiration_policy").(string)
+		}
+		body["committed_use"] = committedUse
+	}
+	if d.HasChange("profile.0.name") || d.HasChange("profile.0.resource_type") {
+		body["profile"] = map[string]string{
+			"name":          d.Get("profile.0.name").(string),
+			"resource_type": d.Get("profile.0.resource_type").(string),
+		}
+	}
+
+	if len(body) > 0 {
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/reservations/{id}`,
+			map[string]string{"id": d.Id()}, body, &reservation{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateReservationWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateReservationWithContext failed %s\n%s", err, response))
+		}
+		_, err = isWaitForReservationStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIsReservationRead(context, d, meta)
+}
+
+func resourceIBMIsReservationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/reservations/{id}`,
+		map[string]string{"id": d.Id()}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteReservationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteReservationWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForReservationDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getReservation(context context.Context, vpcClient *vpcv1.VpcV1, id string) (*reservation, *core.DetailedResponse, error) {
+	reservation := &reservation{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/reservations/{id}`,
+		map[string]string{"id": id}, nil, reservation)
+	if err != nil {
+		return nil, response, err
+	}
+	return reservation, response, nil
+}
+
+func isWaitForReservationStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for reservation (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isReservationPending, isReservationUpdating, isReservationWaiting},
+		Target:  []string{isReservationStable, isReservationFailed},
+		Refresh: func() (interface{}, string, error) {
+			reservation, response, err := getReservation(context, vpcClient, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting reservation: %s\n%s", err, response)
+			}
+			if *reservation.LifecycleState == isReservationFailed {
+				return reservation, *reservation.LifecycleState, fmt.Errorf("[ERROR] Reservation (%s) went into failed state", id)
+			}
+			return reservation, *reservation.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isWaitForReservationDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for reservation (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isReservationDeleting, isReservationStable, isReservationUpdating},
+		Target:  []string{isReservationDeleted, isReservationFailed},
+		Refresh: func() (interface{}, string, error) {
+			reservation, response, err := getReservation(context, vpcClient, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return reservation, isReservationDeleted, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting reservation: %s\n%s", err, response)
+			}
+			if *reservation.LifecycleState == isReservationFailed {
+				return reservation, *reservation.LifecycleState, fmt.Errorf("[ERROR] Reservation (%s) failed to delete", id)
+			}
+			return reservation, *reservation.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// reservationAffinitySchema is the reservation_affinity block of instances and instance templates.
+func reservationAffinitySchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Description: "The reservation affinity, which selects the reservations the instances can use",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"automatic", "disabled", "manual"}),
+					Description:  "The reservation affinity policy, automatic, disabled or manual",
+				},
+				"pool": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "The pool of reservations available for use, required with the manual policy",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    forceNew,
+								Description: "The unique identifier for this reservation",
+							},
+							"crn": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The CRN for this reservation",
+							},
+							"name": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name for this reservation",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// reservationAffinity is the reservation affinity of an instance or an instance template as returned by the VPC API.
+type reservationAffinity struct {
+	Policy *string                `json:"policy,omitempty"`
+	Pool   []reservationReference `json:"pool,omitempty"`
+}
+
+type reservationReference struct {
+	CRN  *string `json:"crn,omitempty"`
+	Href *string `json:"href,omitempty"`
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+func expandReservationAffinity(d *schema.ResourceData, key string) map[string]interface{} {
+	affinity := map[string]interface{}{}
+	if policy, ok := d.GetOk(key + ".0.policy"); ok {
+		affinity["policy"] = policy.(string)
+	}
+	if pool, ok := d.GetOk(key + ".0.pool"); ok {
+		reservations := []map[string]string{}
+		for _, item := range pool.([]interface{}) {
+			reservations = append(reservations, map[string]string{"id": item.(map[string]interface{})["id"].(string)})
+		}
+		affinity["pool"] = reservations
+	}
+	return affinity
+}
+
+func flattenReservationAffinity(affinity *reservationAffinity) []map[string]interface{} {
+	if affinity == nil {
+		return []map[string]interface{}{}
+	}
+	pool := []map[string]interface{}{}
+	for _, reservation := range affinity.Pool {
+		pool = append(pool, map[string]interface{}{
+			"id":   core.StringNilMapper(reservation.ID),
+			"crn":  core.StringNilMapper(reservation.CRN),
+			"name": core.StringNilMapper(reservation.Name),
+		})
+	}
+	return []map[string]interface{}{{
+		"policy": core.StringNilMapper(affinity.Policy),
+		"pool":   pool,
+	}}
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// ResourceIBMIsReservationActivate activates a reservation. An active reservation can't be deactivated, it
+// is billed until its committed use term expires, so deleting this resource only removes it from the state.
+func ResourceIBMIsReservationActivate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsReservationActivateCreate,
+		ReadContext:   resourceIBMIsReservationActivateRead,
+		DeleteContext: resourceIBMIsReservationActivateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"reservation": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the reservation to activate.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the reservation.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the reservation.",
+			},
+		},
+	}
+}
+
+func resourceIBMIsReservationActivateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("reservation").(string)
+	response, err := vpcRequest(context, vpcClient, core.POST, `/reservations/{id}/activate`,
+		map[string]string{"id": id}, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] ActivateReservationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ActivateReservationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(id)
+
+	_, err = isWaitForReservationActive(context, vpcClient, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsReservationActivateRead(context, d, meta)
+}
+
+func resourceIBMIsReservationActivateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reservation, response, err := getReservation(context, vpcClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReservationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetReservationWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("reservation", reservation.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reservation: %s", err))
+	}
+	if err = d.Set("lifecycle_state", reservation.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("status", reservation.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIsReservationActivateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Reservation (%s) can't be deactivated, removing it from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func isWaitForReservationActive(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for reservation (%s) to be active.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isReservationStatusActivating},
+		Target:  []string{isReservationStatusActive, isReservationStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			reservation, response, err := getReservation(context, vpcClient, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting reservation: %s\n%s", err, response)
+			}
+			if *reservation.Status == isReservationStatusFailed {
+				return reservation, *reservation.Status, fmt.Errorf("[ERROR] Reservation (%s) failed to activate", id)
+			}
+			return reservation, *reservation.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
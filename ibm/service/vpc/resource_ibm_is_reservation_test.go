@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsReservationBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-reservation-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-reservation-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsReservationConfig(name, 10, "release"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "capacity.0.total", "10"),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "committed_use.0.term", "one_year"),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "committed_use.0.expiration_policy", "release"),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "profile.0.name", acc.InstanceProfileName),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "status", "inactive"),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_reservation.is_reservation", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsReservationConfig(nameUpdate, 20, "renew"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "capacity.0.total", "20"),
+					resource.TestCheckResourceAttr("ibm_is_reservation.is_reservation", "committed_use.0.expiration_policy", "renew"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_reservation.is_reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIsReservationInstanceAffinity(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-reservation-%d", acctest.RandIntRange(10, 100))
+	templatename := fmt.Sprintf("tf-template-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsReservationInstanceAffinityConfig(vpcname, subnetname, sshname, publicKey, name, templatename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_reservation_activate.is_reservation_activate", "status", "active"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "reservation_affinity.0.policy", "manual"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_instance", "reservation_affinity.0.pool.0.id", "ibm_is_reservation.is_reservation", "id"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance.testacc_instance", "reservation.0.id", "ibm_is_reservation.is_reservation", "id"),
+					resource.TestCheckResourceAttr("ibm_is_instance_template.testacc_template", "reservation_affinity.0.policy", "manual"),
+					resource.TestCheckResourceAttrPair("ibm_is_instance_template.testacc_template", "reservation_affinity.0.pool.0.id", "ibm_is_reservation.is_reservation", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsReservationConfig(name string, total int, expirationPolicy string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_reservation" "is_reservation" {
+		name = "%s"
+		capacity {
+			total = %d
+		}
+		committed_use {
+			term              = "one_year"
+			expiration_policy = "%s"
+		}
+		profile {
+			name          = "%s"
+			resource_type = "instance_profile"
+		}
+		zone = "%s"
+	}
+	`, name, total, expirationPolicy, acc.InstanceProfileName, acc.ISZoneName)
+}
+
+func testAccCheckIBMIsReservationInstanceAffinityConfig(vpcname, subnetname, sshname, publicKey, name, templatename string) string {
+	return testAccCheckIBMIsReservationConfig(name, 1, "release") + fmt.Sprintf(`
+	resource "ibm_is_reservation_activate" "is_reservation_activate" {
+		reservation = ibm_is_reservation.is_reservation.id
+	}
+
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s-instance"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		reservation_affinity {
+			policy = "manual"
+			pool {
+				id = ibm_is_reservation_activate.is_reservation_activate.reservation
+			}
+		}
+	}
+
+	resource "ibm_is_instance_template" "testacc_template" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+		reservation_affinity {
+			policy = "manual"
+			pool {
+				id = ibm_is_reservation.is_reservation.id
+			}
+		}
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, templatename, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName)
+}
@@ -4,6 +4,8 @@
 package vpc
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -11,15 +13,18 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
 	isSecurityGroupRuleCode             = "code"
+	isSecurityGroupRuleDescription      = "description"
 	isSecurityGroupRuleDirection        = "direction"
 	isSecurityGroupRuleIPVersion        = "ip_version"
 	isSecurityGroupRuleIPVersionDefault = "ipv4"
+	isSecurityGroupRuleLocal            = "local"
 	isSecurityGroupRulePortMax          = "port_max"
 	isSecurityGroupRulePortMin          = "port_min"
 	isSecurityGroupRuleProtocolICMP     = "icmp"
@@ -79,6 +84,14 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 				Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
 			},
 
+			isSecurityGroupRuleLocal: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSecurityGroupRuleLocal,
+				Description:  "The local IP address or CIDR block to which this rule allows inbound traffic (or from which, for outbound rules). Defaults to all addresses, 0.0.0.0/0.",
+			},
+
 			isSecurityGroupRuleProtocolICMP: {
 				Type:          schema.TypeList,
 				MaxItems:      1,
@@ -86,7 +99,7 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 				ForceNew:      true,
 				MinItems:      1,
 				ConflictsWith: []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP},
-				Description:   "protocol=icmp",
+				Description:   "protocol=icmp, all ICMP types are allowed when type is not set",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						isSecurityGroupRuleType: {
@@ -171,6 +184,12 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 				Computed:    true,
 				Description: "The Security Group Rule Protocol",
 			},
+
+			isSecurityGroupRuleDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the rule, for example the change ticket that requested it. Security group rules have no description in the VPC API, the value is only kept in the Terraform state.",
+			},
 		},
 	}
 }
@@ -241,6 +260,17 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
 	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
 
+	// The vpc-go-sdk has no local on the rule prototype yet, send the rule ourselves
+	if local, ok := d.GetOk(isSecurityGroupRuleLocal); ok {
+		ruleID, err := createSecurityGroupRuleWithLocal(sess, parsed.secgrpID, sgTemplate, local.(string))
+		if err != nil {
+			return err
+		}
+		d.Set(isSecurityGroupRuleID, ruleID)
+		d.SetId(makeTerraformRuleID(parsed.secgrpID, ruleID))
+		return resourceIBMISSecurityGroupRuleRead(d, meta)
+	}
+
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
 		SecurityGroupRulePrototype: sgTemplate,
@@ -307,6 +337,11 @@ func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("[ERROR] Error Getting Security Group : %s\n%s", err, response)
 	}
 	d.Set(flex.RelatedCRN, *sg.CRN)
+	local, response, err := getSecurityGroupRuleLocal(sess, secgrpID, ruleID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Security Group Rule (%s) local: %s\n%s", ruleID, err, response)
+	}
+	d.Set(isSecurityGroupRuleLocal, local)
 	switch reflect.TypeOf(sgrule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
 		{
@@ -410,6 +445,11 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	// The description is only kept in the state, there is nothing to update on the rule
+	if !d.HasChangeExcept(isSecurityGroupRuleDescription) {
+		return resourceIBMISSecurityGroupRuleRead(d, meta)
+	}
+
 	parsed, _, sgTemplate, err := parseIBMISSecurityGroupRuleDictionary(d, "update", sess)
 	if err != nil {
 		return err
@@ -612,18 +652,17 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 
 	if icmpInterface, ok := d.GetOk("icmp"); ok {
 		if icmpInterface.([]interface{})[0] != nil {
-			haveType := false
-			if value, ok := d.GetOk("icmp.0.type"); ok {
-				parsed.icmpType = int64(value.(int))
-				haveType = true
+			haveType, haveCode := securityGroupRuleICMPConfigured(d)
+			if haveType {
+				parsed.icmpType = int64(d.Get("icmp.0.type").(int))
 				sgTemplate.Type = &parsed.icmpType
 				securityGroupRulePatchModel.Type = &parsed.icmpType
 			}
-			if value, ok := d.GetOk("icmp.0.code"); ok {
+			if haveCode {
 				if !haveType {
 					return nil, nil, nil, fmt.Errorf("icmp code requires icmp type")
 				}
-				parsed.icmpCode = int64(value.(int))
+				parsed.icmpCode = int64(d.Get("icmp.0.code").(int))
 				sgTemplate.Code = &parsed.icmpCode
 				securityGroupRulePatchModel.Code = &parsed.icmpCode
 			}
@@ -682,6 +721,9 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 			securityGroupRulePatch["code"] = nil
 		}
 	}
+	if local, ok := d.GetOk(isSecurityGroupRuleLocal); ok && d.HasChange(isSecurityGroupRuleLocal) {
+		securityGroupRulePatch["local"] = securityGroupRuleLocalModel(local.(string))
+	}
 	sgTemplateUpdate.SecurityGroupRulePatch = securityGroupRulePatch
 	//	log.Printf("[DEBUG] parse tag=%s\n\t%v  \n\t%v  \n\t%v  \n\t%v  \n\t%v \n\t%v \n\t%v \n\t%v  \n\t%v  \n\t%v  \n\t%v  \n\t%v ",
 	//		tag, parsed.secgrpID, parsed.ruleID, parsed.direction, parsed.ipversion, parsed.protocol, parsed.remoteAddress,
@@ -694,3 +736,97 @@ func makeTerraformRuleID(id1, id2 string) string {
 	// we can extract the group id as needed for API calls such as READ.
 	return id1 + "." + id2
 }
+
+// securityGroupRuleICMPConfigured reports whether the ICMP type and code are set in the configuration,
+// GetOk can't tell a type or code of 0 from an unset one, which allows all ICMP types or codes.
+func securityGroupRuleICMPConfigured(d *schema.ResourceData) (haveType, haveCode bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		_, haveType = d.GetOk("icmp.0.type")
+		_, haveCode = d.GetOk("icmp.0.code")
+		return
+	}
+	rawICMP := rawConfig.GetAttr(isSecurityGroupRuleProtocolICMP)
+	if !rawICMP.IsKnown() || rawICMP.IsNull() {
+		return
+	}
+	for it := rawICMP.ElementIterator(); it.Next(); {
+		_, icmp := it.Element()
+		if !icmp.IsKnown() || icmp.IsNull() {
+			continue
+		}
+		haveType = !icmp.GetAttr(isSecurityGroupRuleType).IsNull()
+		haveCode = !icmp.GetAttr(isSecurityGroupRuleCode).IsNull()
+	}
+	return
+}
+
+func validateSecurityGroupRuleLocal(v interface{}, k string) (ws []string, errors []error) {
+	local := v.(string)
+	if !validate.IsSecurityGroupAddress(local) && !validate.IsSecurityGroupCIDR(local) {
+		errors = append(errors, fmt.Errorf("%q must be an IP address or a CIDR block, got: %s", k, local))
+	}
+	return
+}
+
+func securityGroupRuleLocalModel(local string) map[string]interface{} {
+	if validate.IsSecurityGroupAddress(local) {
+		return map[string]interface{}{"address": local}
+	}
+	return map[string]interface{}{"cidr_block": local}
+}
+
+type securityGroupRuleLocal struct {
+	Address   *string `json:"address,omitempty"`
+	CIDRBlock *string `json:"cidr_block,omitempty"`
+}
+
+// createSecurityGroupRuleWithLocal creates the rule described by prototype with the local IP
+// address or CIDR block, and returns the ID of the rule.
+func createSecurityGroupRuleWithLocal(sess *vpcv1.VpcV1, secgrpID string, prototype *vpcv1.SecurityGroupRulePrototype, local string) (string, error) {
+	prototypeJSON, err := json.Marshal(prototype)
+	if err != nil {
+		return "", err
+	}
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(prototypeJSON, &body); err != nil {
+		return "", err
+	}
+	body["local"] = securityGroupRuleLocalModel(local)
+
+	rule := &struct {
+		ID *string `json:"id"`
+	}{}
+	response, err := vpcRequest(context.TODO(), sess, core.POST, `/security_groups/{security_group_id}/rules`,
+		map[string]string{"security_group_id": secgrpID}, body, rule)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+	}
+	if rule.ID == nil {
+		return "", fmt.Errorf("[ERROR] Error while creating Security Group Rule, rule ID missing from response\n%s", response)
+	}
+	return *rule.ID, nil
+}
+
+// getSecurityGroupRuleLocal returns the local IP address or CIDR block of the rule,
+// which the vpc-go-sdk doesn't decode yet.
+func getSecurityGroupRuleLocal(sess *vpcv1.VpcV1, secgrpID, ruleID string) (string, *core.DetailedResponse, error) {
+	rule := &struct {
+		Local *securityGroupRuleLocal `json:"local"`
+	}{}
+	response, err := vpcRequest(context.TODO(), sess, core.GET, `/security_groups/{security_group_id}/rules/{id}`,
+		map[string]string{"security_group_id": secgrpID, "id": ruleID}, nil, rule)
+	if err != nil {
+		return "", response, err
+	}
+	if rule.Local == nil {
+		return "", response, nil
+	}
+	if rule.Local.Address != nil {
+		return *rule.Local.Address, response, nil
+	}
+	if rule.Local.CIDRBlock != nil {
+		return *rule.Local.CIDRBlock, response, nil
+	}
+	return "", response, nil
+}
@@ -42,6 +42,95 @@ func TestAccIBMISSecurityGroupRule_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMISSecurityGroupRule_description(t *testing.T) {
+	var securityGroupRule string
+
+	vpcname := fmt.Sprintf("tfsgrule-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrule-desc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "CHG0001 allow ssh from bastion"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_desc", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_desc", "description", "CHG0001 allow ssh from bastion"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, "CHG0002 allow ssh from bastion"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_desc", "description", "CHG0002 allow ssh from bastion"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_desc", "tcp.0.port_min", "22"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISSecurityGroupRule_local(t *testing.T) {
+	var securityGroupRule string
+
+	vpcname := fmt.Sprintf("tfsgrule-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrule-local-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, "10.240.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_local", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_local", "local", "10.240.0.0/24"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, "10.240.0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_local", "local", "10.240.0.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISSecurityGroupRule_icmpType(t *testing.T) {
+	var securityGroupRule string
+
+	vpcname := fmt.Sprintf("tfsgrule-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrule-icmp-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupRuleICMPConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_icmp_any", securityGroupRule),
+					testAccCheckIBMISSecurityGroupRuleExists("ibm_is_security_group_rule.testacc_security_group_rule_icmp_echo_reply", securityGroupRule),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_icmp_echo_reply", "icmp.0.type", "0"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rule.testacc_security_group_rule_icmp_echo_reply", "icmp.0.code", "0"),
+				),
+			},
+		},
+	})
+}
+
 func parseISTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, ".")
 	if len(segments) != 2 {
@@ -121,6 +210,85 @@ func testAccCheckIBMISSecurityGroupRuleExists(n, securityGroupRuleID string) res
 	}
 }
 
+func testAccCheckIBMISsecurityGroupRuleDescriptionConfig(vpcname, name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule_desc" {
+		group       = ibm_is_security_group.testacc_security_group.id
+		direction   = "inbound"
+		remote      = "10.0.0.4"
+		description = "%s"
+		tcp {
+			port_min = 22
+			port_max = 22
+		}
+	}
+	`, vpcname, name, description)
+}
+
+func testAccCheckIBMISsecurityGroupRuleLocalConfig(vpcname, name, local string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule_local" {
+		group     = ibm_is_security_group.testacc_security_group.id
+		direction = "inbound"
+		remote    = "10.0.0.4"
+		local     = "%s"
+		tcp {
+			port_min = 443
+			port_max = 443
+		}
+	}
+	`, vpcname, name, local)
+}
+
+func testAccCheckIBMISsecurityGroupRuleICMPConfig(vpcname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule_icmp_any" {
+		group     = ibm_is_security_group.testacc_security_group.id
+		direction = "inbound"
+		remote    = "10.0.0.4"
+		icmp {
+		}
+	}
+
+	resource "ibm_is_security_group_rule" "testacc_security_group_rule_icmp_echo_reply" {
+		group     = ibm_is_security_group.testacc_security_group.id
+		direction = "outbound"
+		remote    = "10.0.0.4"
+		icmp {
+			type = 0
+			code = 0
+		}
+	}
+	`, vpcname, name)
+}
+
 func testAccCheckIBMISsecurityGroupRuleConfig(vpcname, name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
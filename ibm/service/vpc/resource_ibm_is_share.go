@@ -50,6 +50,11 @@ func ResourceIbmIsShare() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIbmIsShareValidateReplicaZone(diff)
+				},
+			),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -228,6 +233,11 @@ func ResourceIbmIsShare() *schema.Resource {
 										Set:         schema.HashString,
 										Description: "The security groups to use for this virtual network interface.",
 									},
+									"protocol_state_filtering_mode": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The protocol state filtering mode used for this virtual network interface.",
+									},
 									"subnet": {
 										Type:        schema.TypeString,
 										Optional:    true,
@@ -461,6 +471,11 @@ func ResourceIbmIsShare() *schema.Resource {
 													Set:         schema.HashString,
 													Description: "The security groups to use for this virtual network interface.",
 												},
+												"protocol_state_filtering_mode": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The protocol state filtering mode used for this virtual network interface.",
+												},
 												"subnet": {
 													Type:        schema.TypeString,
 													Optional:    true,
@@ -662,6 +677,19 @@ func ResourceIbmIsShare() *schema.Resource {
 	}
 }
 
+// Replication is only supported across zones, catch a replica placed in the
+// zone of its source share at plan time instead of failing the create.
+func resourceIbmIsShareValidateReplicaZone(diff *schema.ResourceDiff) error {
+	zone, ok := diff.GetOk("zone")
+	if !ok || !diff.NewValueKnown("zone") || !diff.NewValueKnown("replica_share.0.zone") {
+		return nil
+	}
+	if replicaZone, ok := diff.GetOk("replica_share.0.zone"); ok && replicaZone.(string) == zone.(string) {
+		return fmt.Errorf("[ERROR] replica_share zone must be different from the share zone %s, file share replication is cross-zone", zone.(string))
+	}
+	return nil
+}
+
 func ResourceIbmIsShareValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 1)
 	validateSchema = append(validateSchema,
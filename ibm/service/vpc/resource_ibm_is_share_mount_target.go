@@ -5,6 +5,7 @@ package vpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -158,6 +160,13 @@ func ResourceIBMIsShareMountTarget() *schema.Resource {
 							Set:         schema.HashString,
 							Description: "The security groups to use for this virtual network interface.",
 						},
+						"protocol_state_filtering_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_virtual_network_interface", "protocol_state_filtering_mode"),
+							Description:  "The protocol state filtering mode used for this virtual network interface, one of auto, enabled or disabled.",
+						},
 						"subnet": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -309,7 +318,11 @@ func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.Reso
 		shareMountTargetPrototype.TransitEncryption = &transitEncryption
 	}
 	createShareMountTargetOptions.ShareMountTargetPrototype = shareMountTargetPrototype
-	shareTarget, response, err := vpcClient.CreateShareMountTargetWithContext(context, createShareMountTargetOptions)
+	protocolStateFilteringMode := ""
+	if shareMountTargetPrototype.VirtualNetworkInterface != nil {
+		protocolStateFilteringMode = d.Get("virtual_network_interface.0.protocol_state_filtering_mode").(string)
+	}
+	shareTarget, response, err := shareMountTargetCreate(context, vpcClient, createShareMountTargetOptions, protocolStateFilteringMode)
 	if err != nil || shareTarget == nil {
 		log.Printf("[DEBUG] CreateShareMountTargetWithContext failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -462,6 +475,22 @@ func resourceIBMIsShareMountTargetUpdate(context context.Context, d *schema.Reso
 		}
 	}
 
+	if d.HasChange("virtual_network_interface.0.protocol_state_filtering_mode") && !d.IsNewResource() {
+		vniId := d.Get("virtual_network_interface.0.id").(string)
+		body := map[string]interface{}{
+			"protocol_state_filtering_mode": d.Get("virtual_network_interface.0.protocol_state_filtering_mode").(string),
+		}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/virtual_network_interfaces/{id}`, map[string]string{"id": vniId}, body, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+		}
+		_, err = WaitForVNIAvailable(vpcClient, vniId, d, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("virtual_network_interface.0.security_groups") && !d.IsNewResource() {
 		ovs, nvs := d.GetChange("virtual_network_interface.0.security_groups")
 		ov := ovs.(*schema.Set)
@@ -673,10 +702,8 @@ func ShareMountTargetVirtualNetworkInterfaceToMap(context context.Context, vpcCl
 
 	vniSlice := make([]map[string]interface{}, 0)
 	vniMap := map[string]interface{}{}
-	vniOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
-		ID: &vniId,
-	}
-	vni, response, err := vpcClient.GetVirtualNetworkInterfaceWithContext(context, vniOptions)
+	// read raw for the protocol state filtering mode, which the vpc-go-sdk version used by the provider doesn't model
+	vni, response, err := getVirtualNetworkInterface(context, vpcClient, vniId)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -724,6 +751,7 @@ func ShareMountTargetVirtualNetworkInterfaceToMap(context context.Context, vpcCl
 		vniMap["primary_ip"] = primaryIpList
 	}
 	vniMap["subnet"] = vni.Subnet.ID
+	vniMap["protocol_state_filtering_mode"] = vni.ProtocolStateFilteringMode
 	vniMap["resource_type"] = vni.ResourceType
 	vniMap["resource_group"] = vni.ResourceGroup.ID
 	if len(vni.SecurityGroups) != 0 {
@@ -895,3 +923,38 @@ func mountTargetRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, sha
 		return target, "pending", nil
 	}
 }
+
+// shareMountTargetCreate creates the share mount target, the mount targets setting the protocol state filtering mode
+// of their virtual network interface are created with a raw request as the vpc-go-sdk version used by the provider
+// doesn't model it.
+func shareMountTargetCreate(context context.Context, vpcClient *vpcv1.VpcV1, options *vpcv1.CreateShareMountTargetOptions, protocolStateFilteringMode string) (*vpcv1.ShareMountTarget, *core.DetailedResponse, error) {
+	if protocolStateFilteringMode == "" {
+		return vpcClient.CreateShareMountTargetWithContext(context, options)
+	}
+
+	prototype, err := json.Marshal(options.ShareMountTargetPrototype)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := map[string]interface{}{}
+	if err = json.Unmarshal(prototype, &body); err != nil {
+		return nil, nil, err
+	}
+	vni, _ := body["virtual_network_interface"].(map[string]interface{})
+	if vni == nil {
+		vni = map[string]interface{}{}
+	}
+	vni["protocol_state_filtering_mode"] = protocolStateFilteringMode
+	body["virtual_network_interface"] = vni
+
+	var result map[string]json.RawMessage
+	response, err := vpcRequest(context, vpcClient, core.POST, `/shares/{share_id}/mount_targets`, map[string]string{"share_id": *options.ShareID}, body, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	var shareTarget *vpcv1.ShareMountTarget
+	if err = vpcv1.UnmarshalShareMountTarget(result, &shareTarget); err != nil {
+		return nil, response, err
+	}
+	return shareTarget, response, nil
+}
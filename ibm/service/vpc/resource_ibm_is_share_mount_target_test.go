@@ -176,6 +176,36 @@ func TestAccIbmIsShareMountTargetVNI(t *testing.T) {
 	})
 }
 
+func TestAccIbmIsShareMountTargetVNIProtocolStateFilteringMode(t *testing.T) {
+	var conf vpcv1.ShareMountTarget
+	vpcname := fmt.Sprintf("tf-vpc-name-%d", acctest.RandIntRange(10, 100))
+	targetName := fmt.Sprintf("tf-target-%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("tf-fs-name-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tf-subnet-name-%d", acctest.RandIntRange(10, 100))
+	vniName := fmt.Sprintf("tf-vni-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIsShareTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsShareMountTargetConfigVNIProtocolStateFilteringMode(vpcname, sname, targetName, subnetName, vniName, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsShareTargetExists("ibm_is_share_mount_target.is_share_target", conf),
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.is_share_target", "virtual_network_interface.0.protocol_state_filtering_mode", "enabled"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIsShareMountTargetConfigVNIProtocolStateFilteringMode(vpcname, sname, targetName, subnetName, vniName, "disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.is_share_target", "virtual_network_interface.0.protocol_state_filtering_mode", "disabled"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIsShareMountTargetConfigVNISubnet(vpcName, sname, targetName, subnetName, vniName string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "group" {
@@ -207,6 +237,35 @@ func testAccCheckIbmIsShareMountTargetConfigVNISubnet(vpcName, sname, targetName
 	}
 	`, sname, acc.ShareProfileName, vpcName, subnetName, acc.ISCIDR, vniName, targetName)
 }
+func testAccCheckIbmIsShareMountTargetConfigVNIProtocolStateFilteringMode(vpcName, sname, targetName, subnetName, vniName, mode string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_share" "is_share" {
+		zone = "us-south-1"
+		size = 200
+		name = "%s"
+		profile = "%s"
+	}
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "us-south-1"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_share_mount_target" "is_share_target" {
+		share = ibm_is_share.is_share.id
+		virtual_network_interface {
+			name = "%s"
+			subnet = ibm_is_subnet.testacc_subnet.id
+			protocol_state_filtering_mode = "%s"
+		}
+
+		name = "%s"
+	}
+	`, sname, acc.ShareProfileName, vpcName, subnetName, acc.ISCIDR, vniName, mode, targetName)
+}
 func testAccCheckIbmIsShareMountTargetConfigVNIPrimaryIPID(vpcName, sname, targetName, subnetName, vniName, resIPName string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "group" {
@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -147,6 +148,39 @@ func TestAccIbmIsShareReplicaInline(t *testing.T) {
 	})
 }
 
+func TestAccIbmIsShareReplicaInlineSameZone(t *testing.T) {
+	name := fmt.Sprintf("tf-fs-name-%d", acctest.RandIntRange(10, 100))
+	replicaName := fmt.Sprintf("tf-fsrp-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmIsShareConfigReplicaInlineSameZone(name, replicaName),
+				ExpectError: regexp.MustCompile("replica_share zone must be different from the share zone"),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIsShareConfigReplicaInlineSameZone(name, replicaName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_share" "is_share" {
+		size    = 200
+		name    = "%s"
+		profile = "%s"
+		replica_share {
+			name                  = "%s"
+			replication_cron_spec = "0 */5 * * *"
+			profile               = "%s"
+			zone                  = "us-south-1"
+		}
+		zone = "us-south-1"
+	}
+	`, name, acc.ShareProfileName, replicaName, acc.ShareProfileName)
+}
+
 func testAccCheckIbmIsShareConfigBasic(name string) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_share" "is_share" {
@@ -49,6 +49,7 @@ const (
 	isSnapshotWaiting           = "waiting"
 	isSnapshotCapturedAt        = "captured_at"
 	isSnapshotBackupPolicyPlan  = "backup_policy_plan"
+	isSnapshotCloneStates       = "clone_states"
 )
 
 func ResourceIBMSnapshot() *schema.Resource {
@@ -74,6 +75,11 @@ func ResourceIBMSnapshot() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.ForceNewIf(isSnapshotEncryptionKey, func(_ context.Context, diff *schema.ResourceDiff, v interface{}) bool {
+				// the data encryption key of a copy can't be re-wrapped in place, the snapshot is copied again with the new root key
+				_, copied := diff.GetOk(isSnapshotSourceSnapshotCRN)
+				return copied && diff.NewValueKnown(isSnapshotEncryptionKey) && diff.Get(isSnapshotEncryptionKey).(string) != ""
+			}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -284,7 +290,7 @@ func ResourceIBMSnapshot() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "A reference to the root key used to wrap the data encryption key for the source volume.",
+				Description: "A reference to the root key used to wrap the data encryption key for the source volume. When copying a snapshot with source_snapshot_crn, the root key in this region used to re-wrap the data encryption key of the copy.",
 			},
 
 			isSnapshotHref: {
@@ -327,6 +333,31 @@ func ResourceIBMSnapshot() *schema.Resource {
 				Description: "Zones for creating the snapshot clone",
 			},
 
+			isSnapshotCloneStates: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fast restore clones of the snapshot and whether they are ready to restore from",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the clone",
+						},
+						"available": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the clone is available to restore volumes from",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the clone was created",
+						},
+					},
+				},
+			},
+
 			isSnapshotUserTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -476,6 +507,7 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 	if clones, ok := d.GetOk(isSnapshotClones); ok {
 		cloneSet := clones.(*schema.Set)
 		if cloneSet.Len() != 0 {
+			snapshotClonesBillingWarning(flex.ExpandStringList(cloneSet.List()))
 			cloneobjs := make([]vpcv1.SnapshotClonePrototype, cloneSet.Len())
 			for i, clone := range cloneSet.List() {
 				clonestr := clone.(string)
@@ -629,6 +661,9 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	sourceSnapshotList := []map[string]interface{}{}
 	if snapshot.SourceSnapshot != nil {
+		if snapshot.SourceSnapshot.CRN != nil {
+			d.Set(isSnapshotSourceSnapshotCRN, *snapshot.SourceSnapshot.CRN)
+		}
 		sourceSnapshot := map[string]interface{}{}
 		sourceSnapshot["crn"] = snapshot.SourceSnapshot.CRN
 		sourceSnapshot["href"] = *snapshot.SourceSnapshot.Href
@@ -671,14 +706,24 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	var clones []string
 	clones = make([]string, 0)
+	cloneStates := make([]map[string]interface{}, 0)
 	if snapshot.Clones != nil {
 		for _, clone := range snapshot.Clones {
 			if clone.Zone != nil {
 				clones = append(clones, *clone.Zone.Name)
+				cloneState := map[string]interface{}{
+					"zone":      *clone.Zone.Name,
+					"available": clone.Available != nil && *clone.Available,
+				}
+				if clone.CreatedAt != nil {
+					cloneState["created_at"] = flex.DateTimeToString(clone.CreatedAt)
+				}
+				cloneStates = append(cloneStates, cloneState)
 			}
 		}
 	}
 	d.Set(isSnapshotClones, flex.NewStringSet(schema.HashString, clones))
+	d.Set(isSnapshotCloneStates, cloneStates)
 
 	backupPolicyPlanList := []map[string]interface{}{}
 	if snapshot.BackupPolicyPlan != nil {
@@ -813,6 +858,7 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 		add := flex.ExpandStringList(nv.Difference(ov).List())
 
 		if len(add) > 0 {
+			snapshotClonesBillingWarning(add)
 			for i := range add {
 				createCloneOptions := &vpcv1.CreateSnapshotCloneOptions{
 					ID:       &id,
@@ -891,6 +937,13 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		return snapshot, isSnapshotUpdating, nil
 	}
 }
+
+// snapshotClonesBillingWarning logs that fast restore clones are billed, for each zone and for as long
+// as the clone exists, on top of the snapshot itself.
+func snapshotClonesBillingWarning(zones []string) {
+	log.Printf("[WARN] Fast restore clones of the snapshot in zones %s are billed per zone for as long as they exist, in addition to the snapshot", strings.Join(zones, ", "))
+}
+
 func isWaitForCloneAvailable(sess *vpcv1.VpcV1, d *schema.ResourceData, id, zoneName string) (interface{}, error) {
 	log.Printf("Waiting for Snapshot (%s) clone (%s) to be available.", id, zoneName)
 
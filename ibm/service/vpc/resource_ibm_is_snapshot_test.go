@@ -165,6 +165,36 @@ func TestAccIBMISSnapshotSourceSnapshotCopy(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSnapshotSourceSnapshotCopyEncrypted(t *testing.T) {
+	var snapshot string
+	copySnapshotName := fmt.Sprintf("tf-snapshot-copy-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotConfigCRCEncrypted(copySnapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSnapshotExists("ibm_is_snapshot.testacc_snapshot_copy", snapshot),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot_copy", "source_snapshot_crn", acc.ISSnapshotCRN),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot_copy", "encryption", "user_managed"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot_copy", "encryption_key", acc.BaasEncryptionkeyCRN),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_snapshot.testacc_snapshot_copy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISSnapshotDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -404,6 +434,10 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_snapshot.testacc_snapshot", "clones.#", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_is_snapshot.testacc_snapshot", "clones.0", acc.ISZoneName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", "clone_states.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_snapshot.testacc_snapshot", "clone_states.0.created_at"),
 				),
 			},
 		},
@@ -462,3 +496,13 @@ func testAccCheckIBMISSnapshotConfigCRC(copySnapshotName string) string {
 `, copySnapshotName, acc.ISSnapshotCRN)
 
 }
+
+func testAccCheckIBMISSnapshotConfigCRCEncrypted(copySnapshotName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_snapshot" "testacc_snapshot_copy" {
+		name                = "%s"
+		source_snapshot_crn = "%s"
+		encryption_key      = "%s"
+	}
+`, copySnapshotName, acc.ISSnapshotCRN, acc.BaasEncryptionkeyCRN)
+}
@@ -54,6 +54,10 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSSHKeyPublicKeyDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -445,6 +449,35 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 	return true, nil
 }
 
+// resourceIBMISSSHKeyPublicKeyDiff validates a new public key at plan time, sets the type from the key when
+// the type isn't configured, and computes the fingerprint the way the API reports it.
+func resourceIBMISSSHKeyPublicKeyDiff(diff *schema.ResourceDiff) error {
+	if (diff.Id() != "" && !diff.HasChange(isKeyPublicKey)) || !diff.NewValueKnown(isKeyPublicKey) {
+		return nil
+	}
+	pk, err := parseKey(strings.TrimSpace(diff.Get(isKeyPublicKey).(string)))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing public_key: %s", err)
+	}
+	var keyType string
+	switch pk.Type() {
+	case ssh.KeyAlgoRSA:
+		keyType = "rsa"
+	case ssh.KeyAlgoED25519:
+		keyType = "ed25519"
+	default:
+		return fmt.Errorf("[ERROR] public_key of type %s is not supported, the supported key types are rsa and ed25519", pk.Type())
+	}
+	if diff.GetRawConfig().GetAttr(isKeyType).IsNull() {
+		if err = diff.SetNew(isKeyType, keyType); err != nil {
+			return err
+		}
+	} else if diff.NewValueKnown(isKeyType) && diff.Get(isKeyType).(string) != keyType {
+		return fmt.Errorf("[ERROR] type %s doesn't match the %s public_key", diff.Get(isKeyType).(string), keyType)
+	}
+	return diff.SetNew(isKeyFingerprint, ssh.FingerprintSHA256(pk))
+}
+
 // to suppress any change shown when keys are same
 func suppressPublicKeyDiff(k, old, new string, d *schema.ResourceData) bool {
 	// if there are extra spaces or new lines, suppress that change
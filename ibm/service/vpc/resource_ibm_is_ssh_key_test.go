@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -94,6 +95,33 @@ func TestAccIBMISSSHKey_ed25519(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSSHKey_ed25519TypeFromPublicKey(t *testing.T) {
+	var key string
+	publicKey := strings.TrimSpace(`ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHEE9sLlndKFR/hVbF7SUNhKBFrxscJDHrVN/OD1Z+8V abc.edf@ibm.com`)
+	name := fmt.Sprintf("tfssh-createname-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISKeyConfigEd25519(publicKey, name, "rsa"),
+				ExpectError: regexp.MustCompile("type rsa doesn't match the ed25519 public_key"),
+			},
+			{
+				Config: testAccCheckIBMISKeyConfig(publicKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISKeyExists("ibm_is_ssh_key.isExampleKey", key),
+					resource.TestCheckResourceAttr(
+						"ibm_is_ssh_key.isExampleKey", "type", "ed25519"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_ssh_key.isExampleKey", "fingerprint", "SHA256:0MzzyawAlvz5lf/Bx8fRPCD2VafZCtNEH4iFt5hBElk"),
+				),
+			},
+		},
+	})
+}
+
 func checkKeyDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
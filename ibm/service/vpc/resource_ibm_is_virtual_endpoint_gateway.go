@@ -31,6 +31,7 @@ const (
 	isVirtualEndpointGatewayIPsName                   = "name"
 	isVirtualEndpointGatewayIPsSubnet                 = "subnet"
 	isVirtualEndpointGatewayIPsResourceType           = "resource_type"
+	isVirtualEndpointGatewayIPsAutoDelete             = "auto_delete"
 	isVirtualEndpointGatewayHealthState               = "health_state"
 	isVirtualEndpointGatewayLifecycleState            = "lifecycle_state"
 	isVirtualEndpointGatewayTarget                    = "target"
@@ -43,6 +44,10 @@ const (
 	isVirtualEndpointGatewayServiceEndpoints          = "service_endpoints"
 	isVirtualEndpointGatewayAccessTags                = "access_tags"
 	isVirtualEndpointGatewayAllowDnsResolutionBinding = "allow_dns_resolution_binding"
+
+	// private path service gateway targets, the vpc-go-sdk version used by the provider has no
+	// constant for the resource type
+	isVirtualEndpointGatewayTargetPrivatePathServiceGateway = "private_path_service_gateway"
 )
 
 func ResourceIBMISEndpointGateway() *schema.Resource {
@@ -66,6 +71,10 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMisVirtualEndpointGatewayValidateTarget(diff)
+				}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -155,6 +164,13 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 							Optional:    true,
 							Description: "The Subnet id",
 						},
+						isVirtualEndpointGatewayIPsAutoDelete: {
+							Type:             schema.TypeBool,
+							Optional:         true,
+							Default:          true,
+							DiffSuppressFunc: flex.ApplyOnce,
+							Description:      "Indicates whether the new reserved IP will be automatically deleted when the endpoint gateway is deleted or the reserved IP is unbound, ignored when id is set",
+						},
 						isVirtualEndpointGatewayIPsResourceType: {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -199,6 +215,7 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 						isVirtualEndpointGatewayTargetResourceType: {
 							Type:         schema.TypeString,
 							Required:     true,
+							ForceNew:     true,
 							ValidateFunc: validate.InvokeValidator("ibm_is_virtual_endpoint_gateway", isVirtualEndpointGatewayTargetResourceType),
 							Description:  "The target resource type",
 						},
@@ -263,7 +280,7 @@ func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              "provider_cloud_service, provider_infrastructure_service"})
+			AllowedValues:              "private_path_service_gateway, provider_cloud_service, provider_infrastructure_service"})
 
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -279,6 +296,34 @@ func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
 	return &ibmEndpointGatewayResourceValidator
 }
 
+// resourceIBMisVirtualEndpointGatewayValidateTarget checks that the target is identified the way
+// its resource type requires: private path service gateways and provider cloud services by CRN and
+// provider infrastructure services by name.
+func resourceIBMisVirtualEndpointGatewayValidateTarget(diff *schema.ResourceDiff) error {
+	targetNameFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetName)
+	targetCRNFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetCRN)
+	targetResourceTypeFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetResourceType)
+	if !diff.NewValueKnown(targetNameFmt) || !diff.NewValueKnown(targetCRNFmt) || !diff.NewValueKnown(targetResourceTypeFmt) {
+		return nil
+	}
+	name := diff.Get(targetNameFmt).(string)
+	crn := diff.Get(targetCRNFmt).(string)
+	switch resourceType := diff.Get(targetResourceTypeFmt).(string); resourceType {
+	case vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderCloudServiceConst, isVirtualEndpointGatewayTargetPrivatePathServiceGateway:
+		if crn == "" {
+			return fmt.Errorf("[ERROR] Endpoint gateway target %s is required when %s is %s", isVirtualEndpointGatewayTargetCRN, isVirtualEndpointGatewayTargetResourceType, resourceType)
+		}
+	case vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderInfrastructureServiceConst:
+		if name == "" {
+			return fmt.Errorf("[ERROR] Endpoint gateway target %s is required when %s is %s", isVirtualEndpointGatewayTargetName, isVirtualEndpointGatewayTargetResourceType, resourceType)
+		}
+		if crn != "" {
+			return fmt.Errorf("[ERROR] Endpoint gateway target %s isn't supported when %s is %s", isVirtualEndpointGatewayTargetCRN, isVirtualEndpointGatewayTargetResourceType, resourceType)
+		}
+	}
+	return nil
+}
+
 func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -354,7 +399,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(*endpointGateway.ID)
 
-	_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), *targetOpt.ResourceType == isVirtualEndpointGatewayTargetPrivatePathServiceGateway, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -403,6 +448,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Update Endpoint Gateway failed : %s\n%s", err, response)
 	}
 	id := d.Id()
+	privatePath := d.Get(fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetResourceType)).(string) == isVirtualEndpointGatewayTargetPrivatePathServiceGateway
 	var remove, add []string
 	if d.HasChange(isVirtualEndpointGatewaySecurityGroups) {
 		o, n := d.GetChange(isVirtualEndpointGatewaySecurityGroups)
@@ -419,7 +465,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 				if err != nil {
 					return fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response)
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), privatePath, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -443,7 +489,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 				if err != nil {
 					return fmt.Errorf("Error Deleting Security Group Target for this endpoint gateway : %s\n%s", err, response)
 				}
-				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				_, err = isWaitForVirtualEndpointGatewayAvailable(sess, d.Id(), privatePath, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
 					return err
 				}
@@ -540,12 +586,21 @@ func flattenDataSourceSecurityGroups(securityGroupList []vpcv1.SecurityGroupRefe
 	return securitygroupList
 }
 
-func isWaitForVirtualEndpointGatewayAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, timeout time.Duration) (interface{}, error) {
+// isWaitForVirtualEndpointGatewayAvailable waits for the endpoint gateway to be stable. An endpoint
+// gateway to a private path service gateway stays pending until the provider reviews its binding,
+// which can take any time, so pending is accepted for those.
+func isWaitForVirtualEndpointGatewayAvailable(sess *vpcv1.VpcV1, endPointGatewayId string, privatePath bool, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual endpoint gateway (%s) to be available.", endPointGatewayId)
 
+	pending := []string{"waiting", "pending", "updating"}
+	target := []string{"stable", "failed", ""}
+	if privatePath {
+		pending = []string{"waiting", "updating"}
+		target = append(target, "pending")
+	}
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"waiting", "pending", "updating"},
-		Target:     []string{"stable", "failed", ""},
+		Pending:    pending,
+		Target:     target,
 		Refresh:    isVirtualEndpointGatewayRefreshFunc(sess, endPointGatewayId),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
@@ -617,14 +672,20 @@ func expandIPs(ipsSet []interface{}) (ipsOptions []vpcv1.EndpointGatewayReserved
 		// IPs subnet option
 		ipsSubnetID := ips[isVirtualEndpointGatewayIPsSubnet].(string)
 
-		ipsSubnetOpt := &vpcv1.SubnetIdentity{
-			ID: &ipsSubnetID,
-		}
-
-		ipsOpt := &vpcv1.EndpointGatewayReservedIP{
-			ID:     core.StringPtr(ipsID),
-			Name:   core.StringPtr(ipsName),
-			Subnet: ipsSubnetOpt,
+		// an existing reserved IP is bound by id, otherwise a new one is reserved in the subnet
+		ipsOpt := &vpcv1.EndpointGatewayReservedIP{}
+		if ipsID != "" {
+			ipsOpt.ID = core.StringPtr(ipsID)
+		} else {
+			ipsOpt.Subnet = &vpcv1.SubnetIdentity{
+				ID: &ipsSubnetID,
+			}
+			if ipsName != "" {
+				ipsOpt.Name = core.StringPtr(ipsName)
+			}
+			if autoDelete, ok := ips[isVirtualEndpointGatewayIPsAutoDelete].(bool); ok {
+				ipsOpt.AutoDelete = core.BoolPtr(autoDelete)
+			}
 		}
 		ipsOptions = append(ipsOptions, ipsOpt)
 	}
@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -36,6 +37,25 @@ func TestAccIBMISVirtualEndpointGateway_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVirtualEndpointGateway_InvalidTarget(t *testing.T) {
+	vpcname1 := fmt.Sprintf("tfvpngw-vpc-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("tfvpngw-createname-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckisVirtualEndpointGatewayConfigTarget(vpcname1, name1, "name", "ibm-ntp-server", "provider_cloud_service"),
+				ExpectError: regexp.MustCompile(`target crn is required when resource_type is provider_cloud_service`),
+			},
+			{
+				Config:      testAccCheckisVirtualEndpointGatewayConfigTarget(vpcname1, name1, "crn", "crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.mil01.cloud-object-storage.appdomain.cloud", "provider_infrastructure_service"),
+				ExpectError: regexp.MustCompile(`target name is required when resource_type is provider_infrastructure_service`),
+			},
+		},
+	})
+}
+
 func TestAccIBMISVirtualEndpointGateway_AllowDnsResolutionBinding(t *testing.T) {
 	var endpointGateway string
 	vpcname1 := fmt.Sprintf("tfvpngw-vpc-%d", acctest.RandIntRange(10, 100))
@@ -161,6 +181,31 @@ func TestAccIBMISVirtualEndpointGateway_FullySpecified(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVirtualEndpointGateway_IPsAutoDelete(t *testing.T) {
+	var monitor string
+	vpcname1 := fmt.Sprintf("tfvpngw-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname1 := fmt.Sprintf("tfvpngw-subnet-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("tfvpngw-createname-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_is_virtual_endpoint_gateway.endpoint_gateway"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckisVirtualEndpointGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckisVirtualEndpointGatewayConfigIPsAutoDelete(vpcname1, subnetname1, name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckisVirtualEndpointGatewayExists(name, &monitor),
+					resource.TestCheckResourceAttr(name, "name", name1),
+					resource.TestCheckResourceAttrSet(name, "ips.0.id"),
+					resource.TestCheckResourceAttr("data.ibm_is_subnet_reserved_ip.vpe_ip", "auto_delete", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISVirtualEndpointGateway_CreateAfterManualDestroy(t *testing.T) {
 	t.Skip()
 	var monitorOne, monitorTwo string
@@ -285,6 +330,21 @@ func testAccCheckisVirtualEndpointGatewayConfigBasic(vpcname1, subnetname1, name
 	}`, vpcname1, subnetname1, acc.ISZoneName, acc.ISCIDR, name1)
 }
 
+func testAccCheckisVirtualEndpointGatewayConfigTarget(vpcname1, name1, targetAttribute, targetValue, resourceType string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_virtual_endpoint_gateway" "endpoint_gateway" {
+		name = "%[2]s"
+		target {
+		  %[3]s         = "%[4]s"
+		  resource_type = "%[5]s"
+		}
+		vpc = ibm_is_vpc.testacc_vpc.id
+	}`, vpcname1, name1, targetAttribute, targetValue, resourceType)
+}
+
 func testAccCheckisVirtualEndpointGatewayConfigAllowDnsResolutionBinding(vpcname1, name1 string, enable_hub, allowDnsResolutionBinding bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -335,6 +395,36 @@ func testAccCheckisVirtualEndpointGatewayConfigFullySpecified(vpcname1, subnetna
 	}`, vpcname1, subnetname1, acc.ISZoneName, acc.ISCIDR, name1)
 }
 
+func testAccCheckisVirtualEndpointGatewayConfigIPsAutoDelete(vpcname1, subnetname1, name1 string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%[2]s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%[3]s"
+		ipv4_cidr_block = "%[4]s"
+	}
+	resource "ibm_is_virtual_endpoint_gateway" "endpoint_gateway" {
+		name = "%[5]s"
+		target {
+			name          = "ibm-dns-server2"
+			resource_type = "provider_infrastructure_service"
+		}
+		vpc = ibm_is_vpc.testacc_vpc.id
+		ips {
+		  subnet      = ibm_is_subnet.testacc_subnet.id
+		  name        = "test-reserved-ip1"
+		  auto_delete = false
+		}
+	}
+	data "ibm_is_subnet_reserved_ip" "vpe_ip" {
+		subnet      = ibm_is_subnet.testacc_subnet.id
+		reserved_ip = ibm_is_virtual_endpoint_gateway.endpoint_gateway.ips.0.id
+	}`, vpcname1, subnetname1, acc.ISZoneName, acc.ISCIDR, name1)
+}
+
 func testAccCheckisVirtualEndpointGatewayConfigBasicSecurityGroups(vpcname1, subnetname1, sgname1, name1 string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -0,0 +1,572 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	isVirtualNetworkInterfaceStable   = "stable"
+	isVirtualNetworkInterfaceFailed   = "failed"
+	isVirtualNetworkInterfacePending  = "pending"
+	isVirtualNetworkInterfaceUpdating = "updating"
+	isVirtualNetworkInterfaceWaiting  = "waiting"
+	isVirtualNetworkInterfaceDeleting = "deleting"
+	isVirtualNetworkInterfaceDeleted  = "deleted"
+
+	isVirtualNetworkInterfaceProtocolStateFilteringModeAuto = "auto"
+)
+
+// virtualNetworkInterface is the virtual network interface returned by the VPC API. The vpc-go-sdk
+// version used by the provider models neither its create and delete operations nor the IP spoofing,
+// infrastructure NAT and protocol state filtering settings.
+type virtualNetworkInterface struct {
+	AllowIPSpoofing            *bool                          `json:"allow_ip_spoofing,omitempty"`
+	AutoDelete                 *bool                          `json:"auto_delete,omitempty"`
+	CreatedAt                  *string                        `json:"created_at,omitempty"`
+	CRN                        *string                        `json:"crn,omitempty"`
+	EnableInfrastructureNat    *bool                          `json:"enable_infrastructure_nat,omitempty"`
+	Href                       *string                        `json:"href,omitempty"`
+	ID                         *string                        `json:"id,omitempty"`
+	LifecycleState             *string                        `json:"lifecycle_state,omitempty"`
+	Name                       *string                        `json:"name,omitempty"`
+	PrimaryIP                  *vpcv1.ReservedIPReference     `json:"primary_ip,omitempty"`
+	ProtocolStateFilteringMode *string                        `json:"protocol_state_filtering_mode,omitempty"`
+	ResourceGroup              *vpcv1.ResourceGroupReference  `json:"resource_group,omitempty"`
+	ResourceType               *string                        `json:"resource_type,omitempty"`
+	SecurityGroups             []vpcv1.SecurityGroupReference `json:"security_groups,omitempty"`
+	Subnet                     *vpcv1.SubnetReference         `json:"subnet,omitempty"`
+	Target                     *virtualNetworkInterfaceTarget `json:"target,omitempty"`
+	VPC                        *vpcv1.VPCReference            `json:"vpc,omitempty"`
+	Zone                       *vpcv1.ZoneReference           `json:"zone,omitempty"`
+}
+
+type virtualNetworkInterfaceTarget struct {
+	Href         *string `json:"href,omitempty"`
+	ID           *string `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	ResourceType *string `json:"resource_type,omitempty"`
+}
+
+func ResourceIBMIsVirtualNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVirtualNetworkInterfaceCreate,
+		ReadContext:   resourceIBMIsVirtualNetworkInterfaceRead,
+		UpdateContext: resourceIBMIsVirtualNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMIsVirtualNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_virtual_network_interface", "name"),
+				Description:  "The name for this virtual network interface. The name is unique across all virtual network interfaces in the VPC.",
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subnet of the virtual network interface.",
+			},
+			"allow_ip_spoofing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether source IP spoofing is allowed on this interface. If false, source IP spoofing is prevented on this interface. If true, source IP spoofing is allowed on this interface.",
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether this virtual network interface will be automatically deleted when the target it is attached to is deleted.",
+			},
+			"enable_infrastructure_nat": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If true, the VPC infrastructure performs any needed NAT operations. If false, the packet is passed unchanged to the target, which must perform any needed NAT operations.",
+			},
+			"protocol_state_filtering_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      isVirtualNetworkInterfaceProtocolStateFilteringModeAuto,
+				ValidateFunc: validate.InvokeValidator("ibm_is_virtual_network_interface", "protocol_state_filtering_mode"),
+				Description:  "The protocol state filtering mode used for this virtual network interface, one of auto, enabled or disabled. With auto, filtering is enabled unless the target is a bare metal server.",
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups for this virtual network interface, the VPC default security group if not set.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group for this virtual network interface.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the virtual network interface was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this virtual network interface.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this virtual network interface.",
+			},
+			"lifecycle_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the virtual network interface.",
+			},
+			"primary_ip": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The primary IP address of the virtual network interface.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this reserved IP.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this reserved IP.",
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The target of this virtual network interface, if it is attached to one.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for the target.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the target.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for the target.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type of the target.",
+						},
+					},
+				},
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPC this virtual network interface resides in.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone this virtual network interface resides in.",
+			},
+		},
+	}
+}
+
+func ResourceIBMIsVirtualNetworkInterfaceValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "protocol_state_filtering_mode",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "auto, disabled, enabled",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_virtual_network_interface", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{
+		"subnet":                        map[string]string{"id": d.Get("subnet").(string)},
+		"allow_ip_spoofing":             d.Get("allow_ip_spoofing").(bool),
+		"auto_delete":                   d.Get("auto_delete").(bool),
+		"enable_infrastructure_nat":     d.Get("enable_infrastructure_nat").(bool),
+		"protocol_state_filtering_mode": d.Get("protocol_state_filtering_mode").(string),
+	}
+	if name, ok := d.GetOk("name"); ok {
+		body["name"] = name.(string)
+	}
+	if resourceGroup, ok := d.GetOk("resource_group"); ok {
+		body["resource_group"] = map[string]string{"id": resourceGroup.(string)}
+	}
+	if sgs, ok := d.GetOk("security_groups"); ok && sgs.(*schema.Set).Len() > 0 {
+		securityGroups := []map[string]string{}
+		for _, sg := range sgs.(*schema.Set).List() {
+			securityGroups = append(securityGroups, map[string]string{"id": sg.(string)})
+		}
+		body["security_groups"] = securityGroups
+	}
+
+	vni := &virtualNetworkInterface{}
+	response, err := vpcRequest(context, vpcClient, core.POST, `/virtual_network_interfaces`, nil, body, vni)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*vni.ID)
+
+	_, err = isWaitForVirtualNetworkInterfaceStable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIsVirtualNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsVirtualNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vni, response, err := getVirtualNetworkInterface(context, vpcClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", vni.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if vni.Subnet != nil {
+		if err = d.Set("subnet", vni.Subnet.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting subnet: %s", err))
+		}
+	}
+	if err = d.Set("allow_ip_spoofing", vni.AllowIPSpoofing); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_ip_spoofing: %s", err))
+	}
+	if err = d.Set("auto_delete", vni.AutoDelete); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting auto_delete: %s", err))
+	}
+	if err = d.Set("enable_infrastructure_nat", vni.EnableInfrastructureNat); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enable_infrastructure_nat: %s", err))
+	}
+	if err = d.Set("protocol_state_filtering_mode", vni.ProtocolStateFilteringMode); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting protocol_state_filtering_mode: %s", err))
+	}
+
+	securityGroups := make([]string, 0, len(vni.SecurityGroups))
+	for _, sg := range vni.SecurityGroups {
+		securityGroups = append(securityGroups, *sg.ID)
+	}
+	if err = d.Set("security_groups", flex.NewStringSet(schema.HashString, securityGroups)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting security_groups: %s", err))
+	}
+
+	if vni.ResourceGroup != nil {
+		if err = d.Set("resource_group", vni.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting resource_group: %s", err))
+		}
+	}
+	if err = d.Set("created_at", vni.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", vni.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("href", vni.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("lifecycle_state", vni.LifecycleState); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lifecycle_state: %s", err))
+	}
+	if err = d.Set("resource_type", vni.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	primaryIP := []map[string]interface{}{}
+	if vni.PrimaryIP != nil {
+		primaryIP = append(primaryIP, map[string]interface{}{
+			"address": core.StringNilMapper(vni.PrimaryIP.Address),
+			"href":    core.StringNilMapper(vni.PrimaryIP.Href),
+			"id":      core.StringNilMapper(vni.PrimaryIP.ID),
+			"name":    core.StringNilMapper(vni.PrimaryIP.Name),
+		})
+	}
+	if err = d.Set("primary_ip", primaryIP); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting primary_ip: %s", err))
+	}
+
+	target := []map[string]interface{}{}
+	if vni.Target != nil {
+		target = append(target, map[string]interface{}{
+			"href":          core.StringNilMapper(vni.Target.Href),
+			"id":            core.StringNilMapper(vni.Target.ID),
+			"name":          core.StringNilMapper(vni.Target.Name),
+			"resource_type": core.StringNilMapper(vni.Target.ResourceType),
+		})
+	}
+	if err = d.Set("target", target); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target: %s", err))
+	}
+
+	if vni.VPC != nil {
+		if err = d.Set("vpc", vni.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting vpc: %s", err))
+		}
+	}
+	if vni.Zone != nil {
+		if err = d.Set("zone", vni.Zone.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting zone: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := map[string]interface{}{}
+	if d.HasChange("name") {
+		body["name"] = d.Get("name").(string)
+	}
+	for _, key := range []string{"allow_ip_spoofing", "auto_delete", "enable_infrastructure_nat"} {
+		if d.HasChange(key) {
+			body[key] = d.Get(key).(bool)
+		}
+	}
+	if d.HasChange("protocol_state_filtering_mode") {
+		body["protocol_state_filtering_mode"] = d.Get("protocol_state_filtering_mode").(string)
+	}
+
+	if len(body) > 0 {
+		vni := &virtualNetworkInterface{}
+		response, err := vpcRequest(context, vpcClient, core.PATCH, `/virtual_network_interfaces/{id}`,
+			map[string]string{"id": d.Id()}, body, vni)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	if d.HasChange("security_groups") {
+		ovs, nvs := d.GetChange("security_groups")
+		ov := ovs.(*schema.Set)
+		nv := nvs.(*schema.Set)
+		remove := flex.ExpandStringList(ov.Difference(nv).List())
+		add := flex.ExpandStringList(nv.Difference(ov).List())
+		id := d.Id()
+		// the new security groups are bound first, so that the interface is never left without one
+		for i := range add {
+			createsgvnioptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{
+				SecurityGroupID: &add[i],
+				ID:              &id,
+			}
+			_, response, err := vpcClient.CreateSecurityGroupTargetBindingWithContext(context, createsgvnioptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while adding security group %q to virtual network interface %s\n%s: %q", add[i], id, err, response))
+			}
+			_, err = isWaitForVirtualNetworkInterfaceStable(context, vpcClient, id, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for i := range remove {
+			deletesgvnioptions := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
+				SecurityGroupID: &remove[i],
+				ID:              &id,
+			}
+			response, err := vpcClient.DeleteSecurityGroupTargetBindingWithContext(context, deletesgvnioptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q from virtual network interface %s\n%s: %q", remove[i], id, err, response))
+			}
+			_, err = isWaitForVirtualNetworkInterfaceStable(context, vpcClient, id, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMIsVirtualNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMIsVirtualNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := vpcRequest(context, vpcClient, core.DELETE, `/virtual_network_interfaces/{id}`,
+		map[string]string{"id": d.Id()}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteVirtualNetworkInterfaceWithContext failed %s\n%s", err, response))
+	}
+
+	_, err = isWaitForVirtualNetworkInterfaceDeleted(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getVirtualNetworkInterface(context context.Context, vpcClient *vpcv1.VpcV1, id string) (*virtualNetworkInterface, *core.DetailedResponse, error) {
+	vni := &virtualNetworkInterface{}
+	response, err := vpcRequest(context, vpcClient, core.GET, `/virtual_network_interfaces/{id}`,
+		map[string]string{"id": id}, nil, vni)
+	if err != nil {
+		return nil, response, err
+	}
+	return vni, response, nil
+}
+
+func isWaitForVirtualNetworkInterfaceStable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for virtual network interface (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isVirtualNetworkInterfacePending, isVirtualNetworkInterfaceUpdating, isVirtualNetworkInterfaceWaiting},
+		Target:     []string{isVirtualNetworkInterfaceStable, isVirtualNetworkInterfaceFailed},
+		Refresh:    isVirtualNetworkInterfaceRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isVirtualNetworkInterfaceRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vni, response, err := getVirtualNetworkInterface(context, vpcClient, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting virtual network interface: %s\n%s", err, response)
+		}
+
+		if *vni.LifecycleState == isVirtualNetworkInterfaceFailed {
+			return vni, *vni.LifecycleState, fmt.Errorf("[ERROR] Virtual network interface (%s) went into failed state", id)
+		}
+		return vni, *vni.LifecycleState, nil
+	}
+}
+
+func isWaitForVirtualNetworkInterfaceDeleted(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for virtual network interface (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isVirtualNetworkInterfaceDeleting, isVirtualNetworkInterfaceStable, isVirtualNetworkInterfaceUpdating},
+		Target:     []string{isVirtualNetworkInterfaceDeleted, isVirtualNetworkInterfaceFailed},
+		Refresh:    isVirtualNetworkInterfaceDeleteRefreshFunc(context, vpcClient, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isVirtualNetworkInterfaceDeleteRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vni, response, err := getVirtualNetworkInterface(context, vpcClient, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return vni, isVirtualNetworkInterfaceDeleted, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error getting virtual network interface: %s\n%s", err, response)
+		}
+
+		if *vni.LifecycleState == isVirtualNetworkInterfaceFailed {
+			return vni, *vni.LifecycleState, fmt.Errorf("[ERROR] Virtual network interface (%s) failed to delete", id)
+		}
+		return vni, *vni.LifecycleState, nil
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIsVirtualNetworkInterfaceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vni-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-vni-update-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, name, false, true, "auto"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "allow_ip_spoofing", "false"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "enable_infrastructure_nat", "true"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "protocol_state_filtering_mode", "auto"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "target.#", "0"),
+					resource.TestCheckResourceAttrSet("ibm_is_virtual_network_interface.is_vni", "crn"),
+					resource.TestCheckResourceAttrSet("ibm_is_virtual_network_interface.is_vni", "primary_ip.0.address"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, nameUpdate, true, false, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "allow_ip_spoofing", "true"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "enable_infrastructure_nat", "false"),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "protocol_state_filtering_mode", "enabled"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_virtual_network_interface.is_vni",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIsVirtualNetworkInterfaceSecurityGroups(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sgname := fmt.Sprintf("tf-sg-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vni-%d", acctest.RandIntRange(10, 100))
+	var vniID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceSecurityGroupsConfig(vpcname, subnetname, sgname, name, "ibm_is_security_group.testacc_sg_1.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceID("ibm_is_virtual_network_interface.is_vni", &vniID, false),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "security_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("ibm_is_virtual_network_interface.is_vni", "security_groups.*", "ibm_is_security_group.testacc_sg_1", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceSecurityGroupsConfig(vpcname, subnetname, sgname, name, "ibm_is_security_group.testacc_sg_1.id, ibm_is_security_group.testacc_sg_2.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceID("ibm_is_virtual_network_interface.is_vni", &vniID, true),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "security_groups.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIsVirtualNetworkInterfaceSecurityGroupsConfig(vpcname, subnetname, sgname, name, "ibm_is_security_group.testacc_sg_2.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIsVirtualNetworkInterfaceID("ibm_is_virtual_network_interface.is_vni", &vniID, true),
+					resource.TestCheckResourceAttr("ibm_is_virtual_network_interface.is_vni", "security_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("ibm_is_virtual_network_interface.is_vni", "security_groups.*", "ibm_is_security_group.testacc_sg_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMIsVirtualNetworkInterfaceID saves the ID of the virtual network interface, or checks that it is
+// unchanged, so that the security groups are known to be updated in place.
+func testAccCheckIBMIsVirtualNetworkInterfaceID(n string, id *string, same bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if same && rs.Primary.ID != *id {
+			return fmt.Errorf("virtual network interface was replaced: %s, expected %s", rs.Primary.ID, *id)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMIsVirtualNetworkInterfaceConfig(vpcname, subnetname, name string, allowIPSpoofing, enableNat bool, protocolStateFilteringMode string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_virtual_network_interface" "is_vni" {
+		name                          = "%s"
+		subnet                        = ibm_is_subnet.testacc_subnet.id
+		allow_ip_spoofing             = %t
+		enable_infrastructure_nat     = %t
+		protocol_state_filtering_mode = "%s"
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, allowIPSpoofing, enableNat, protocolStateFilteringMode)
+}
+
+func testAccCheckIBMIsVirtualNetworkInterfaceSecurityGroupsConfig(vpcname, subnetname, sgname, name, securityGroups string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%[2]s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%[3]s"
+		ipv4_cidr_block = "%[4]s"
+	}
+
+	resource "ibm_is_security_group" "testacc_sg_1" {
+		name = "%[5]s-1"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group" "testacc_sg_2" {
+		name = "%[5]s-2"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_virtual_network_interface" "is_vni" {
+		name            = "%[6]s"
+		subnet          = ibm_is_subnet.testacc_subnet.id
+		security_groups = [%[7]s]
+	}
+	`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sgname, name, securityGroups)
+}
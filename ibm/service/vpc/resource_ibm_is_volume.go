@@ -688,7 +688,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 	optionsget := &vpcv1.GetVolumeOptions{
 		ID: &id,
 	}
-	_, response, err := sess.GetVolume(optionsget)
+	vol, response, err := sess.GetVolume(optionsget)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -696,6 +696,10 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		return fmt.Errorf("Error getting Volume (%s): %s\n%s", id, err, response)
 	}
+	// fail before any of the changes is applied
+	if d.HasChanges(isVolumeProfileName, isVolumeIops, isVolumeCapacity) && len(vol.VolumeAttachments) == 0 {
+		return fmt.Errorf("[ERROR] The profile, iops and capacity of volume %s can only be changed while it is attached to a virtual server instance", id)
+	}
 	eTag := response.Headers.Get("ETag")
 	options := &vpcv1.UpdateVolumeOptions{
 		ID: &id,
@@ -732,6 +736,9 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) < 1 {
 			return fmt.Errorf("[ERROR] Error updating Volume profile/iops because the specified volume %s is not attached to a virtual server instance ", volId)
 		}
+		// the name update changes the etag
+		eTag = response.Headers.Get("ETag")
+		options.IfMatch = &eTag
 		volAtt := &vol.VolumeAttachments[0]
 		insId := *volAtt.Instance.ID
 		getinsOptions := &vpcv1.GetInstanceOptions{
@@ -756,16 +763,12 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 				return err
 			}
 		}
-		if d.HasChange(isVolumeProfileName) {
-			profile := d.Get(isVolumeProfileName).(string)
-			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
-				Name: &profile,
-			}
-		} else if d.HasChange(isVolumeIops) {
-			profile := d.Get(isVolumeProfileName).(string)
-			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
-				Name: &profile,
-			}
+		profile := d.Get(isVolumeProfileName).(string)
+		volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
+			Name: &profile,
+		}
+		// iops is required when converting to the custom profile, and is set by the tier otherwise
+		if profile == "custom" {
 			iops := int64(d.Get(isVolumeIops).(int))
 			volumeProfilePatchModel.Iops = &iops
 		}
@@ -776,6 +779,9 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		}
 		options.VolumePatch = volumeProfilePatch
 		_, response, err = sess.UpdateVolume(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating vpc volume profile/iops: %s\n%s", err, response)
+		}
 		_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
@@ -799,6 +805,8 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		if vol.VolumeAttachments == nil || len(vol.VolumeAttachments) == 0 || *vol.VolumeAttachments[0].ID == "" {
 			return fmt.Errorf("[ERROR] Error volume capacity can't be updated since volume %s is not attached to any instance for VolumePatch", id)
 		}
+		eTag = response.Headers.Get("ETag")
+		options.IfMatch = &eTag
 		insId := vol.VolumeAttachments[0].Instance.ID
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: insId,
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -206,6 +207,91 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISVolumeConvertProfile_basic(t *testing.T) {
+	var volID string
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	volName := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+
+	// the volume must be converted in place, not replaced
+	checkSameVolume := func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["ibm_is_volume.storage"]
+		if !ok {
+			return errors.New("Not found: ibm_is_volume.storage")
+		}
+		if volID == "" {
+			volID = rs.Primary.ID
+		} else if rs.Primary.ID != volID {
+			return fmt.Errorf("Volume was replaced, %s is now %s", volID, rs.Primary.ID)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVolumeProfileConfig(vpcname, subnetname, sshname, publicKey, name, volName, "general-purpose", 0),
+				Check: resource.ComposeTestCheckFunc(
+					checkSameVolume,
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "profile", "general-purpose"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_volume.storage", "bandwidth"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVolumeProfileConfig(vpcname, subnetname, sshname, publicKey, name, volName, "custom", 1000),
+				Check: resource.ComposeTestCheckFunc(
+					checkSameVolume,
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "profile", "custom"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "iops", "1000"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVolumeProfileConfig(vpcname, subnetname, sshname, publicKey, name, volName, "general-purpose", 0),
+				Check: resource.ComposeTestCheckFunc(
+					checkSameVolume,
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "profile", "general-purpose"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISVolumeUpdateUnattached_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVolumeConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "name", name),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISVolumeUnattachedProfileConfig(name, "5iops-tier"),
+				ExpectError: regexp.MustCompile("can only be changed while it is attached to a virtual server instance"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISVolumeUpdateCapacity_basic(t *testing.T) {
 	var vol string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -434,6 +520,63 @@ func testAccCheckIBMISVolumeTierConfig(vpcname, subnetname, sshname, publicKey,
 
 }
 
+func testAccCheckIBMISVolumeUnattachedProfileConfig(name, profileName string) string {
+	return fmt.Sprintf(
+		`
+	resource "ibm_is_volume" "storage"{
+		name = "%s"
+		profile = "%s"
+		zone = "us-south-1"
+	}
+`, name, profileName)
+
+}
+
+func testAccCheckIBMISVolumeProfileConfig(vpcname, subnetname, sshname, publicKey, name, volName, profileName string, iops int64) string {
+	iopsConfig := ""
+	if iops > 0 {
+		iopsConfig = fmt.Sprintf("iops    = %d", iops)
+	}
+	return fmt.Sprintf(
+		`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+		  
+		resource "ibm_is_subnet" "testacc_subnet" {
+			name            			= "%s"
+			vpc             			= ibm_is_vpc.testacc_vpc.id
+			zone            			= "%s"
+			total_ipv4_address_count 	= 16
+		}
+		  
+		resource "ibm_is_ssh_key" "testacc_sshkey" {
+			name       = "%s"
+			public_key = "%s"
+		}
+		resource "ibm_is_volume" "storage"{
+			name 	= "%s"
+			profile = "%s"
+			zone 	= "%s"
+			%s
+		}		  
+		resource "ibm_is_instance" "testacc_instance" {
+			name    = "%s"
+			image   = "%s"
+			profile = "%s"
+			volumes = [ibm_is_volume.storage.id]
+			primary_network_interface {
+				subnet     = ibm_is_subnet.testacc_subnet.id
+			}
+			vpc  = ibm_is_vpc.testacc_vpc.id
+			zone = "%s"
+			keys = [ibm_is_ssh_key.testacc_sshkey.id]
+		}	
+
+`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, volName, profileName, acc.ISZoneName, iopsConfig, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName)
+
+}
+
 func testAccCheckIBMISVolumeAttachmentDeleteConfig(vpcname, subnetname, sshname, publicKey, insname, capacityArray string) string {
 	return fmt.Sprintf(
 		`
@@ -10,10 +10,12 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -48,6 +50,11 @@ const (
 	subnetsList                               = "subnets"
 	totalIPV4AddressCount                     = "total_ipv4_address_count"
 	availableIPV4AddressCount                 = "available_ipv4_address_count"
+	isVPCSubnetCount                          = "subnet_count"
+	isVPCPublicGateways                       = "public_gateways"
+	isVPCPublicGatewayCount                   = "public_gateway_count"
+	isVPCCseSourceAddressByZone               = "cse_source_address_by_zone"
+	isVPCFlowLogCollectorCount                = "flow_log_collector_count"
 	isVPCCRN                                  = "crn"
 	isVPCSecurityGroupList                    = "security_group"
 	isVPCSecurityGroupName                    = "group_name"
@@ -65,6 +72,8 @@ const (
 	isVPCAccessTags                           = "access_tags"
 	isVPCAccessTagType                        = "access"
 	isVPCUserTagType                          = "user"
+	isVPCPropagateTagsToChildren              = "propagate_tags_to_children"
+	isVPCUntaggedChildren                     = "untagged_children"
 	isVPCDns                                  = "dns"
 	isVPCDnsEnableHub                         = "enable_hub"
 	isVPCDnsResolutionBindingCount            = "resolution_binding_count"
@@ -86,6 +95,8 @@ const (
 	isVPCDnsResolverVpcRemote                 = "remote"
 	isVPCDnsResolverVpcRemoteAccount          = "account"
 	isVPCDnsResolverVpcRemoteRegion           = "region"
+	isVPCDnsResolverDnsBindingID              = "dns_binding_id"
+	isVPCDnsResolverDnsBindingName            = "dns_binding_name"
 	isVPCNoSgAclRules                         = "no_sg_acl_rules"
 )
 
@@ -210,6 +221,17 @@ func ResourceIBMISVPC() *schema.Resource {
 										ConflictsWith: []string{"dns.0.resolver.0.vpc_id"},
 										Description:   "The VPC crn whose DNS resolver provides the DNS server addresses for this VPC.The VPC may be remote and therefore may not be directly retrievable.",
 									},
+									isVPCDnsResolverDnsBindingID: &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier of the DNS resolution binding created for the `delegated` resolver.",
+									},
+									isVPCDnsResolverDnsBindingName: &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "The name of the DNS resolution binding created when the VPC is created with a `delegated` resolver.",
+									},
 									"vpc_remote_account_id": &schema.Schema{
 										Type:        schema.TypeString,
 										Computed:    true,
@@ -371,6 +393,18 @@ func ResourceIBMISVPC() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of tags",
 			},
+			isVPCPropagateTagsToChildren: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Apply the tags of the VPC to its subnets, instances, volumes attached to its instances and load balancers",
+			},
+			isVPCUntaggedChildren: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the children of the VPC missing some of its tags while propagate_tags_to_children is set, they are tagged on the next update of the VPC",
+			},
 			isVPCAccessTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -434,6 +468,38 @@ func ResourceIBMISVPC() *schema.Resource {
 				},
 			},
 
+			isVPCCseSourceAddressByZone: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The cloud service endpoint source IP address of the VPC, by zone name",
+			},
+
+			isVPCSubnetCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of subnets in the VPC",
+			},
+
+			isVPCPublicGateways: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the public gateways attached to the subnets of the VPC",
+			},
+
+			isVPCPublicGatewayCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of public gateways attached to the subnets of the VPC",
+			},
+
+			isVPCFlowLogCollectorCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of flow log collectors targeting the VPC or a resource in the VPC",
+			},
+
 			isVPCSecurityGroupList: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -730,6 +796,12 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
+	if d.Get(isVPCPropagateTagsToChildren).(bool) {
+		err = propagateVPCTagsToChildren(sess, meta, *vpc.ID, new(schema.Set), d.Get(isVPCTags))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error on create of resource vpc (%s) propagating tags to children: %s", d.Id(), err)
+		}
+	}
 	if _, ok := d.GetOk(isVPCAccessTags); ok {
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
@@ -738,9 +810,220 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	if d.Get("dns.0.resolver.0.type").(string) == "delegated" {
+		err = vpcCreateDnsResolverDelegation(d, sess, *vpc.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vpcCreateDnsResolverDelegation binds a newly created VPC to the hub VPC in dns.0.resolver and then
+// delegates its DNS resolver to it, the resolver can't be created as delegated.
+func vpcCreateDnsResolverDelegation(d *schema.ResourceData, sess *vpcv1.VpcV1, id string) error {
+	hubVPCID := d.Get("dns.0.resolver.0.vpc_id").(string)
+	hubVPCCrn := d.Get("dns.0.resolver.0.vpc_crn").(string)
+	var hubVPC vpcv1.VPCIdentityIntf
+	resolverVPC := &vpcv1.VpcdnsResolverVPCPatch{}
+	if hubVPCID != "" && hubVPCID != "null" {
+		hubVPC = &vpcv1.VPCIdentityByID{
+			ID: &hubVPCID,
+		}
+		resolverVPC.ID = &hubVPCID
+	} else if hubVPCCrn != "" && hubVPCCrn != "null" {
+		hubVPC = &vpcv1.VPCIdentityByCRN{
+			CRN: &hubVPCCrn,
+		}
+		resolverVPC.CRN = &hubVPCCrn
+	} else {
+		return fmt.Errorf("[ERROR] Error creating VPC (%s) with a delegated DNS resolver: one of dns.0.resolver.0.vpc_id or dns.0.resolver.0.vpc_crn must be set", id)
+	}
+	vpcdnsResolutionBinding, err := createVPCDnsResolutionBinding(context.Background(), sess, id, d.Get("dns.0.resolver.0.dns_binding_name").(string), hubVPC)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating DNS resolution binding for VPC (%s): %s", id, err)
+	}
+
+	// recorded right away, so that the binding is deleted with the VPC even if the delegation fails
+	dnsList := make([]map[string]interface{}, 0)
+	currentDns := map[string]interface{}{}
+	currentResolverList := make([]map[string]interface{}, 0)
+	currentResolver := map[string]interface{}{}
+	currentResolver["vpc_id"] = hubVPCID
+	currentResolver["vpc_crn"] = hubVPCCrn
+	currentResolver[isVPCDnsResolverDnsBindingID] = *vpcdnsResolutionBinding.ID
+	currentResolver[isVPCDnsResolverDnsBindingName] = *vpcdnsResolutionBinding.Name
+	currentResolverList = append(currentResolverList, currentResolver)
+	currentDns["resolver"] = currentResolverList
+	dnsList = append(dnsList, currentDns)
+	if err = d.Set("dns", dnsList); err != nil {
+		return fmt.Errorf("[ERROR] Error setting dns: %s", err)
+	}
+
+	vpcPatchModel := &vpcv1.VPCPatch{
+		Dns: &vpcv1.VpcdnsPatch{
+			Resolver: &vpcv1.VpcdnsResolverPatch{
+				Type: core.StringPtr("delegated"),
+				VPC:  resolverVPC,
+			},
+		},
+	}
+	vpcPatch, err := vpcPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for VPCPatch: %s", err)
+	}
+	updateVpcOptions := &vpcv1.UpdateVPCOptions{
+		ID:       &id,
+		VPCPatch: vpcPatch,
+	}
+	_, response, err := sess.UpdateVPC(updateVpcOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error delegating DNS resolver of VPC (%s) : %s\n%s", id, err, response)
+	}
 	return nil
 }
 
+// isVPCChildrenCRNs returns the CRNs of the subnets, instances, volumes attached to the instances and load
+// balancers of the VPC.
+func isVPCChildrenCRNs(sess *vpcv1.VpcV1, vpcID string) ([]string, error) {
+	crns := []string{}
+	subnetIDs := map[string]bool{}
+
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetsOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			options.Start = &start
+		}
+		subnets, response, err := sess.ListSubnets(options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			subnetIDs[*subnet.ID] = true
+			crns = append(crns, *subnet.CRN)
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		options := &vpcv1.ListInstancesOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			options.Start = &start
+		}
+		instances, response, err := sess.ListInstances(options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching instances %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			crns = append(crns, *instance.CRN)
+			for _, volumeAttachment := range instance.VolumeAttachments {
+				if volumeAttachment.Volume != nil && volumeAttachment.Volume.CRN != nil {
+					crns = append(crns, *volumeAttachment.Volume.CRN)
+				}
+			}
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	// the load balancers of the VPC are found through the reserved IPs of its subnets
+	lbCRNs := map[string]bool{}
+	for subnetID := range subnetIDs {
+		start = ""
+		for {
+			options := &vpcv1.ListSubnetReservedIpsOptions{
+				SubnetID: core.StringPtr(subnetID),
+			}
+			if start != "" {
+				options.Start = &start
+			}
+			reservedIPs, response, err := sess.ListSubnetReservedIps(options)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error Fetching reserved IPs of subnet %s %s\n%s", subnetID, err, response)
+			}
+			for _, reservedIP := range reservedIPs.ReservedIps {
+				target, ok := reservedIP.Target.(*vpcv1.ReservedIPTarget)
+				if ok && target.ResourceType != nil && *target.ResourceType == "load_balancer" && target.CRN != nil && !lbCRNs[*target.CRN] {
+					lbCRNs[*target.CRN] = true
+					crns = append(crns, *target.CRN)
+				}
+			}
+			start = flex.GetNext(reservedIPs.Next)
+			if start == "" {
+				break
+			}
+		}
+	}
+
+	return crns, nil
+}
+
+func propagateVPCTagsToChildren(sess *vpcv1.VpcV1, meta interface{}, vpcID string, oldList, newList interface{}) error {
+	crns, err := isVPCChildrenCRNs(sess, vpcID)
+	if err != nil {
+		return err
+	}
+	return flex.UpdateGlobalTagsUsingCRNs(oldList, newList, meta, crns, isVPCUserTagType)
+}
+
+// isVPCUntaggedChildrenCRNs returns the CRNs of the children of the VPC missing some of the tags. The tags
+// of the children are looked up with one global search query per batch of children rather than one tag
+// call per child. Children not indexed by global search yet are skipped.
+func isVPCUntaggedChildrenCRNs(sess *vpcv1.VpcV1, meta interface{}, vpcID string, tags *schema.Set) ([]string, error) {
+	crns, err := isVPCChildrenCRNs(sess, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	untagged := []string{}
+	for start := 0; start < len(crns); start += 50 {
+		end := start + 50
+		if end > len(crns) {
+			end = len(crns)
+		}
+		queries := []string{}
+		for _, crn := range crns[start:end] {
+			queries = append(queries, fmt.Sprintf("crn:\"%s\"", crn))
+		}
+		options := &globalsearchv2.SearchOptions{}
+		options.SetQuery(strings.Join(queries, " OR "))
+		options.SetFields([]string{"crn", "tags"})
+		options.SetLimit(int64(end - start))
+		result, response, err := gsClient.Search(options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error querying the tags of the children of vpc (%s): %s\n%s", vpcID, err, response)
+		}
+		for _, item := range result.Items {
+			childTags := []string{}
+			if t, ok := item.GetProperty("tags").([]interface{}); ok {
+				for _, tag := range t {
+					childTags = append(childTags, fmt.Sprintf("%s", tag))
+				}
+			}
+			if tags.Difference(flex.NewStringSet(flex.ResourceIBMVPCHash, childTags)).Len() > 0 {
+				untagged = append(untagged, *item.CRN)
+			}
+		}
+	}
+	sort.Strings(untagged)
+	return untagged, nil
+}
+
 func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPC (%s) to be available.", id)
 
@@ -920,6 +1203,9 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		if err != nil {
 			return err
 		}
+		resolverMap := dnsMap["resolver"].([]map[string]interface{})[0]
+		resolverMap[isVPCDnsResolverDnsBindingID] = d.Get("dns.0.resolver.0.dns_binding_id").(string)
+		resolverMap[isVPCDnsResolverDnsBindingName] = d.Get("dns.0.resolver.0.dns_binding_name").(string)
 		if err = d.Set(isVPCDns, []map[string]interface{}{dnsMap}); err != nil {
 			return fmt.Errorf("[ERROR] Error setting dns: %s", err)
 		}
@@ -930,6 +1216,19 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			"Error on get of resource vpc (%s) tags: %s", d.Id(), err)
 	}
 	d.Set(isVPCTags, tags)
+	untagged := []string{}
+	if d.Get(isVPCPropagateTagsToChildren).(bool) && tags != nil && tags.Len() > 0 {
+		// children out of sync, e.g. created after the VPC, are tagged on the next update of the VPC
+		untagged, err = isVPCUntaggedChildrenCRNs(sess, meta, *vpc.ID, tags)
+		if err != nil {
+			log.Printf(
+				"Error on get of resource vpc (%s) children tags: %s", d.Id(), err)
+			untagged = flex.ExpandStringList(d.Get(isVPCUntaggedChildren).([]interface{}))
+		}
+	}
+	if err = d.Set(isVPCUntaggedChildren, untagged); err != nil {
+		return fmt.Errorf("[ERROR] Error setting untagged_children: %s", err)
+	}
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *vpc.CRN, "", isVPCAccessTagType)
 	if err != nil {
 		log.Printf(
@@ -953,15 +1252,18 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 	//set the cse ip addresses info
 	if vpc.CseSourceIps != nil {
 		cseSourceIpsList := make([]map[string]interface{}, 0)
+		cseSourceIpByZone := map[string]string{}
 		for _, sourceIP := range vpc.CseSourceIps {
 			currentCseSourceIp := map[string]interface{}{}
 			if sourceIP.IP != nil {
 				currentCseSourceIp[isVPCDnsResolverManualServersAddress] = *sourceIP.IP.Address
 				currentCseSourceIp["zone_name"] = *sourceIP.Zone.Name
 				cseSourceIpsList = append(cseSourceIpsList, currentCseSourceIp)
+				cseSourceIpByZone[*sourceIP.Zone.Name] = *sourceIP.IP.Address
 			}
 		}
 		d.Set(cseSourceAddresses, cseSourceIpsList)
+		d.Set(isVPCCseSourceAddressByZone, cseSourceIpByZone)
 	}
 	// set the subnets list
 	start := ""
@@ -982,8 +1284,12 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 	}
 	subnetsInfo := make([]map[string]interface{}, 0)
+	publicGateways := map[string]bool{}
 	for _, subnet := range allrecs {
 		if *subnet.VPC.ID == d.Id() {
+			if subnet.PublicGateway != nil {
+				publicGateways[*subnet.PublicGateway.ID] = true
+			}
 			l := map[string]interface{}{
 				"name":                    *subnet.Name,
 				"id":                      *subnet.ID,
@@ -996,6 +1302,26 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 	}
 	d.Set(subnetsList, subnetsInfo)
+	d.Set(isVPCSubnetCount, len(subnetsInfo))
+	publicGatewayIDs := make([]string, 0, len(publicGateways))
+	for id := range publicGateways {
+		publicGatewayIDs = append(publicGatewayIDs, id)
+	}
+	sort.Strings(publicGatewayIDs)
+	d.Set(isVPCPublicGateways, publicGatewayIDs)
+	d.Set(isVPCPublicGatewayCount, len(publicGatewayIDs))
+
+	// only the total count of the collection is needed, it is left unset without permission to list flow logs
+	flowLogCollectors, response, err := sess.ListFlowLogCollectors(&vpcv1.ListFlowLogCollectorsOptions{
+		VPCID: vpc.ID,
+		Limit: core.Int64Ptr(1),
+	})
+	if err != nil {
+		log.Printf(
+			"Error on get of resource vpc (%s) flow log collectors: %s\n%s", d.Id(), err, response)
+	} else {
+		d.Set(isVPCFlowLogCollectorCount, *flowLogCollectors.TotalCount)
+	}
 
 	//Set Security group list
 	vpcid := d.Id()
@@ -1142,6 +1468,17 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		return err
 	}
 
+	if d.Get(isVPCPropagateTagsToChildren).(bool) {
+		// every update attaches the tags to the current children, including the ones created after the VPC
+		oldList, newList := d.GetChange(isVPCTags)
+		if !d.HasChange(isVPCTags) || d.HasChange(isVPCPropagateTagsToChildren) {
+			oldList = new(schema.Set)
+		}
+		err = propagateVPCTagsToChildren(sess, meta, id, oldList, newList)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error on update of resource vpc (%s) propagating tags to children: %s", d.Id(), err)
+		}
+	}
 	if d.HasChange(isVPCTags) {
 		getvpcOptions := &vpcv1.GetVPCOptions{
 			ID: &id,
@@ -1366,6 +1703,39 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		return fmt.Errorf("[ERROR] Error Getting VPC (%s): %s\n%s", id, err, response)
 	}
 
+	if bindingID := d.Get("dns.0.resolver.0.dns_binding_id").(string); bindingID != "" {
+		// the binding created along with the VPC can only be removed once the resolver is no longer delegated
+		if d.Get("dns.0.resolver.0.type").(string) == "delegated" {
+			vpcPatch := map[string]interface{}{
+				"dns": map[string]interface{}{
+					"resolver": map[string]interface{}{
+						"type": "system",
+						"vpc":  nil,
+					},
+				},
+			}
+			updateVpcOptions := &vpcv1.UpdateVPCOptions{
+				ID:       &id,
+				VPCPatch: vpcPatch,
+			}
+			_, response, err = sess.UpdateVPC(updateVpcOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Updating VPC (%s) DNS resolver to system : %s\n%s", id, err, response)
+			}
+		}
+		deleteVPCDnsResolutionBindingOptions := &vpcv1.DeleteVPCDnsResolutionBindingOptions{}
+		deleteVPCDnsResolutionBindingOptions.SetVPCID(id)
+		deleteVPCDnsResolutionBindingOptions.SetID(bindingID)
+		response, err = sess.DeleteVPCDnsResolutionBinding(deleteVPCDnsResolutionBindingOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Deleting DNS resolution binding (%s) of VPC (%s) : %s\n%s", bindingID, id, err, response)
+		}
+		_, err = isWaitForVPCDnsResolutionBindingDeleted(sess, id, bindingID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
 		ID: &id,
 	}
@@ -1396,6 +1766,39 @@ func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (in
 	return stateConf.WaitForState()
 }
 
+func isWaitForVPCDnsResolutionBindingDeleted(vpc *vpcv1.VpcV1, vpcID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for DNS resolution binding (%s) of VPC (%s) to be deleted.", id, vpcID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", isVPCDeleting},
+		Target:     []string{isVPCDeleted, isVPCFailed},
+		Refresh:    isVPCDnsResolutionBindingDeleteRefreshFunc(vpc, vpcID, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isVPCDnsResolutionBindingDeleteRefreshFunc(vpc *vpcv1.VpcV1, vpcID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getVPCDnsResolutionBindingOptions := &vpcv1.GetVPCDnsResolutionBindingOptions{
+			VPCID: &vpcID,
+			ID:    &id,
+		}
+		binding, response, err := vpc.GetVPCDnsResolutionBinding(getVPCDnsResolutionBindingOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return binding, isVPCDeleted, nil
+			}
+			return nil, isVPCFailed, fmt.Errorf("[ERROR] The DNS resolution binding %s failed to delete: %s\n%s", id, err, response)
+		}
+
+		return binding, isVPCDeleting, nil
+	}
+}
+
 func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] is vpc delete function here")
@@ -1542,7 +1945,8 @@ func resourceIBMIsVPCMapToVpcdnsPrototype(modelMap map[string]interface{}) (*vpc
 
 func resourceIBMIsVPCMapToVpcdnsResolverPrototype(modelMap map[string]interface{}) (vpcv1.VpcdnsResolverPrototypeIntf, error) {
 	model := &vpcv1.VpcdnsResolverPrototype{}
-	if modelMap["type"] != nil && modelMap["type"].(string) != "" {
+	// a delegated resolver is configured once the VPC exists, see vpcCreateDnsResolverDelegation
+	if modelMap["type"] != nil && modelMap["type"].(string) != "" && modelMap["type"].(string) != "delegated" {
 		model.Type = core.StringPtr(modelMap["type"].(string))
 	}
 	if modelMap["manual_servers"] != nil && modelMap["manual_servers"].(*schema.Set).Len() > 0 {
@@ -237,35 +237,27 @@ func resourceIBMIsVPCDnsResolutionBindingCreate(context context.Context, d *sche
 		return diag.FromErr(err)
 	}
 	spokeVPCID := d.Get("vpc_id").(string)
-	createVPCDnsResolutionBindingOptions := &vpcv1.CreateVPCDnsResolutionBindingOptions{}
 	vpchref := d.Get("vpc.0.href").(string)
 	vpccrn := d.Get("vpc.0.crn").(string)
 	vpcid := d.Get("vpc.0.id").(string)
 
-	createVPCDnsResolutionBindingOptions.SetVPCID(spokeVPCID)
-	if d.Get("name").(string) != "" {
-		createVPCDnsResolutionBindingOptions.SetName(d.Get("name").(string))
-	}
+	var vPCIdentityIntf vpcv1.VPCIdentityIntf
 	if vpchref != "" {
-		vPCIdentityIntf := &vpcv1.VPCIdentityByHref{
+		vPCIdentityIntf = &vpcv1.VPCIdentityByHref{
 			Href: &vpchref,
 		}
-		createVPCDnsResolutionBindingOptions.SetVPC(vPCIdentityIntf)
 	} else if vpcid != "" {
-		vPCIdentityIntf := &vpcv1.VPCIdentityByID{
+		vPCIdentityIntf = &vpcv1.VPCIdentityByID{
 			ID: &vpcid,
 		}
-		createVPCDnsResolutionBindingOptions.SetVPC(vPCIdentityIntf)
 	} else {
-		vPCIdentityIntf := &vpcv1.VPCIdentityByCRN{
+		vPCIdentityIntf = &vpcv1.VPCIdentityByCRN{
 			CRN: &vpccrn,
 		}
-		createVPCDnsResolutionBindingOptions.SetVPC(vPCIdentityIntf)
 	}
-	vpcdnsResolutionBinding, response, err := sess.CreateVPCDnsResolutionBindingWithContext(context, createVPCDnsResolutionBindingOptions)
+	vpcdnsResolutionBinding, err := createVPCDnsResolutionBinding(context, sess, spokeVPCID, d.Get("name").(string), vPCIdentityIntf)
 	if err != nil {
-		log.Printf("[DEBUG] CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response))
+		return diag.FromErr(err)
 	}
 	d.SetId(MakeTerraformVPCDNSID(spokeVPCID, *vpcdnsResolutionBinding.ID))
 
@@ -275,6 +267,24 @@ func resourceIBMIsVPCDnsResolutionBindingCreate(context context.Context, d *sche
 	}
 	return nil
 }
+
+// createVPCDnsResolutionBinding binds the spoke VPC to the hub VPC, it is shared with the delegated
+// resolver of ibm_is_vpc.
+func createVPCDnsResolutionBinding(context context.Context, sess *vpcv1.VpcV1, spokeVPCID, name string, hubVPC vpcv1.VPCIdentityIntf) (*vpcv1.VpcdnsResolutionBinding, error) {
+	createVPCDnsResolutionBindingOptions := &vpcv1.CreateVPCDnsResolutionBindingOptions{}
+	createVPCDnsResolutionBindingOptions.SetVPCID(spokeVPCID)
+	if name != "" {
+		createVPCDnsResolutionBindingOptions.SetName(name)
+	}
+	createVPCDnsResolutionBindingOptions.SetVPC(hubVPC)
+	vpcdnsResolutionBinding, response, err := sess.CreateVPCDnsResolutionBindingWithContext(context, createVPCDnsResolutionBindingOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("CreateVPCDnsResolutionBindingWithContext failed %s\n%s", err, response)
+	}
+	return vpcdnsResolutionBinding, nil
+}
+
 func resourceIBMIsVPCDnsResolutionBindingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -4,11 +4,13 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -34,6 +36,7 @@ const (
 	rtNextHop                    = "next_hop"
 	rtZone                       = "zone"
 	rtOrigin                     = "origin"
+	rtAdvertiseRoutesTo          = "advertise_routes_to"
 )
 
 func ResourceIBMISVPCRoutingTable() *schema.Resource {
@@ -58,13 +61,27 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				Description: "The VPC identifier.",
 			},
 			"accept_routes_from_resource_type": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"vpn_gateway", "vpn_server"}),
+				},
 				Set:         schema.HashString,
 				Description: "The filters specifying the resources that may create routes in this routing table, The resource type: vpn_gateway or vpn_server",
 			},
+			rtAdvertiseRoutesTo: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"direct_link", "transit_gateway"}),
+				},
+				Set:         schema.HashString,
+				Description: "The ingress sources to advertise the routes of this routing table with advertise set to true to: direct_link or transit_gateway. The routing table must have the matching route_direct_link_ingress or route_transit_gateway_ingress set to true.",
+			},
 			rtRouteDirectLinkIngress: {
 				Type:        schema.TypeBool,
 				ForceNew:    false,
@@ -230,6 +247,18 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
 
+	// advertise_routes_to isn't part of the routing table prototype of the vpc-go-sdk yet, it's patched after the create
+	if advertiseRoutesTo, ok := d.GetOk(rtAdvertiseRoutesTo); ok && advertiseRoutesTo.(*schema.Set).Len() > 0 {
+		updateVpcRoutingTableOptions := sess.NewUpdateVPCRoutingTableOptions(vpcID, *routeTable.ID, map[string]interface{}{
+			rtAdvertiseRoutesTo: flex.ExpandStringList(advertiseRoutesTo.(*schema.Set).List()),
+		})
+		_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Update VPC Routing table advertise_routes_to err %s\n%s", err, response)
+			return err
+		}
+	}
+
 	return resourceIBMISVPCRoutingTableRead(d, meta)
 }
 
@@ -269,6 +298,13 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 	if err = d.Set("accept_routes_from_resource_type", acceptRoutesFromArray); err != nil {
 		return fmt.Errorf("[ERROR] Error setting accept_routes_from_resource_type: %s", err)
 	}
+	advertiseRoutesTo, response, err := getVPCRoutingTableAdvertiseRoutesTo(sess, idSet[0], idSet[1])
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting VPC Routing table advertise_routes_to: %s\n%s", err, response)
+	}
+	if err = d.Set(rtAdvertiseRoutesTo, advertiseRoutesTo); err != nil {
+		return fmt.Errorf("[ERROR] Error setting advertise_routes_to: %s", err)
+	}
 	subnets := make([]map[string]interface{}, 0)
 
 	for _, s := range routeTable.Subnets {
@@ -328,6 +364,7 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange(rtRouteInternetIngress) {
 		rtRouteInternetIngress := d.Get(rtRouteInternetIngress).(bool)
 		routingTablePatchModel.RouteInternetIngress = core.BoolPtr(rtRouteInternetIngress)
+		hasChange = true
 	}
 	if d.HasChange(rtRouteTransitGatewayIngress) {
 		routeTransitGatewayIngress := d.Get(rtRouteTransitGatewayIngress).(bool)
@@ -347,6 +384,10 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 	if asPatchErr != nil {
 		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
 	}
+	if d.HasChange(rtAdvertiseRoutesTo) {
+		routingTablePatchModelAsPatch[rtAdvertiseRoutesTo] = flex.ExpandStringList(d.Get(rtAdvertiseRoutesTo).(*schema.Set).List())
+		updateVpcRoutingTableOptions.IfMatch = &eTag
+	}
 
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
@@ -397,3 +438,17 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 	}
 	return true, nil
 }
+
+// getVPCRoutingTableAdvertiseRoutesTo returns the ingress sources the routing table advertises its routes to,
+// which the vpc-go-sdk doesn't decode yet.
+func getVPCRoutingTableAdvertiseRoutesTo(sess *vpcv1.VpcV1, vpcID, id string) ([]string, *core.DetailedResponse, error) {
+	routingTable := &struct {
+		AdvertiseRoutesTo []string `json:"advertise_routes_to"`
+	}{}
+	response, err := vpcRequest(context.TODO(), sess, core.GET, `/vpcs/{vpc_id}/routing_tables/{id}`,
+		map[string]string{"vpc_id": vpcID, "id": id}, nil, routingTable)
+	if err != nil {
+		return nil, response, err
+	}
+	return routingTable.AdvertiseRoutesTo, response, nil
+}
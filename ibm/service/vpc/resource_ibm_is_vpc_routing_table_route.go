@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -23,6 +24,7 @@ const (
 	rNextHop     = "next_hop"
 	rName        = "name"
 	rZone        = "zone"
+	rAdvertise   = "advertise"
 )
 
 func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
@@ -68,7 +70,13 @@ func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
 			rNextHop: {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "If action is deliver, the next hop that packets will be delivered to. For other action values, its address will be 0.0.0.0.",
+				Description: "If action is deliver, the next hop that packets will be delivered to, an IP address, for example the primary IP of a virtual network interface, or the ID of a VPN gateway connection. For other action values, its address will be 0.0.0.0.",
+			},
+			rAdvertise: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether this route will be advertised to the ingress sources specified by the advertise_routes_to routing table property.",
 			},
 			rAction: {
 				Type:         schema.TypeString,
@@ -261,6 +269,18 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID))
 	d.Set(rID, *route.ID)
+
+	// advertise isn't part of the route prototype of the vpc-go-sdk yet, it's patched after the create
+	if d.Get(rAdvertise).(bool) {
+		updateVpcRoutingTableRouteOptions := sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, tableID, *route.ID, map[string]interface{}{
+			rAdvertise: true,
+		})
+		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Update VPC Routing table route advertise err %s\n%s", err, response)
+			return err
+		}
+	}
 	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
 }
 
@@ -313,6 +333,11 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("creator", creator)
 	d.Set("priority", route.Priority)
+	advertise, response, err := getVPCRoutingTableRouteAdvertise(sess, idSet[0], idSet[1], idSet[2])
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting VPC Routing table route advertise: %s\n%s", err, response)
+	}
+	d.Set(rAdvertise, advertise)
 	return nil
 }
 
@@ -357,11 +382,17 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 			}
 		}
 	}
+	if d.HasChange(rAdvertise) {
+		hasChange = true
+	}
 	if hasChange {
 		routePatchModelAsPatch, patchErr := routePatchModel.AsPatch()
 		if patchErr != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr)
 		}
+		if d.HasChange(rAdvertise) {
+			routePatchModelAsPatch[rAdvertise] = d.Get(rAdvertise).(bool)
+		}
 		updateVpcRoutingTableRouteOptions.RoutePatch = routePatchModelAsPatch
 		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
 		if err != nil {
@@ -411,3 +442,17 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 	}
 	return true, nil
 }
+
+// getVPCRoutingTableRouteAdvertise returns whether the route is advertised to the ingress sources of the
+// routing table, which the vpc-go-sdk doesn't decode yet.
+func getVPCRoutingTableRouteAdvertise(sess *vpcv1.VpcV1, vpcID, routingTableID, id string) (bool, *core.DetailedResponse, error) {
+	route := &struct {
+		Advertise *bool `json:"advertise"`
+	}{}
+	response, err := vpcRequest(context.TODO(), sess, core.GET, `/vpcs/{vpc_id}/routing_tables/{routing_table_id}/routes/{id}`,
+		map[string]string{"vpc_id": vpcID, "routing_table_id": routingTableID, "id": id}, nil, route)
+	if err != nil {
+		return false, response, err
+	}
+	return route.Advertise != nil && *route.Advertise, response, nil
+}
@@ -50,6 +50,38 @@ func TestAccIBMISVPCRoutingTableRoute_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPCRoutingTableRoute_advertise(t *testing.T) {
+	var vpcRouteTables string
+	name := fmt.Sprintf("tfvpcuat-create-%d", acctest.RandIntRange(10, 100))
+	routeName := fmt.Sprintf("tfvpcuat-create-%d", acctest.RandIntRange(10, 100))
+	routeTableName := fmt.Sprintf("tfvpcrt-create-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRouteTableRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRouteTableRouteAdvertiseConfig(routeTableName, name, routeName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRouteTableRouteExists("ibm_is_vpc_routing_table_route.test_custom_route1", vpcRouteTables),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "advertise_routes_to.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_route.test_custom_route1", "advertise", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRouteTableRouteAdvertiseConfig(routeTableName, name, routeName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_route.test_custom_route1", "advertise", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVPCRouteTableRouteDestroy(s *terraform.State) error {
 	//userDetails, _ := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
 
@@ -144,3 +176,27 @@ resource "ibm_is_vpc_routing_table_route" "test_custom_route1" {
 }
 `, name, rtName, subnetName, acc.ISZoneName, acc.ISCIDR, routeName, acc.ISZoneName, acc.ISRouteNextHop)
 }
+
+func testAccCheckIBMISVPCRouteTableRouteAdvertiseConfig(rtName, name, routeName string, advertise bool) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+    name = "%s"
+}
+resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
+	vpc = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+	route_direct_link_ingress = true
+	route_transit_gateway_ingress = true
+	advertise_routes_to = ["direct_link", "transit_gateway"]
+}
+resource "ibm_is_vpc_routing_table_route" "test_custom_route1" {
+  vpc = ibm_is_vpc.testacc_vpc.id
+  routing_table = ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table.routing_table
+  name = "%s"
+  zone = "%s"
+  next_hop = "%s"
+  destination = "%s"
+  advertise = %t
+}
+`, name, rtName, routeName, acc.ISZoneName, acc.ISRouteNextHop, acc.ISRouteDestination, advertise)
+}
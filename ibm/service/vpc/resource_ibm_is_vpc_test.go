@@ -299,6 +299,40 @@ func TestAccIBMISVPC_dns_delegated(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPC_dns_delegated_on_create(t *testing.T) {
+	var vpc string
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	name2 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	subnet1 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
+	subnet2 := fmt.Sprintf("terraformsubnet-%d", acctest.RandIntRange(10, 100))
+	resourecinstance := fmt.Sprintf("terraformresource-%d", acctest.RandIntRange(10, 100))
+	resolver := fmt.Sprintf("terraformresolver-%d", acctest.RandIntRange(10, 100))
+	binding := fmt.Sprintf("terraformbinding-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDnsDelegatedOnCreateConfig(name1, name2, subnet1, subnet2, resourecinstance, resolver, binding),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.hub_false_delegated", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.hub_true", "dns.0.resolution_binding_count", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.hub_false_delegated", "dns.0.resolver.0.type", "delegated"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc.hub_false_delegated", "dns.0.resolver.0.vpc_id", "ibm_is_vpc.hub_true", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.hub_false_delegated", "dns.0.resolver.0.dns_binding_name", binding),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpc.hub_false_delegated", "dns.0.resolver.0.dns_binding_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISVPC_basic_apm(t *testing.T) {
 	var vpc string
 	name := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
@@ -381,6 +415,78 @@ func TestAccIBMISVPC_noSGACLRules(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPC_propagateTagsToChildren(t *testing.T) {
+	var vpc string
+	vpcname := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("terraformsubnetuat-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the subnet is created after the vpc, it is tagged on the next update of the vpc
+				Config: testAccCheckIBMISVPCPropagateTagsConfig(vpcname, subnetname, `["cost-center:1234", "env:test"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "propagate_tags_to_children", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCPropagateTagsConfig(vpcname, subnetname, `["cost-center:1234", "env:test", "owner:tf"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "tags.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCPropagateTagsConfig(vpcname, subnetname, `["cost-center:1234", "env:test", "owner:tf"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_subnet.testacc_subnet", "tags.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISVPC_topologySummary(t *testing.T) {
+	var vpc string
+	vpcname := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("terraformsubnetuat-%d", acctest.RandIntRange(10, 100))
+	gatewayname := fmt.Sprintf("terraformpgwuat-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCTopologySummaryConfig(vpcname, subnetname, gatewayname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpc.testacc_vpc", fmt.Sprintf("cse_source_address_by_zone.%s", acc.ISZoneName)),
+				),
+			},
+			{
+				// the subnet and the public gateway are created after the vpc, they are read on refresh
+				Config: testAccCheckIBMISVPCTopologySummaryConfig(vpcname, subnetname, gatewayname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "subnet_count", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "public_gateway_count", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc.testacc_vpc", "public_gateways.0", "ibm_is_public_gateway.testacc_gateway", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc.testacc_vpc", "flow_log_collector_count", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVPCDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -597,6 +703,70 @@ func testAccCheckIBMISVPCDnsDelegatedConfig(vpcname, vpcname2, subnetname1, subn
 	
 	`, vpcname, enableHub, vpcname2, enablehubfalse, subnetname1, acc.ISZoneName, subnetname2, acc.ISZoneName, subnetname3, acc.ISZoneName, subnetname4, acc.ISZoneName, resourceinstance, resolver1, resolver2, bindingname)
 
+}
+func testAccCheckIBMISVPCDnsDelegatedOnCreateConfig(vpcname, vpcname2, subnetname1, subnetname2, resourceinstance, resolver, bindingname string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default	   =  true
+	}
+	
+	resource ibm_is_vpc hub_true {
+		name = "%s"
+		dns {
+			enable_hub = true
+		}
+	}
+	
+	resource ibm_is_vpc hub_false_delegated {
+		depends_on = [ibm_dns_custom_resolver.test_hub_true]
+		name = "%s"
+		dns {
+			enable_hub = false
+			resolver {
+				type = "delegated"
+				vpc_id = ibm_is_vpc.hub_true.id
+				dns_binding_name = "%s"
+			}
+		}
+	}
+	
+	resource "ibm_is_subnet" "hub_true_sub1" {
+		name		   				=  "%s"
+		vpc      	   				=  ibm_is_vpc.hub_true.id
+		zone		   				=  "%s"
+		total_ipv4_address_count 	= 16
+	}
+	resource "ibm_is_subnet" "hub_true_sub2" {
+		name		   				=  "%s"
+		vpc      	   				=  ibm_is_vpc.hub_true.id
+		zone		   				=  "%s"
+		total_ipv4_address_count 	= 16
+	}
+	resource "ibm_resource_instance" "dns-cr-instance" {
+		name		   		=  "%s"
+		resource_group_id  	=  data.ibm_resource_group.rg.id
+		location           	=  "global"
+		service		   		=  "dns-svcs"
+		plan		   		=  "standard-dns"
+	}
+	resource "ibm_dns_custom_resolver" "test_hub_true" {
+		name		   		=  "%s"
+		instance_id 	   	=  ibm_resource_instance.dns-cr-instance.guid
+		description	   		=  "new test CR - TF"
+		high_availability  	=  true
+		enabled 	   		=  true
+		locations {
+				subnet_crn  = ibm_is_subnet.hub_true_sub1.crn
+				enabled	 = true
+		}
+		locations {
+				subnet_crn  = ibm_is_subnet.hub_true_sub2.crn
+				enabled	 = true
+		}
+	}
+	
+	`, vpcname, vpcname2, bindingname, subnetname1, acc.ISZoneName, subnetname2, acc.ISZoneName, resourceinstance, resolver)
+
 }
 func testAccCheckIBMISVPCDnsDelegatedUpdate1Config(vpcname, vpcname2, subnetname1, subnetname2, subnetname3, subnetname4, resourceinstance, resolver1, resolver2, bindingname string, enableHub, enablehubfalse bool) string {
 	return fmt.Sprintf(`
@@ -852,3 +1022,44 @@ func testAccCheckIBMISVPCNoSgAclRulesConfig(vpcname string) string {
 `, vpcname)
 
 }
+
+func testAccCheckIBMISVPCPropagateTagsConfig(vpcname, subnetname, tags string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name                       = "%s"
+		tags                       = %s
+		propagate_tags_to_children = true
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+`, vpcname, tags, subnetname, acc.ISZoneName)
+
+}
+
+func testAccCheckIBMISVPCTopologySummaryConfig(vpcname, subnetname, gatewayname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_public_gateway" "testacc_gateway" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+		public_gateway           = ibm_is_public_gateway.testacc_gateway.id
+	}
+`, vpcname, gatewayname, acc.ISZoneName, subnetname, acc.ISZoneName)
+
+}
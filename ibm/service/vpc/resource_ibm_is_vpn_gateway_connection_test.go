@@ -102,6 +102,77 @@ func TestAccIBMISVPNGatewayConnection_route(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPNGatewayConnection_establishMode(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(100, 200))
+	vpnname := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionEstablishModeConfig(vpcname, subnetname, vpnname, name, "peer_only", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "establish_mode", "peer_only"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "distribute_traffic", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionEstablishModeConfig(vpcname, subnetname, vpnname, name, "bidirectional", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "establish_mode", "bidirectional"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "distribute_traffic", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISVPNGatewayConnection_cidrsUpdate(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnname := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionCIDRsConfig(vpcname, subnetname, vpnname, name, `["10.45.0.0/24"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer_cidrs.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionCIDRsConfig(vpcname, subnetname, vpnname, name, `["10.46.0.0/24", "10.47.0.0/24"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer_cidrs.*", "10.46.0.0/24"),
+					resource.TestCheckTypeSetElemAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer_cidrs.*", "10.47.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVPNGatewayConnectionDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -486,3 +557,60 @@ func testAccCheckIBMISVPNGatewayConnectionNullPatchConfig(vpc, subnet, vpnname,
 	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, ikepolicyname, ipsecpolicyname, name, noNullPass, noNullPass)
 
 }
+
+func testAccCheckIBMISVPNGatewayConnectionEstablishModeConfig(vpc, subnet, vpnname, name, establishMode string, distributeTraffic bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc1" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet1" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc1.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway1" {
+		name = "%s"
+		subnet = "${ibm_is_subnet.testacc_subnet1.id}"
+		mode = "route"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection1" {
+		name = "%s"
+		vpn_gateway = "${ibm_is_vpn_gateway.testacc_VPNGateway1.id}"
+		peer_address = "${ibm_is_vpn_gateway.testacc_VPNGateway1.public_ip_address}"
+		preshared_key = "VPNDemoPassword"
+		establish_mode = "%s"
+		distribute_traffic = %t
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, name, establishMode, distributeTraffic)
+}
+
+func testAccCheckIBMISVPNGatewayConnectionCIDRsConfig(vpc, subnet, vpnname, name, peerCIDRs string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		mode = "policy"
+	}
+
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway.id
+		peer_address = "1.2.3.4"
+		preshared_key = "VPNDemoPassword"
+		local_cidrs = [ibm_is_subnet.testacc_subnet.ipv4_cidr_block]
+		peer_cidrs = %s
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, name, peerCIDRs)
+}
@@ -4,12 +4,15 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,6 +43,8 @@ const (
 	isVPNGatewayConnectionResourcetype              = "resource_type"
 	isVPNGatewayConnectionCreatedat                 = "created_at"
 	isVPNGatewayConnectionStatusreasons             = "status_reasons"
+	isVPNGatewayConnectionEstablishMode             = "establish_mode"
+	isVPNGatewayConnectionDistributeTraffic         = "distribute_traffic"
 )
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
@@ -93,19 +98,17 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			isVPNGatewayConnectionLocalCIDRS: {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				ForceNew:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Set:         schema.HashString,
-				Description: "VPN gateway connection local CIDRs",
+				Description: "VPN gateway connection local CIDRs, updated in place",
 			},
 
 			isVPNGatewayConnectionPeerCIDRS: {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				ForceNew:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Set:         schema.HashString,
-				Description: "VPN gateway connection peer CIDRs",
+				Description: "VPN gateway connection peer CIDRs, updated in place",
 			},
 
 			isVPNGatewayConnectionDeadPeerDetectionAction: {
@@ -142,6 +145,21 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 				Description: "VPN gateway connection IKE Policy",
 			},
 
+			isVPNGatewayConnectionEstablishMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionEstablishMode),
+				Description:  "The establish mode of the VPN gateway connection, bidirectional or peer_only",
+			},
+
+			isVPNGatewayConnectionDistributeTraffic: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the traffic is distributed between the up tunnels of a route based VPN gateway connection",
+			},
+
 			isVPNGatewayConnection: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -224,6 +242,31 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 							Computed:    true,
 							Description: "The status of the VPN Tunnel",
 						},
+
+						isVPNGatewayConnectionStatusreasons: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The reasons for the current status of the VPN Tunnel (if any).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A snake case string succinctly identifying the status reason.",
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "An explanation of the reason for this VPN gateway connection tunnel's status.",
+									},
+									"more_info": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Link to documentation about this status reason.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -266,6 +309,13 @@ func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeInt,
 			MinValue:                   "2",
 			MaxValue:                   "86399"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNGatewayConnectionEstablishMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "bidirectional, peer_only"})
 
 	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema}
 	return &ibmISVPNGatewayConnectionResourceValidator
@@ -326,10 +376,6 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		},
 		Name: &name,
 	}
-	options := &vpcv1.CreateVPNGatewayConnectionOptions{
-		VPNGatewayID:                  &gatewayID,
-		VPNGatewayConnectionPrototype: vpnGatewayConnectionPrototypeModel,
-	}
 
 	if _, ok := d.GetOk(isVPNGatewayConnectionLocalCIDRS); ok {
 		localCidrs := flex.ExpandStringList((d.Get(isVPNGatewayConnectionLocalCIDRS).(*schema.Set)).List())
@@ -359,16 +405,39 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		vpnGatewayConnectionPrototypeModel.IpsecPolicy = nil
 	}
 
-	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
+	// The prototype of the SDK has no establish mode or traffic distribution. They are sent with
+	// the rest of the connection, a peer_only connection must never start IKE negotiation itself.
+	connectionOptions := vpnGatewayConnectionOptions{}
+	if establishMode, ok := d.GetOk(isVPNGatewayConnectionEstablishMode); ok {
+		connectionOptions.EstablishMode = core.StringPtr(establishMode.(string))
+	}
+	if distributeTraffic, ok := d.GetOkExists(isVPNGatewayConnectionDistributeTraffic); ok {
+		connectionOptions.DistributeTraffic = core.BoolPtr(distributeTraffic.(bool))
+	}
+	body := struct {
+		*vpcv1.VPNGatewayConnectionPrototype
+		vpnGatewayConnectionOptions
+	}{vpnGatewayConnectionPrototypeModel, connectionOptions}
+
+	vpnGatewayConnection := struct {
+		ID *string `json:"id"`
+	}{}
+	response, err := vpcRequest(context.Background(), sess, core.POST, `/vpn_gateways/{vpn_gateway_id}/connections`,
+		map[string]string{"vpn_gateway_id": gatewayID}, body, &vpnGatewayConnection)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
 	}
-	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
 	log.Printf("[INFO] VPNGatewayConnection : %s/%s", gatewayID, *vpnGatewayConnection.ID)
 	return nil
 }
 
+// vpnGatewayConnectionOptions holds the connection settings the vpc-go-sdk doesn't read yet.
+type vpnGatewayConnectionOptions struct {
+	EstablishMode     *string `json:"establish_mode,omitempty"`
+	DistributeTraffic *bool   `json:"distribute_traffic,omitempty"`
+}
+
 func resourceIBMISVPNGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
 
 	parts, err := flex.IdParts(d.Id())
@@ -453,6 +522,18 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 			if vpcTunnel.Status != nil {
 				currentTunnel["status"] = *vpcTunnel.Status
 			}
+			statusReasons := make([]map[string]interface{}, 0, len(vpcTunnel.StatusReasons))
+			for _, statusReason := range vpcTunnel.StatusReasons {
+				reason := map[string]interface{}{
+					"code":    *statusReason.Code,
+					"message": *statusReason.Message,
+				}
+				if statusReason.MoreInfo != nil {
+					reason["more_info"] = *statusReason.MoreInfo
+				}
+				statusReasons = append(statusReasons, reason)
+			}
+			currentTunnel[isVPNGatewayConnectionStatusreasons] = statusReasons
 			vpcTunnelsList = append(vpcTunnelsList, currentTunnel)
 		}
 	}
@@ -470,6 +551,19 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	vpngateway := vpngatewayIntf.(*vpcv1.VPNGateway)
 	d.Set(flex.RelatedCRN, *vpngateway.CRN)
+
+	connectionOptions := &vpnGatewayConnectionOptions{}
+	response, err = vpcRequest(context.Background(), sess, core.GET, `/vpn_gateways/{vpn_gateway_id}/connections/{id}`,
+		map[string]string{"vpn_gateway_id": gID, "id": gConnID}, nil, connectionOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+	}
+	if connectionOptions.EstablishMode != nil {
+		d.Set(isVPNGatewayConnectionEstablishMode, *connectionOptions.EstablishMode)
+	}
+	if connectionOptions.DistributeTraffic != nil {
+		d.Set(isVPNGatewayConnectionDistributeTraffic, *connectionOptions.DistributeTraffic)
+	}
 	return nil
 }
 
@@ -569,17 +663,74 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		hasChanged = true
 	}
 
+	if d.HasChange(isVPNGatewayConnectionEstablishMode) || d.HasChange(isVPNGatewayConnectionDistributeTraffic) {
+		hasChanged = true
+	}
+
 	if hasChanged {
 		vpnGatewayConnectionPatch, err := vpnGatewayConnectionPatchModel.AsPatch()
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
+		if d.HasChange(isVPNGatewayConnectionEstablishMode) {
+			vpnGatewayConnectionPatch[isVPNGatewayConnectionEstablishMode] = d.Get(isVPNGatewayConnectionEstablishMode).(string)
+		}
+		if d.HasChange(isVPNGatewayConnectionDistributeTraffic) {
+			vpnGatewayConnectionPatch[isVPNGatewayConnectionDistributeTraffic] = d.Get(isVPNGatewayConnectionDistributeTraffic).(bool)
+		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating Vpn Gateway Connection: %s\n%s", err, response)
 		}
 	}
+
+	if d.HasChange(isVPNGatewayConnectionLocalCIDRS) {
+		oldCIDRs, newCIDRs := d.GetChange(isVPNGatewayConnectionLocalCIDRS)
+		err = vpngwconUpdateCIDRs(oldCIDRs.(*schema.Set), newCIDRs.(*schema.Set), func(prefix, length string) (*core.DetailedResponse, error) {
+			return sess.AddVPNGatewayConnectionLocalCIDR(sess.NewAddVPNGatewayConnectionLocalCIDROptions(gID, gConnID, prefix, length))
+		}, func(prefix, length string) (*core.DetailedResponse, error) {
+			return sess.RemoveVPNGatewayConnectionLocalCIDR(sess.NewRemoveVPNGatewayConnectionLocalCIDROptions(gID, gConnID, prefix, length))
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating local CIDRs of Vpn Gateway Connection (%s): %s", gConnID, err)
+		}
+	}
+	if d.HasChange(isVPNGatewayConnectionPeerCIDRS) {
+		oldCIDRs, newCIDRs := d.GetChange(isVPNGatewayConnectionPeerCIDRS)
+		err = vpngwconUpdateCIDRs(oldCIDRs.(*schema.Set), newCIDRs.(*schema.Set), func(prefix, length string) (*core.DetailedResponse, error) {
+			return sess.AddVPNGatewayConnectionPeerCIDR(sess.NewAddVPNGatewayConnectionPeerCIDROptions(gID, gConnID, prefix, length))
+		}, func(prefix, length string) (*core.DetailedResponse, error) {
+			return sess.RemoveVPNGatewayConnectionPeerCIDR(sess.NewRemoveVPNGatewayConnectionPeerCIDROptions(gID, gConnID, prefix, length))
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating peer CIDRs of Vpn Gateway Connection (%s): %s", gConnID, err)
+		}
+	}
+	return nil
+}
+
+// vpngwconUpdateCIDRs adds the new CIDRs of a connection before removing the old ones, so that a policy mode
+// connection is never left without a CIDR.
+func vpngwconUpdateCIDRs(oldCIDRs, newCIDRs *schema.Set, add, remove func(prefix, length string) (*core.DetailedResponse, error)) error {
+	for _, cidr := range newCIDRs.Difference(oldCIDRs).List() {
+		parts := strings.Split(cidr.(string), "/")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid CIDR %s", cidr)
+		}
+		if response, err := add(parts[0], parts[1]); err != nil {
+			return fmt.Errorf("adding %s: %s\n%s", cidr, err, response)
+		}
+	}
+	for _, cidr := range oldCIDRs.Difference(newCIDRs).List() {
+		parts := strings.Split(cidr.(string), "/")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid CIDR %s", cidr)
+		}
+		if response, err := remove(parts[0], parts[1]); err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("removing %s: %s\n%s", cidr, err, response)
+		}
+	}
 	return nil
 }
 
@@ -18,8 +18,8 @@ import (
 func ResourceIBMIsVPNServerClient() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMIsVPNServerClientDisconnect,
-		ReadContext:   resourceIBMIsVPNServerClientDisconnect,
-		UpdateContext: resourceIBMIsVPNServerClientDisconnect,
+		ReadContext:   resourceIBMIsVPNServerClientRead,
+		UpdateContext: resourceIBMIsVPNServerClientUpdate,
 		DeleteContext: resourceIBMIsVPNServerClientDelete,
 		Importer:      &schema.ResourceImporter{},
 
@@ -133,21 +133,74 @@ func resourceIBMIsVPNServerClientDisconnect(context context.Context, d *schema.R
 	return nil
 }
 
-func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// resourceIBMIsVPNServerClientRead only refreshes the identifiers, reading the client must not
+// disconnect it again. A client that is gone is kept in the state, as that is the outcome of both
+// deleting it and of the VPN server removing it after the disconnect.
+func resourceIBMIsVPNServerClientRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	vpnServer, vpnClient, err := vpnServerClientIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getVPNServerClientOptions := &vpcv1.GetVPNServerClientOptions{}
+
+	getVPNServerClientOptions.SetVPNServerID(vpnServer)
+	getVPNServerClientOptions.SetID(vpnClient)
+
+	_, response, err := sess.GetVPNServerClientWithContext(context, getVPNServerClientOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] GetVPNServerClientWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] GetVPNServerClientWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("vpn_server", vpnServer); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpn_server: %s", err))
+	}
+	if err = d.Set("vpn_client", vpnClient); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpn_client: %s", err))
+	}
+	if err = d.Set("delete", d.Get("delete")); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting delete: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMIsVPNServerClientUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// a disconnected client can still be deleted, a deleted client can't be brought back
+	if d.HasChange("delete") && d.Get("delete").(bool) {
+		return resourceIBMIsVPNServerClientDisconnect(context, d, meta)
+	}
+	return nil
+}
+
+// vpnServerClientIdParts returns the VPN server and client of the ID, which is
+// vpnServer/vpnClient for deleted clients and vpnServer/vpnClient/statusCode for disconnected ones.
+func vpnServerClientIdParts(id string) (string, string, error) {
+	parts, err := flex.IdParts(id)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR]  Failed %s\n%s", "false", err))
+		return "", "", err
 	}
-	if len(parts) != 2 {
-		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpnServer/vpnClient", d.Id()))
+	if len(parts) != 2 && len(parts) != 3 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpnServer/vpnClient", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServer, vpnClient, err := vpnServerClientIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	vpnServer := parts[0]
-	vpnClient := parts[1]
 
 	getVPNServerClientOptions := &vpcv1.GetVPNServerClientOptions{}
 
@@ -165,8 +218,8 @@ func resourceIBMIsVPNServerClientDelete(context context.Context, d *schema.Resou
 	}
 
 	deleteVPNServerClientOptions := &vpcv1.DeleteVPNServerClientOptions{}
-	deleteVPNServerClientOptions.SetVPNServerID(d.Get("vpn_server").(string))
-	deleteVPNServerClientOptions.SetID(d.Get("vpn_client").(string))
+	deleteVPNServerClientOptions.SetVPNServerID(vpnServer)
+	deleteVPNServerClientOptions.SetID(vpnClient)
 
 	response, err = sess.DeleteVPNServerClientWithContext(context, deleteVPNServerClientOptions)
 	if err != nil {
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestAccIBMIsVPNServerClientBasic(t *testing.T) {
+	if acc.ISVPNServerID == "" || acc.ISVPNServerClientID == "" {
+		t.Skip("Set the environment variables IS_VPN_SERVER_ID and IS_VPN_SERVER_CLIENT_ID for testing ibm_is_vpn_server_client resource")
+	}
+	name := "ibm_is_vpn_server_client.is_vpn_server_client"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPNServerClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the client is disconnected once, the empty plan after the step shows that
+				// refreshing it doesn't disconnect it again
+				Config: testAccCheckIBMIsVPNServerClientConfig(acc.ISVPNServerID, acc.ISVPNServerClientID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "vpn_server", acc.ISVPNServerID),
+					resource.TestCheckResourceAttr(name, "vpn_client", acc.ISVPNServerClientID),
+					resource.TestCheckResourceAttr(name, "delete", "false"),
+					resource.TestCheckResourceAttr(name, "status_code", "202"),
+					resource.TestCheckResourceAttr(name, "id", fmt.Sprintf("%s/%s/202", acc.ISVPNServerID, acc.ISVPNServerClientID)),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete", "status_code", "description"},
+			},
+			{
+				// switching delete to true deletes the disconnected client in place
+				Config: testAccCheckIBMIsVPNServerClientConfig(acc.ISVPNServerID, acc.ISVPNServerClientID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "delete", "true"),
+					resource.TestCheckResourceAttr(name, "id", fmt.Sprintf("%s/%s", acc.ISVPNServerID, acc.ISVPNServerClientID)),
+					testAccCheckIBMIsVPNServerClientGone(name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNServerClientConfig(vpnServer, vpnClient string, delete bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_vpn_server_client" "is_vpn_server_client" {
+			vpn_server = "%s"
+			vpn_client = "%s"
+			delete     = %t
+		}
+	`, vpnServer, vpnClient, delete)
+}
+
+func testAccCheckIBMIsVPNServerClientGone(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		return testAccCheckIBMIsVPNServerClientDeleted(rs.Primary.ID)
+	}
+}
+
+func testAccCheckIBMIsVPNServerClientDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server_client" {
+			continue
+		}
+		if err := testAccCheckIBMIsVPNServerClientDeleted(rs.Primary.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMIsVPNServerClientDeleted(id string) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+
+	parts := strings.Split(id, "/")
+	if len(parts) < 2 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of vpnServer/vpnClient", id)
+	}
+
+	getVPNServerClientOptions := &vpcv1.GetVPNServerClientOptions{}
+	getVPNServerClientOptions.SetVPNServerID(parts[0])
+	getVPNServerClientOptions.SetID(parts[1])
+
+	_, response, err := sess.GetVPNServerClient(getVPNServerClientOptions)
+	if err == nil {
+		return fmt.Errorf("VPNServerClient still exists: %s", id)
+	} else if response == nil || response.StatusCode != 404 {
+		return fmt.Errorf("Error checking for VPNServerClient (%s) has been deleted: %s", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// vpcRequest sends a request to a VPC API the vpc-go-sdk doesn't cover yet, with
+// the service URL, version and authenticator of the VPC client.
+func vpcRequest(ctx context.Context, vpcClient *vpcv1.VpcV1, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	return vpcRequestWithQuery(ctx, vpcClient, method, path, pathParams, nil, body, result)
+}
+
+// vpcRequestWithQuery is vpcRequest with query parameters, for example the start token or the
+// filters of a list.
+func vpcRequestWithQuery(ctx context.Context, vpcClient *vpcv1.VpcV1, method, path string, pathParams, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = vpcClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(vpcClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", fmt.Sprint(*vpcClient.Version))
+	builder.AddQuery("generation", "2")
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return vpcClient.Service.Request(request, result)
+}